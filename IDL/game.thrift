@@ -5,21 +5,57 @@ include "common.thrift"
 struct GetGameListRequest {
    1: optional GameListFilter Filter
    2: optional GameListSorter Sorter
-   3: i32 PageNum
-   4: i32 PageSize
+   3: i32 PageNum // 页码模式，设置 Cursor 时忽略
+   4: i32 PageSize // 两种模式下都是每页条数
+   5: optional string Cursor // 设置后使用游标模式：空字符串表示第一页，之后传上一页返回的 NextCursor
+   6: optional bool NeedTotal // 仅游标模式：是否返回 TotalCount，默认不统计
 }
 
 struct GameListFilter {
     1: optional string FilterText
+    2: optional i64 CpID
+    3: optional list<GameStatus> NewestStatus // 最新版本状态，命中任一即可；已下架的游戏按 Offline 匹配
+    4: optional OnlineStatus OnlineStatus // 线上状态
+    5: optional list<GamePlatform> Platforms // 最新版本支持任一平台即可
+    6: optional i64 CreateTimeStart // 创建时间范围，秒级时间戳，闭区间
+    7: optional i64 CreateTimeEnd
+    8: optional i64 UpdateTimeStart // 更新时间范围，秒级时间戳，闭区间
+    9: optional i64 UpdateTimeEnd
+    10: optional i64 CategoryID // 最新版本属于该分类或其子分类
+    11: optional list<i64> TagIDs // 最新版本带有任一标签即可
+}
+
+enum OnlineStatus {
+    Unset = 0
+    Online = 1 // 有线上版本
+    NeverOnline = 2 // 从未上线
+    TakenDown = 3 // 已下架
 }
 
 struct GameListSorter {
-    1: optional i64 UpdateTime
+    1: optional i64 UpdateTime // 已废弃：设置时等同于 SortField = UpdateTime
+    2: optional GameListSortField SortField // 默认按更新时间
+    3: optional SortOrder SortOrder // 默认倒序
+}
+
+enum GameListSortField {
+    Unset = 0
+    CreateTime = 1
+    UpdateTime = 2
+    GameName = 3
+}
+
+enum SortOrder {
+    Unset = 0
+    Desc = 1
+    Asc = 2
 }
 
 struct GetGameListResponse {
     1: list<BriefGame> GameList
-    2: i32 TotalCount
+    2: optional i32 TotalCount // 页码模式总是返回；游标模式仅在 NeedTotal 时返回
+    3: string NextCursor // 游标模式下一页的游标，没有更多数据时为空
+    4: bool HasMore
     255: common.BaseResp BaseResp
 }
 
@@ -32,6 +68,10 @@ struct BriefGame {
     6: i64 CreateTime
     7: i64 UpdateTime
     8: GameStatus GameStatus
+    9: string GameIntroduction // 以下字段取自线上版本，未上线过的游戏取自最新版本
+    10: list<GamePlatform> GamePlatforms
+    11: string PackageName
+    12: string DownloadURL
 }
 
 enum GameStatus {
@@ -40,6 +80,9 @@ enum GameStatus {
     Reviewing = 2 // 审核中
     Published = 3 // 已发布
     Rejected = 4 // 已拒绝
+    Scheduled = 5 // 审核通过，等待定时发布
+    Offline = 6 // 已下架，需重新审核才能再次上线
+    Deleted = 7 // 草稿已删除，保留期内可从回收站恢复
 }
 
 struct GetGameDetailRequest {
@@ -58,6 +101,30 @@ struct GameDetail {
     4: GameVersion NewestGameVersion // 创建新游戏时仅需填写NewestGameVersion字段
     5: i64 CreateTime
     6: i64 ModifyTime
+    7: optional GameTakedown Takedown // 仅游戏处于下架状态时返回
+    8: optional GameRollout Rollout // 仅游戏有进行中的灰度发布时返回
+}
+
+struct GameTakedown {
+    1: i64 GameVersionID // 被下架的版本
+    2: string Reason
+    3: string Operator
+    4: i64 TakedownTime
+}
+
+// 灰度发布：审核通过的版本先对部分玩家生效，其余玩家仍获取上线版本。
+// 玩家按 (GameID, GameVersionID, PlayerID) 的哈希稳定分桶，提高比例时已命中的玩家不会掉出灰度
+struct GameRollout {
+    1: i64 GameVersionID // 灰度中的版本
+    2: i32 Percentage // 灰度比例，1-99
+    3: RolloutState State
+    4: i64 BaseGameVersionID // 未命中灰度的玩家获取的版本，即上线版本
+}
+
+enum RolloutState {
+    Unset = 0
+    Active = 1 // 灰度中
+    Paused = 2 // 已暂停，暂停期间比例不能提高，已在灰度中的玩家继续获取灰度版本
 }
 
 struct GameVersion {
@@ -69,13 +136,80 @@ struct GameVersion {
     6: string GameIntroduction
     7: list<string> GameIntroductionImages
     8: list<GamePlatform> GamePlatforms
-    9: string PackageName // 包名
-    10: string DownloadURL // 下载链接
+    9: string PackageName // 包名；设置了 Builds 时以 Builds 为准
+    10: string DownloadURL // 下载链接；设置了 Builds 时以 Builds 为准
     11: GameStatus GameStatus // 游戏状态
     12: string ReviewComment
     13: i64 ReviewTime
     14: i64 CreateTime
     15: i64 UpdateTime
+    16: i64 PublishAt // 定时发布时间(unix秒)，0 表示未设置
+    17: string Operator // 最近一次审核的审核人
+    18: i64 Revision // 版本修订号，每次写入递增，用于编辑冲突检测
+    19: i64 CategoryID // 所属分类，0 表示未分类
+    20: list<i64> TagIDs // 标签，随版本一起审核
+    21: string DefaultLocale // GameName 等顶层商店信息所用的语言，如 zh-CN；为空表示 zh-CN
+    22: map<string, LocalizedListing> Listings // 其他语言的商店信息，key 为语言标签，如 en-US
+    23: list<PlatformBuild> Builds // 各平台的构建信息，每个平台至多一个
+    24: optional ApkManifest ApkManifest // 提交审核时上传的 APK 的解析结果，供审核参考；编辑草稿后清空
+    25: string ReleaseNotes // 默认语言的更新说明，富文本；游戏上线过之后提交的版本必填
+    26: list<MediaItem> Media // 媒体库，按 Position 排序；设置后取代 GameIntroductionImages，其中的截图按顺序写入 GameIntroductionImages；未设置过的旧版本由 GameIntroductionImages 生成截图
+}
+
+// 媒体库中的一项
+struct MediaItem {
+    1: MediaType Type
+    2: string URI // 图片或视频地址
+    3: string ThumbnailURI // 缩略图，预告片提交审核时必填
+    4: MediaOrientation Orientation
+    5: string AspectRatio // 宽高比，如 16:9，须与 Orientation 一致
+    6: string Caption // 说明文字
+    7: i32 Position // 在媒体库中的位置，从 0 开始，同一版本内不可重复
+}
+
+enum MediaType {
+    Unset = 0
+    Screenshot = 1 // 截图
+    TrailerVideo = 2 // 预告片
+    PromoArt = 3 // 宣传图
+}
+
+enum MediaOrientation {
+    Unset = 0
+    Landscape = 1 // 横向
+    Portrait = 2 // 纵向
+    Square = 3 // 方形
+}
+
+// 从 APK 的 AndroidManifest.xml 与签名中解析出的信息
+struct ApkManifest {
+    1: string PackageName
+    2: i64 VersionCode
+    3: string VersionName
+    4: i32 MinSdkVersion
+    5: string CertificateSHA256 // 签名证书的 SHA-256，十六进制；未签名时为空
+    6: i64 InspectTime // 解析时间(unix秒)
+}
+
+// 一个平台的构建信息
+struct PlatformBuild {
+    1: GamePlatform Platform
+    2: string Identifier // Android 包名或 iOS Bundle ID，Web 为空
+    3: string VersionName // 展示用的版本号，如 1.2.0
+    4: i64 VersionCode // Android versionCode / iOS build number
+    5: string DownloadURL // 下载链接，Web 为入口地址
+    6: string MinOSVersion // 最低系统版本，如 Android 8.0、iOS 13.0
+    7: i64 FileSize // 安装包大小(字节)
+    8: string SHA256 // 安装包的 SHA-256，十六进制
+}
+
+// 一种语言的商店信息，为空的字段回退到默认语言
+struct LocalizedListing {
+    1: string GameName
+    2: string GameIntroduction
+    3: list<string> GameIntroductionImages
+    4: string HeaderImage
+    5: string ReleaseNotes
 }
 
 enum GamePlatform {
@@ -88,10 +222,17 @@ enum GamePlatform {
 // 更新时使用，做读写区分的原因是字段较大差异
 struct GameDetailWrite {
     1: i64 GameID
-    2: i64 CpID
+    2: i64 CpID // 可不传，游戏归属以网关透传的调用方 CP 为准；传入时必须与调用方一致
     3: GameVersion GameVersion
 }
 
+// 字段级校验错误，草稿只校验能否落库，提交审核时按平台做完整校验
+struct FieldError {
+    1: string Field // 字段名，与网关表单字段一致，列表项带下标，如 game_introduction_images[2]
+    2: string Code // required / too_long / invalid_format / invalid_value / duplicate
+    3: string Message
+}
+
 struct CreateGameDetailRequest {
    1: GameDetailWrite GameDetail
    2: SubmitMode SubmitMode
@@ -99,15 +240,20 @@ struct CreateGameDetailRequest {
 
 struct CreateGameDetailResponse {
     1: i64 GameID
+    2: list<FieldError> FieldErrors // 内容校验未通过时返回
     255: common.BaseResp BaseResp
 }
 
 struct UpdateGameDraftRequest {
     1: GameDetailWrite GameDetail
     2: SubmitMode SubmitMode
+    3: optional i64 ExpectedRevision // 客户端读到的最新版本 Revision，不一致时拒绝写入；不传则不做冲突检测
 }
 
 struct UpdateGameDraftResponse {
+    1: i64 GameVersionID // 本次写入的版本，草稿原地更新时与原版本相同
+    2: i64 Revision // 写入后的修订号
+    3: list<FieldError> FieldErrors // 内容校验未通过时返回
     255: common.BaseResp BaseResp
 }
 
@@ -121,6 +267,16 @@ struct ReviewGameVersionRequest {
    1: i64 GameID
    2: i64 GameVersionID
    3: ReviewResult ReviewResult
+   4: optional i64 PublishAt // 仅审核通过时有效，设置后版本进入 Scheduled 状态，到点自动上线
+   5: optional ReviewRemark ReviewRemark
+   6: optional i32 RolloutPercentage // 仅审核通过时有效，1-99，不可与 PublishAt 同时设置：版本发布后先对该比例的玩家灰度，游戏须已有上线版本
+}
+
+struct ReviewRemark {
+    1: string Remark // 审核意见
+    2: string Operator // 审核人
+    3: i64 ReviewTime // 审核时间，以服务端时间为准
+    4: string Meta // 附加信息
 }
 
 struct ReviewGameVersionResponse {
@@ -138,6 +294,364 @@ struct DeleteGameDraftRequest {
 }
 
 struct DeleteGameDraftResponse {
+    1: i64 NewestGameVersionID // 删除后的最新版本，0 表示游戏已没有任何版本
+    255: common.BaseResp BaseResp
+}
+
+struct DeletedGameDraft {
+    1: GameVersion GameVersion
+    2: i64 DeleteTime // 删除时间
+    3: i64 ExpireTime // 超过该时间后不能再恢复
+}
+
+struct ListDeletedGameDraftsRequest {
+    1: i64 GameID
+}
+
+struct ListDeletedGameDraftsResponse {
+    1: list<DeletedGameDraft> DeletedDrafts // 仅包含保留期内的草稿，按删除时间倒序
+    255: common.BaseResp BaseResp
+}
+
+struct RestoreGameDraftRequest {
+    1: i64 GameID
+    2: i64 GameVersionID // 回收站中的草稿版本
+}
+
+struct RestoreGameDraftResponse {
+    1: i64 Revision // 恢复后的修订号
+    255: common.BaseResp BaseResp
+}
+
+enum RolloutAction {
+    Unset = 0
+    Start = 1 // 审核通过时开始灰度
+    Raise = 2 // 提高灰度比例
+    Pause = 3
+    Resume = 4
+    Rollback = 5 // 终止灰度，所有玩家回到上线版本，灰度版本下线，需重新提审
+    Complete = 6 // 比例提高到 100，灰度版本成为上线版本
+}
+
+struct RolloutLog {
+    1: i64 RolloutLogID
+    2: i64 GameID
+    3: i64 GameVersionID
+    4: RolloutAction Action
+    5: i32 FromPercentage // 操作前的灰度比例，开始灰度时为 0
+    6: i32 ToPercentage // 操作后的灰度比例，回滚后为 0
+    7: string Operator
+    8: string Reason
+    9: i64 CreateTime
+}
+
+struct UpdateGameRolloutRequest {
+    1: i64 GameID
+    2: i64 GameVersionID // 必须是灰度中的版本
+    3: RolloutAction Action // 仅支持 Raise、Pause、Resume、Rollback
+    4: optional i32 Percentage // 仅 Raise 时必填，须大于当前比例，100 表示全量发布
+    5: string Reason
+    6: string Operator
+}
+
+struct UpdateGameRolloutResponse {
+    1: optional GameRollout Rollout // 操作后的灰度状态，全量发布或回滚后不返回
+    255: common.BaseResp BaseResp
+}
+
+struct GetGameRolloutRequest {
+    1: i64 GameID
+}
+
+struct GetGameRolloutResponse {
+    1: optional GameRollout Rollout // 没有进行中的灰度时不返回
+    2: list<RolloutLog> RolloutLogs // 该游戏全部灰度操作记录，按时间倒序
+    255: common.BaseResp BaseResp
+}
+
+struct ResolveServedVersionRequest {
+    1: i64 GameID
+    2: string PlayerID
+}
+
+struct ResolveServedVersionResponse {
+    1: i64 GameVersionID // 该玩家应获取的版本
+    2: bool InRollout // 是否命中灰度
+    255: common.BaseResp BaseResp
+}
+
+struct GetGameChangelogRequest {
+    1: i64 GameID
+    2: i32 PageNum
+    3: i32 PageSize
+}
+
+struct GetGameChangelogResponse {
+    1: list<ChangelogEntry> Entries // 已发布和已下架的版本，按创建时间倒序
+    2: i32 TotalCount
+    255: common.BaseResp BaseResp
+}
+
+// 一个发布过的版本的更新说明
+struct ChangelogEntry {
+    1: i64 GameVersionID
+    2: GameStatus GameStatus // Published 或 Offline
+    3: i64 PublishTime // 发布时间(unix秒)：定时发布的版本为定时发布时间，否则为审核通过时间
+    4: string DefaultLocale
+    5: string ReleaseNotes // 默认语言的更新说明
+    6: map<string, string> LocalizedReleaseNotes // 其他语言的更新说明，key 为语言标签，未填写的语言不出现
+}
+
+struct ListGameVersionsRequest {
+    1: i64 GameID
+    2: optional list<GameStatus> StatusFilter // 为空时返回所有状态的版本
+    3: i32 PageNum
+    4: i32 PageSize
+}
+
+struct ListGameVersionsResponse {
+    1: list<GameVersion> GameVersions // 按创建时间倒序
+    2: i32 TotalCount
+    255: common.BaseResp BaseResp
+}
+
+struct RollbackGameVersionRequest {
+    1: i64 GameID
+    2: i64 GameVersionID // 回滚目标版本，必须是该游戏曾经发布过的版本
+    3: string Reason // 回滚原因
+    4: string Operator // 操作人
+}
+
+struct RollbackGameVersionResponse {
+    255: common.BaseResp BaseResp
+}
+
+struct CancelScheduledPublishRequest {
+    1: i64 GameID
+    2: i64 GameVersionID
+}
+
+struct CancelScheduledPublishResponse {
+    255: common.BaseResp BaseResp
+}
+
+struct GameReviewLog {
+    1: i64 ReviewLogID
+    2: i64 GameID
+    3: i64 GameVersionID
+    4: ReviewResult ReviewResult
+    5: GameStatus ResultStatus // 审核后版本的状态
+    6: ReviewRemark ReviewRemark
+    7: i64 PublishAt // 定时发布时间，0 表示立即生效
+}
+
+struct GetGameReviewLogsRequest {
+    1: i64 GameID
+    2: i64 GameVersionID
+}
+
+struct GetGameReviewLogsResponse {
+    1: list<GameReviewLog> ReviewLogs // 按审核时间倒序
+    255: common.BaseResp BaseResp
+}
+
+struct SubmitGameVersionRequest {
+    1: i64 GameID
+    2: i64 GameVersionID // 必须是该游戏最新的草稿版本
+    3: optional binary ApkFile // Android 安装包，包名须与版本的 Android 包名一致
+    4: optional string ApkPath // 服务端本地的 Android 安装包路径，须位于配置的 apk.upload_dir 下；与 ApkFile 二选一
+}
+
+struct SubmitGameVersionResponse {
+    1: list<FieldError> FieldErrors // 草稿内容不满足提交审核的要求时返回
+    255: common.BaseResp BaseResp
+}
+
+struct WithdrawGameVersionRequest {
+    1: i64 GameID
+    2: i64 GameVersionID // 必须是审核中的版本
+}
+
+struct WithdrawGameVersionResponse {
+    255: common.BaseResp BaseResp
+}
+
+struct TakedownGameRequest {
+    1: i64 GameID
+    2: string Reason // 下架原因
+    3: string Operator // 操作人
+}
+
+struct TakedownGameResponse {
+    255: common.BaseResp BaseResp
+}
+
+struct RestoreGameRequest {
+    1: i64 GameID
+    2: string Reason // 恢复原因
+    3: string Operator // 操作人
+}
+
+struct RestoreGameResponse {
+    255: common.BaseResp BaseResp
+}
+
+struct FieldDiff {
+    1: string Field // 字段名，如 game_name、game_platforms
+    2: string FromValue // 旧值，列表字段为 JSON 数组
+    3: string ToValue // 新值，列表字段为 JSON 数组
+    4: list<string> AddedItems // 仅列表字段：新增的元素
+    5: list<string> RemovedItems // 仅列表字段：删除的元素
+}
+
+struct DiffGameVersionsRequest {
+    1: i64 GameID
+    2: optional i64 FromVersionID // 不传默认为线上版本
+    3: optional i64 ToVersionID // 不传默认为最新版本
+}
+
+struct DiffGameVersionsResponse {
+    1: i64 FromVersionID // 0 表示游戏还没有线上版本，此时所有字段都视为新增
+    2: i64 ToVersionID
+    3: list<FieldDiff> Diffs // 只包含有变化的字段
+    255: common.BaseResp BaseResp
+}
+
+struct SearchGamesRequest {
+    1: string Query // 搜索词：中文、英文单词、拼音全拼或首字母、包名
+    2: optional i64 CpID
+    3: optional list<GameStatus> Status // 按游戏状态过滤，含义同 GameListFilter.NewestStatus
+    4: i32 PageNum
+    5: i32 PageSize
+}
+
+struct SearchHighlight {
+    1: string Field // 命中的字段：game_name、game_introduction、package_name
+    2: string Snippet // 命中片段，命中部分用 <em></em> 包裹，其余内容已做 HTML 转义
+}
+
+struct SearchGameHit {
+    1: BriefGame Game
+    2: double Score // 相关度得分，只用于同一次搜索结果之间的比较
+    3: list<SearchHighlight> Highlights
+}
+
+struct SearchGamesResponse {
+    1: list<SearchGameHit> Hits // 按相关度从高到低排序
+    2: i32 TotalCount
+    255: common.BaseResp BaseResp
+}
+
+struct BatchGetGameDetailsRequest {
+    1: list<i64> GameIDs // 最多 100 个，重复的 ID 只返回一次
+}
+
+struct BatchGetGameDetailsResponse {
+    1: list<GameDetail> GameDetails // 按请求中 ID 的顺序排列
+    2: list<i64> MissingGameIDs // 不存在的游戏 ID
+    255: common.BaseResp BaseResp
+}
+
+// 同一平台（Android / iOS）的包名只能被一个游戏占用：提交审核时预留，发布时锁定
+struct ReleasePackageNameRequest {
+    1: GamePlatform Platform
+    2: string PackageName
+    3: optional i64 TransferToGameID // 设置时将占用转移给该游戏（转为预留），否则直接释放
+    4: string Reason // 操作原因
+    5: string Operator // 操作人
+}
+
+struct ReleasePackageNameResponse {
+    1: i64 PreviousGameID // 原占用该包名的游戏
+    255: common.BaseResp BaseResp
+}
+
+// 分类为树形结构，最多三层；标签为扁平列表。游戏版本通过 CategoryID / TagIDs 引用它们
+struct Category {
+    1: i64 CategoryID
+    2: i64 ParentID // 0 表示顶层分类
+    3: string Name // 同一父分类下不可重名
+    4: i32 SortOrder // 同级分类按 SortOrder、CategoryID 升序排列
+    5: i64 CreateTime
+    6: i64 UpdateTime
+}
+
+struct Tag {
+    1: i64 TagID
+    2: string Name // 全局唯一
+    3: i64 CreateTime
+    4: i64 UpdateTime
+}
+
+struct CreateCategoryRequest {
+    1: string Name
+    2: i64 ParentID
+    3: i32 SortOrder
+}
+
+struct CreateCategoryResponse {
+    1: i64 CategoryID
+    255: common.BaseResp BaseResp
+}
+
+struct UpdateCategoryRequest {
+    1: i64 CategoryID
+    2: string Name
+    3: i64 ParentID // 可移动到其他父分类下，但不能移动到自身或其子分类下
+    4: i32 SortOrder
+}
+
+struct UpdateCategoryResponse {
+    255: common.BaseResp BaseResp
+}
+
+struct DeleteCategoryRequest {
+    1: i64 CategoryID // 仍有子分类或被任一游戏版本引用时不可删除
+}
+
+struct DeleteCategoryResponse {
+    255: common.BaseResp BaseResp
+}
+
+struct ListCategoriesRequest {
+}
+
+struct ListCategoriesResponse {
+    1: list<Category> Categories // 全部分类，按 ParentID、SortOrder、CategoryID 升序排列
+    255: common.BaseResp BaseResp
+}
+
+struct CreateTagRequest {
+    1: string Name
+}
+
+struct CreateTagResponse {
+    1: i64 TagID
+    255: common.BaseResp BaseResp
+}
+
+struct UpdateTagRequest {
+    1: i64 TagID
+    2: string Name
+}
+
+struct UpdateTagResponse {
+    255: common.BaseResp BaseResp
+}
+
+struct DeleteTagRequest {
+    1: i64 TagID // 被任一游戏版本引用时不可删除
+}
+
+struct DeleteTagResponse {
+    255: common.BaseResp BaseResp
+}
+
+struct ListTagsRequest {
+}
+
+struct ListTagsResponse {
+    1: list<Tag> Tags // 按名称排序
     255: common.BaseResp BaseResp
 }
 
@@ -148,5 +662,31 @@ service GameService {
     CreateGameDetailResponse CreateGameDetail (1: CreateGameDetailRequest req) // 创建游戏详情
     ReviewGameVersionResponse ReviewGameVersion (1: ReviewGameVersionRequest req) // 审核游戏信息
     DeleteGameDraftResponse DeleteGameDraft (1: DeleteGameDraftRequest req) // 删除游戏草稿
+    ListGameVersionsResponse ListGameVersions (1: ListGameVersionsRequest req) // 获取游戏版本历史
+    RollbackGameVersionResponse RollbackGameVersion (1: RollbackGameVersionRequest req) // 回滚上线版本
+    CancelScheduledPublishResponse CancelScheduledPublish (1: CancelScheduledPublishRequest req) // 取消定时发布
+    GetGameReviewLogsResponse GetGameReviewLogs (1: GetGameReviewLogsRequest req) // 获取版本审核记录
+    SubmitGameVersionResponse SubmitGameVersion (1: SubmitGameVersionRequest req) // 草稿提交审核
+    WithdrawGameVersionResponse WithdrawGameVersion (1: WithdrawGameVersionRequest req) // 撤回审核
+    DiffGameVersionsResponse DiffGameVersions (1: DiffGameVersionsRequest req) // 版本字段对比
+    TakedownGameResponse TakedownGame (1: TakedownGameRequest req) // 下架游戏
+    RestoreGameResponse RestoreGame (1: RestoreGameRequest req) // 恢复下架游戏，版本重新进入审核
+    ListDeletedGameDraftsResponse ListDeletedGameDrafts (1: ListDeletedGameDraftsRequest req) // 获取草稿回收站
+    RestoreGameDraftResponse RestoreGameDraft (1: RestoreGameDraftRequest req) // 从回收站恢复草稿
+    SearchGamesResponse SearchGames (1: SearchGamesRequest req) // 全文搜索游戏
+    BatchGetGameDetailsResponse BatchGetGameDetails (1: BatchGetGameDetailsRequest req) // 批量获取游戏详情
+    ReleasePackageNameResponse ReleasePackageName (1: ReleasePackageNameRequest req) // 管理员释放或转移包名占用
+    CreateCategoryResponse CreateCategory (1: CreateCategoryRequest req) // 创建分类
+    UpdateCategoryResponse UpdateCategory (1: UpdateCategoryRequest req) // 修改分类
+    DeleteCategoryResponse DeleteCategory (1: DeleteCategoryRequest req) // 删除分类
+    ListCategoriesResponse ListCategories (1: ListCategoriesRequest req) // 获取全部分类
+    CreateTagResponse CreateTag (1: CreateTagRequest req) // 创建标签
+    UpdateTagResponse UpdateTag (1: UpdateTagRequest req) // 修改标签
+    DeleteTagResponse DeleteTag (1: DeleteTagRequest req) // 删除标签
+    ListTagsResponse ListTags (1: ListTagsRequest req) // 获取全部标签
+    UpdateGameRolloutResponse UpdateGameRollout (1: UpdateGameRolloutRequest req) // 调整灰度发布：提高比例、暂停、恢复或回滚
+    GetGameRolloutResponse GetGameRollout (1: GetGameRolloutRequest req) // 获取灰度状态与操作记录
+    ResolveServedVersionResponse ResolveServedVersion (1: ResolveServedVersionRequest req) // 获取玩家应获取的版本
+    GetGameChangelogResponse GetGameChangelog (1: GetGameChangelogRequest req) // 获取已发布版本的更新说明
 }
 
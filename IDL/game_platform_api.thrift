@@ -116,14 +116,48 @@ struct GetGameListRequest {
    2: optional GameListSorter sorter
    3: i32 page_num
    4: i32 page_size
+   5: optional string cursor
+   6: optional bool need_total
 }
 
 struct GameListFilter {
     1: optional string filter_text
+    2: optional string cp_id
+    3: optional list<GameStatus> newest_status
+    4: optional OnlineStatus online_status
+    5: optional list<GamePlatform> platforms
+    6: optional i64 create_time_start
+    7: optional i64 create_time_end
+    8: optional i64 update_time_start
+    9: optional i64 update_time_end
+    10: optional string category_id // 包含子分类
+    11: optional list<string> tag_ids // 带有任一标签即可
+}
+
+enum OnlineStatus {
+    Unset = 0
+    Online = 1
+    NeverOnline = 2
+    TakenDown = 3
 }
 
 struct GameListSorter {
     1: optional i64 update_time
+    2: optional GameListSortField sort_field
+    3: optional SortOrder sort_order
+}
+
+enum GameListSortField {
+    Unset = 0
+    CreateTime = 1
+    UpdateTime = 2
+    GameName = 3
+}
+
+enum SortOrder {
+    Unset = 0
+    Desc = 1
+    Asc = 2
 }
 
 struct GetGameListResponse {
@@ -133,7 +167,9 @@ struct GetGameListResponse {
 
 struct GetGameListData {
     1: list<BriefGame> game_list
-    2: i32 total_count
+    2: optional i32 total_count
+    3: string next_cursor
+    4: bool has_more
 }
 
 struct BriefGame {
@@ -144,6 +180,11 @@ struct BriefGame {
     5: i64 create_time
     6: i64 update_time
     7: GameStatus game_status
+    8: string header_image
+    9: string game_introduction
+    10: list<GamePlatform> game_platforms
+    11: string package_name
+    12: string download_url
 }
 
 enum GameStatus {
@@ -152,6 +193,9 @@ enum GameStatus {
     Reviewing = 2
     Published = 3
     Rejected = 4
+    Scheduled = 5
+    Offline = 6
+    Deleted = 7
 }
 
 
@@ -175,6 +219,38 @@ struct GameDetail {
     4: GameVersion newest_game_version
     5: i64 create_time
     6: i64 modify_time
+    7: optional GameTakedown takedown
+    8: optional GameRollout rollout // 仅游戏有进行中的灰度发布时返回
+}
+
+struct GameTakedown {
+    1: string game_version_id
+    2: string reason
+    3: string operator
+    4: i64 takedown_time
+}
+
+struct GameRollout {
+    1: string game_version_id // 灰度中的版本
+    2: i32 percentage // 1-99
+    3: RolloutState state
+    4: string base_game_version_id // 未命中灰度的玩家获取的版本
+}
+
+enum RolloutState {
+    Unset = 0
+    Active = 1
+    Paused = 2 // 暂停期间比例不能提高，已在灰度中的玩家继续获取灰度版本
+}
+
+enum RolloutAction {
+    Unset = 0
+    Start = 1
+    Raise = 2
+    Pause = 3
+    Resume = 4
+    Rollback = 5
+    Complete = 6
 }
 
 struct GameVersion {
@@ -192,6 +268,70 @@ struct GameVersion {
     12: ReviewRemark review_remark
     13: i64 create_time
     14: i64 update_time
+    15: i64 publish_at
+    16: i64 revision
+    17: string category_id // 所属分类，空表示未分类
+    18: list<string> tag_ids
+    19: string default_locale // 顶层名称、介绍、图片所用的语言，如 zh-CN
+    20: map<string, LocalizedListing> listings // 其他语言的商店信息，key 为语言标签
+    21: string display_locale // 读接口按 Accept-Language 或 lang 参数选出的语言
+    22: LocalizedListing display // display_locale 下展示的商店信息，缺失字段已回退到默认语言
+    23: list<PlatformBuild> builds // 各平台的构建信息，设置后取代 package_name 和 download_url
+    24: ApkManifest apk_manifest // 提交审核时上传的 APK 的解析结果，未上传时为空
+    25: string release_notes // 默认语言的更新说明，富文本；游戏上线过之后提交审核时必填
+    26: list<MediaItem> media // 媒体库，按 position 排序；设置后取代 game_introduction_images
+}
+
+struct MediaItem {
+    1: MediaType type
+    2: string uri
+    3: string thumbnail_uri // 预告片提交审核时必填
+    4: MediaOrientation orientation
+    5: string aspect_ratio // 宽高比，如 16:9
+    6: string caption
+    7: i32 position // 从 0 开始，同一版本内不可重复
+}
+
+enum MediaType {
+    Unset = 0
+    Screenshot = 1 // 截图
+    TrailerVideo = 2 // 预告片
+    PromoArt = 3 // 宣传图
+}
+
+enum MediaOrientation {
+    Unset = 0
+    Landscape = 1
+    Portrait = 2
+    Square = 3
+}
+
+struct ApkManifest {
+    1: string package_name
+    2: i64 version_code
+    3: string version_name
+    4: i32 min_sdk_version
+    5: string certificate_sha256 // 签名证书的 SHA-256
+    6: i64 inspect_time
+}
+
+struct PlatformBuild {
+    1: GamePlatform platform
+    2: string identifier // Android 包名或 iOS Bundle ID，Web 为空
+    3: string version_name
+    4: i64 version_code
+    5: string download_url // 下载链接，Web 为入口地址
+    6: string min_os_version
+    7: i64 file_size // 安装包大小(字节)
+    8: string sha256
+}
+
+struct LocalizedListing {
+    1: string game_name
+    2: string game_introduction
+    3: list<string> game_introduction_images
+    4: string header_image
+    5: string release_notes
 }
 
 enum GamePlatform {
@@ -212,8 +352,16 @@ struct CreateGameDetailRequest {
    2: SubmitMode submit_mode
 }
 
+// 字段级校验错误，field 与表单字段名一致，前端可直接展示在对应字段旁
+struct FieldError {
+    1: string field
+    2: string code
+    3: string message
+}
+
 struct CreateGameDetailResponse {
     1:  CreateGameDetailData data
+    2: list<FieldError> field_errors
     255: common.BaseResp BaseResp
 }
 
@@ -225,15 +373,18 @@ struct UpdateGameDetailRequest {
     1: string game_id(api.path = 'id')
     2: GameDetailWrite game_detail
     3: SubmitMode submit_mode
+    4: optional i64 expected_revision
 }
 
 struct UpdateGameDetailResponse {
     1: UpdateGameDetailData data
     2: common.BaseResp base_resp
+    3: list<FieldError> field_errors
 }
 
 struct UpdateGameDetailData {
-
+    1: string game_version_id
+    2: i64 revision
 }
 
 struct DeleteGameDraftRequest {
@@ -241,6 +392,136 @@ struct DeleteGameDraftRequest {
 }
 
 struct DeleteGameDraftData {
+    1: string newest_game_version_id
+}
+
+struct DeletedGameDraft {
+    1: GameVersion game_version
+    2: i64 delete_time
+    3: i64 expire_time
+}
+
+struct ListDeletedGameDraftsRequest {
+    1: i64 game_id (api.path = 'id')
+}
+
+struct ListDeletedGameDraftsResponse {
+    1: ListDeletedGameDraftsData data
+    255: common.BaseResp base_resp
+}
+
+struct ListDeletedGameDraftsData {
+    1: list<DeletedGameDraft> deleted_drafts
+}
+
+struct RestoreGameDraftRequest {
+    1: i64 game_id (api.path = 'id')
+    2: i64 game_version_id (api.path = 'version_id')
+}
+
+struct RestoreGameDraftResponse {
+    1: RestoreGameDraftData data
+    255: common.BaseResp base_resp
+}
+
+struct RestoreGameDraftData {
+    1: i64 revision
+}
+
+struct SearchGamesRequest {
+    1: string query
+    2: optional string cp_id
+    3: optional list<GameStatus> status
+    4: i32 page_num
+    5: i32 page_size
+}
+
+struct SearchGamesResponse {
+    1: SearchGamesData data
+    255: common.BaseResp base_resp
+}
+
+struct SearchGamesData {
+    1: list<SearchGameHit> hits
+    2: i32 total_count
+}
+
+struct SearchGameHit {
+    1: BriefGame game
+    2: double score
+    3: list<SearchHighlight> highlights
+}
+
+struct SearchHighlight {
+    1: string field // game_name、game_introduction、package_name
+    2: string snippet // 命中部分用 <em></em> 包裹，其余内容已做 HTML 转义
+}
+
+struct BatchGetGameDetailsRequest {
+    1: list<string> game_ids
+}
+
+struct BatchGetGameDetailsResponse {
+    1: BatchGetGameDetailsData data
+    255: common.BaseResp base_resp
+}
+
+struct BatchGetGameDetailsData {
+    1: list<GameDetail> game_details
+    2: list<string> missing_game_ids
+}
+
+struct ReleasePackageNameRequest {
+    1: GamePlatform platform
+    2: string package_name
+    3: optional string transfer_to_game_id // 设置时将占用转移给该游戏，否则直接释放
+    4: string reason
+    5: string operator
+}
+
+struct ReleasePackageNameResponse {
+    1: ReleasePackageNameData data
+    255: common.BaseResp base_resp
+}
+
+struct ReleasePackageNameData {
+    1: string previous_game_id
+}
+
+struct ListCategoriesRequest {
+}
+
+struct ListCategoriesResponse {
+    1: ListCategoriesData data
+    255: common.BaseResp base_resp
+}
+
+struct ListCategoriesData {
+    1: list<CategoryNode> categories // 顶层分类，同级按 sort_order 排序
+}
+
+struct CategoryNode {
+    1: string category_id
+    2: string name
+    3: i32 sort_order
+    4: list<CategoryNode> children
+}
+
+struct ListTagsRequest {
+}
+
+struct ListTagsResponse {
+    1: ListTagsData data
+    255: common.BaseResp base_resp
+}
+
+struct ListTagsData {
+    1: list<Tag> tags // 按名称排序
+}
+
+struct Tag {
+    1: string tag_id
+    2: string name
 }
 
 struct DeleteGameDraftResponse {
@@ -253,6 +534,8 @@ struct ReviewGameVersionRequest {
     2: string game_version_id
     3: ReviewResult review_result
     4: ReviewRemark review_remark
+    5: optional i64 publish_at
+    6: optional i32 rollout_percentage // 仅审核通过时有效，1-99，先对该比例的玩家灰度
 }
 
 struct ReviewGameVersionResponse {
@@ -264,6 +547,238 @@ struct ReviewGameVersionResponse {
 struct ReviewGameVersionData {
 }
 
+struct ListGameVersionsRequest {
+    1: i64 game_id (api.path = 'id')
+    2: optional list<GameStatus> status_filter (api.query = 'status')
+    3: i32 page_num (api.query = 'page_num')
+    4: i32 page_size (api.query = 'page_size')
+}
+
+struct ListGameVersionsResponse {
+    1: ListGameVersionsData data
+    255: common.BaseResp base_resp
+}
+
+struct ListGameVersionsData {
+    1: list<GameVersion> game_versions
+    2: i32 total_count
+}
+
+struct RollbackGameVersionRequest {
+    1: i64 game_id (api.path = 'id')
+    2: string game_version_id
+    3: string reason
+    4: string operator
+}
+
+struct RollbackGameVersionResponse {
+    1: RollbackGameVersionData data
+    255: common.BaseResp base_resp
+}
+
+struct RollbackGameVersionData {
+}
+
+struct CancelScheduledPublishRequest {
+    1: i64 game_id (api.path = 'id')
+    2: string game_version_id
+}
+
+struct CancelScheduledPublishResponse {
+    1: CancelScheduledPublishData data
+    255: common.BaseResp base_resp
+}
+
+struct CancelScheduledPublishData {
+}
+
+struct GameReviewLog {
+    1: string review_log_id
+    2: string game_id
+    3: string game_version_id
+    4: ReviewResult review_result
+    5: GameStatus result_status
+    6: ReviewRemark review_remark
+    7: i64 publish_at
+}
+
+struct GetGameReviewLogsRequest {
+    1: i64 game_id (api.path = 'id')
+    2: i64 game_version_id (api.path = 'version_id')
+}
+
+struct GetGameReviewLogsResponse {
+    1: GetGameReviewLogsData data
+    255: common.BaseResp base_resp
+}
+
+struct GetGameReviewLogsData {
+    1: list<GameReviewLog> review_logs
+}
+
+// 可用 multipart/form-data 提交，文件字段 apk 为 Android 安装包，由 game 服务解析并核对包名
+struct SubmitGameVersionRequest {
+    1: i64 game_id (api.path = 'id')
+    2: string game_version_id
+}
+
+struct SubmitGameVersionResponse {
+    1: SubmitGameVersionData data
+    2: list<FieldError> field_errors
+    255: common.BaseResp base_resp
+}
+
+struct SubmitGameVersionData {
+}
+
+struct WithdrawGameVersionRequest {
+    1: i64 game_id (api.path = 'id')
+    2: string game_version_id
+}
+
+struct WithdrawGameVersionResponse {
+    1: WithdrawGameVersionData data
+    255: common.BaseResp base_resp
+}
+
+struct WithdrawGameVersionData {
+}
+
+struct UpdateGameRolloutRequest {
+    1: i64 game_id (api.path = 'id')
+    2: string game_version_id
+    3: RolloutAction action // raise、pause、resume 或 rollback
+    4: optional i32 percentage // 仅 raise 时必填，100 表示全量发布
+    5: string reason
+    6: string operator
+}
+
+struct UpdateGameRolloutResponse {
+    1: UpdateGameRolloutData data
+    255: common.BaseResp base_resp
+}
+
+struct UpdateGameRolloutData {
+    1: optional GameRollout rollout // 全量发布或回滚后为空
+}
+
+struct GetGameRolloutRequest {
+    1: i64 game_id (api.path = 'id')
+}
+
+struct GetGameRolloutResponse {
+    1: GetGameRolloutData data
+    255: common.BaseResp base_resp
+}
+
+struct GetGameRolloutData {
+    1: optional GameRollout rollout
+    2: list<RolloutLog> rollout_logs // 按时间倒序
+}
+
+struct RolloutLog {
+    1: string rollout_log_id
+    2: string game_version_id
+    3: RolloutAction action
+    4: i32 from_percentage
+    5: i32 to_percentage
+    6: string operator
+    7: string reason
+    8: i64 create_time
+}
+
+struct ResolveServedVersionRequest {
+    1: i64 game_id (api.path = 'id')
+    2: string player_id (api.query = 'player_id')
+}
+
+struct ResolveServedVersionResponse {
+    1: ResolveServedVersionData data
+    255: common.BaseResp base_resp
+}
+
+struct ResolveServedVersionData {
+    1: string game_version_id
+    2: bool in_rollout
+}
+
+struct GetGameChangelogRequest {
+    1: i64 game_id (api.path = 'id')
+    2: i32 page_num (api.query = 'page_num')
+    3: i32 page_size (api.query = 'page_size')
+}
+
+struct GetGameChangelogResponse {
+    1: GetGameChangelogData data
+    255: common.BaseResp base_resp
+}
+
+struct GetGameChangelogData {
+    1: list<ChangelogEntry> entries // 按创建时间倒序
+    2: i32 total_count
+}
+
+struct ChangelogEntry {
+    1: string game_version_id
+    2: GameStatus game_status
+    3: i64 publish_time
+    4: string display_locale // 按 Accept-Language 或 lang 参数选出的语言
+    5: string release_notes // display_locale 下的更新说明，未翻译时为默认语言的内容
+}
+
+struct TakedownGameRequest {
+    1: i64 game_id (api.path = 'id')
+    2: string reason
+    3: string operator
+}
+
+struct TakedownGameResponse {
+    1: TakedownGameData data
+    255: common.BaseResp base_resp
+}
+
+struct TakedownGameData {
+}
+
+struct RestoreGameRequest {
+    1: i64 game_id (api.path = 'id')
+    2: string reason
+    3: string operator
+}
+
+struct RestoreGameResponse {
+    1: RestoreGameData data
+    255: common.BaseResp base_resp
+}
+
+struct RestoreGameData {
+}
+
+struct FieldDiff {
+    1: string field
+    2: string from_value
+    3: string to_value
+    4: list<string> added_items
+    5: list<string> removed_items
+}
+
+struct DiffGameVersionsRequest {
+    1: i64 game_id (api.path = 'id')
+    2: optional string from_version_id (api.query = 'from_version_id')
+    3: optional string to_version_id (api.query = 'to_version_id')
+}
+
+struct DiffGameVersionsResponse {
+    1: DiffGameVersionsData data
+    255: common.BaseResp base_resp
+}
+
+struct DiffGameVersionsData {
+    1: string from_version_id
+    2: string to_version_id
+    3: list<FieldDiff> diffs
+}
+
 service GamePlatformAPIService {
      // content provider
      CreateCPMaterialResponse CreateCPMaterial(1: CreateCPMaterialsRequest req) (api.post = '/api/v1/cp/materials') // 创建厂商材料
@@ -278,4 +793,26 @@ service GamePlatformAPIService {
      UpdateGameDetailResponse UpdateGameDetail(1: UpdateGameDetailRequest req) (api.put = '/api/v1/games/:id') // 更新游戏信息
      ReviewGameVersionResponse ReviewGameVersion(1: ReviewGameVersionRequest req) (api.post = '/api/v1/games/review') // 审核游戏信息
      DeleteGameDraftResponse DeleteGameDraft(1: DeleteGameDraftRequest req) (api.delete = '/api/v1/games/:id/draft') // 删除游戏草稿
+     ListGameVersionsResponse ListGameVersions(1: ListGameVersionsRequest req) (api.get = '/api/v1/games/:id/versions') // 获取游戏版本历史
+     RollbackGameVersionResponse RollbackGameVersion(1: RollbackGameVersionRequest req) (api.post = '/api/v1/games/:id/rollback') // 回滚上线版本
+     CancelScheduledPublishResponse CancelScheduledPublish(1: CancelScheduledPublishRequest req) (api.post = '/api/v1/games/:id/schedule/cancel') // 取消定时发布
+     GetGameReviewLogsResponse GetGameReviewLogs(1: GetGameReviewLogsRequest req) (api.get = '/api/v1/games/:id/versions/:version_id/reviews') // 获取版本审核记录
+     SubmitGameVersionResponse SubmitGameVersion(1: SubmitGameVersionRequest req) (api.post = '/api/v1/games/:id/submit') // 草稿提交审核
+     WithdrawGameVersionResponse WithdrawGameVersion(1: WithdrawGameVersionRequest req) (api.post = '/api/v1/games/:id/withdraw') // 撤回审核
+     DiffGameVersionsResponse DiffGameVersions(1: DiffGameVersionsRequest req) (api.get = '/api/v1/games/:id/diff') // 版本字段对比
+     TakedownGameResponse TakedownGame(1: TakedownGameRequest req) (api.post = '/api/v1/games/:id/takedown') // 下架游戏
+     RestoreGameResponse RestoreGame(1: RestoreGameRequest req) (api.post = '/api/v1/games/:id/restore') // 恢复下架游戏
+     UpdateGameRolloutResponse UpdateGameRollout(1: UpdateGameRolloutRequest req) (api.post = '/api/v1/games/:id/rollout') // 调整灰度发布
+     GetGameRolloutResponse GetGameRollout(1: GetGameRolloutRequest req) (api.get = '/api/v1/games/:id/rollout') // 获取灰度状态与操作记录
+     ResolveServedVersionResponse ResolveServedVersion(1: ResolveServedVersionRequest req) (api.get = '/api/v1/games/:id/served-version') // 获取玩家应获取的版本
+     GetGameChangelogResponse GetGameChangelog(1: GetGameChangelogRequest req) (api.get = '/api/v1/games/:id/changelog') // 获取更新日志
+     ListDeletedGameDraftsResponse ListDeletedGameDrafts(1: ListDeletedGameDraftsRequest req) (api.get = '/api/v1/games/:id/trash') // 获取草稿回收站
+     RestoreGameDraftResponse RestoreGameDraft(1: RestoreGameDraftRequest req) (api.post = '/api/v1/games/:id/trash/:version_id/restore') // 从回收站恢复草稿
+     SearchGamesResponse SearchGames(1: SearchGamesRequest req) (api.get = '/api/v1/games/search') // 全文搜索游戏
+     BatchGetGameDetailsResponse BatchGetGameDetails(1: BatchGetGameDetailsRequest req) (api.get = '/api/v1/games/batch') // 批量获取游戏详情
+     ReleasePackageNameResponse ReleasePackageName(1: ReleasePackageNameRequest req) (api.post = '/api/v1/admin/package-names/release') // 管理员释放或转移包名占用
+
+     // taxonomy
+     ListCategoriesResponse ListCategories(1: ListCategoriesRequest req) (api.get = '/api/v1/categories') // 获取分类树
+     ListTagsResponse ListTags(1: ListTagsRequest req) (api.get = '/api/v1/tags') // 获取全部标签
 }
\ No newline at end of file
@@ -0,0 +1,105 @@
+// Package apk inspects Android packages offline.
+//
+// Inspect reads the binary AndroidManifest.xml of an APK for its package name, version and minimum SDK,
+// and the signing certificate from the APK Signature Scheme v3 or v2 block, falling back to the v1 JAR
+// signature under META-INF. Nothing is resolved against resources.arsc, so values that are resource
+// references are reported as "@0x7f..." like aapt does.
+package apk
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// maxManifestSize bounds the uncompressed AndroidManifest.xml, so a crafted archive cannot exhaust memory.
+const maxManifestSize = 8 << 20
+
+// ErrInvalidAPK is returned for files that are not APKs or whose manifest cannot be read.
+var ErrInvalidAPK = errors.New("invalid apk")
+
+// Manifest is what an APK says about itself.
+type Manifest struct {
+	PackageName   string
+	VersionCode   int64
+	VersionName   string
+	MinSdkVersion int32
+	// CertificateSHA256 is the SHA-256 digest of the DER encoded signing certificate, in lowercase hex.
+	// It is empty for unsigned APKs.
+	CertificateSHA256 string
+}
+
+// Inspect reads the manifest and signing certificate of the APK in r.
+func Inspect(r io.ReaderAt, size int64) (*Manifest, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAPK, err)
+	}
+
+	var manifestFile *zip.File
+	for _, f := range archive.File {
+		if f.Name == "AndroidManifest.xml" {
+			manifestFile = f
+			break
+		}
+	}
+	if manifestFile == nil {
+		return nil, fmt.Errorf("%w: AndroidManifest.xml is missing", ErrInvalidAPK)
+	}
+	data, err := readEntry(manifestFile, maxManifestSize)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAPK, err)
+	}
+	manifest, err := parseManifest(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAPK, err)
+	}
+
+	certificate, err := signingCertificate(r, size, archive)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAPK, err)
+	}
+	if certificate != nil {
+		manifest.CertificateSHA256 = certificateDigest(certificate)
+	}
+	return manifest, nil
+}
+
+// InspectBytes inspects an APK held in memory.
+func InspectBytes(data []byte) (*Manifest, error) {
+	return Inspect(bytes.NewReader(data), int64(len(data)))
+}
+
+// InspectFile inspects the APK at path.
+func InspectFile(path string) (*Manifest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return Inspect(f, info.Size())
+}
+
+// readEntry reads a zip entry of at most limit bytes.
+func readEntry(f *zip.File, limit int64) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(io.LimitReader(rc, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("%s is larger than %d bytes", f.Name, limit)
+	}
+	return data, nil
+}
@@ -0,0 +1,290 @@
+package apk
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testAttribute is an attribute written by startElementChunk.
+type testAttribute struct {
+	name     uint32
+	rawValue uint32
+	dataType uint8
+	data     uint32
+}
+
+// stringPoolChunk encodes a string pool, in UTF-8 or UTF-16.
+func stringPoolChunk(strs []string, utf8 bool) []byte {
+	var data []byte
+	offsets := make([]byte, 0, 4*len(strs))
+	for _, s := range strs {
+		offsets = binary.LittleEndian.AppendUint32(offsets, uint32(len(data)))
+		if utf8 {
+			data = append(data, byte(len(utf16.Encode([]rune(s)))), byte(len(s)))
+			data = append(data, s...)
+			data = append(data, 0)
+		} else {
+			units := utf16.Encode([]rune(s))
+			data = binary.LittleEndian.AppendUint16(data, uint16(len(units)))
+			for _, u := range units {
+				data = binary.LittleEndian.AppendUint16(data, u)
+			}
+			data = binary.LittleEndian.AppendUint16(data, 0)
+		}
+	}
+	for len(data)%4 != 0 {
+		data = append(data, 0)
+	}
+
+	var flags uint32
+	if utf8 {
+		flags = stringPoolUTF8
+	}
+	header := make([]byte, 0, 28)
+	header = binary.LittleEndian.AppendUint16(header, chunkStringPool)
+	header = binary.LittleEndian.AppendUint16(header, 28)
+	header = binary.LittleEndian.AppendUint32(header, uint32(28+len(offsets)+len(data)))
+	header = binary.LittleEndian.AppendUint32(header, uint32(len(strs)))
+	header = binary.LittleEndian.AppendUint32(header, 0)
+	header = binary.LittleEndian.AppendUint32(header, flags)
+	header = binary.LittleEndian.AppendUint32(header, uint32(28+len(offsets)))
+	header = binary.LittleEndian.AppendUint32(header, 0)
+	return append(append(header, offsets...), data...)
+}
+
+// resourceMapChunk encodes the resource IDs of the first strings of the pool.
+func resourceMapChunk(ids ...uint32) []byte {
+	chunk := binary.LittleEndian.AppendUint16(nil, chunkResourceMap)
+	chunk = binary.LittleEndian.AppendUint16(chunk, 8)
+	chunk = binary.LittleEndian.AppendUint32(chunk, uint32(8+4*len(ids)))
+	for _, id := range ids {
+		chunk = binary.LittleEndian.AppendUint32(chunk, id)
+	}
+	return chunk
+}
+
+// startElementChunk encodes a start element named by the string at index name.
+func startElementChunk(name uint32, attributes ...testAttribute) []byte {
+	chunk := binary.LittleEndian.AppendUint16(nil, chunkStartElement)
+	chunk = binary.LittleEndian.AppendUint16(chunk, 16)
+	chunk = binary.LittleEndian.AppendUint32(chunk, uint32(16+20+20*len(attributes)))
+	chunk = binary.LittleEndian.AppendUint32(chunk, 1)       // line number
+	chunk = binary.LittleEndian.AppendUint32(chunk, noIndex) // comment
+	chunk = binary.LittleEndian.AppendUint32(chunk, noIndex) // namespace
+	chunk = binary.LittleEndian.AppendUint32(chunk, name)
+	chunk = binary.LittleEndian.AppendUint16(chunk, 20) // attribute start
+	chunk = binary.LittleEndian.AppendUint16(chunk, 20) // attribute size
+	chunk = binary.LittleEndian.AppendUint16(chunk, uint16(len(attributes)))
+	chunk = append(chunk, make([]byte, 6)...) // id, class and style indexes
+	for _, attr := range attributes {
+		chunk = binary.LittleEndian.AppendUint32(chunk, noIndex)
+		chunk = binary.LittleEndian.AppendUint32(chunk, attr.name)
+		chunk = binary.LittleEndian.AppendUint32(chunk, attr.rawValue)
+		chunk = binary.LittleEndian.AppendUint16(chunk, 8)
+		chunk = append(chunk, 0, attr.dataType)
+		chunk = binary.LittleEndian.AppendUint32(chunk, attr.data)
+	}
+	return chunk
+}
+
+// binaryManifest encodes the manifest of com.happy.elimination 1.2.0 (120) for SDK 21 and up. With
+// obfuscated set the android: attributes have empty names and are only known by their resource IDs.
+func binaryManifest(utf8, obfuscated bool) []byte {
+	strs := []string{"versionCode", "versionName", "minSdkVersion", "package", "manifest", "uses-sdk", "com.happy.elimination", "1.2.0"}
+	if obfuscated {
+		strs[0], strs[1], strs[2] = "", "", ""
+	}
+	body := stringPoolChunk(strs, utf8)
+	body = append(body, resourceMapChunk(attrVersionCode, attrVersionName, attrMinSdkVersion)...)
+	body = append(body, startElementChunk(4,
+		testAttribute{name: 0, rawValue: noIndex, dataType: valueIntDec, data: 120},
+		testAttribute{name: 1, rawValue: 7, dataType: valueString, data: 7},
+		testAttribute{name: 3, rawValue: 6, dataType: valueString, data: 6},
+	)...)
+	body = append(body, startElementChunk(5,
+		testAttribute{name: 2, rawValue: noIndex, dataType: valueIntDec, data: 21},
+	)...)
+
+	header := binary.LittleEndian.AppendUint16(nil, chunkXML)
+	header = binary.LittleEndian.AppendUint16(header, 8)
+	header = binary.LittleEndian.AppendUint32(header, uint32(8+len(body)))
+	return append(header, body...)
+}
+
+// testCertificate returns a self-signed DER certificate.
+func testCertificate(t *testing.T, name string) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return der
+}
+
+// pkcs7Signature encodes a PKCS #7 SignedData holding certificate, as in META-INF/CERT.RSA.
+func pkcs7Signature(t *testing.T, certificate []byte) []byte {
+	emptySet := asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true}
+	content, err := asn1.Marshal(struct{ ContentType asn1.ObjectIdentifier }{asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}})
+	require.NoError(t, err)
+	signedData, err := asn1.Marshal(struct {
+		Version          int
+		DigestAlgorithms asn1.RawValue
+		ContentInfo      asn1.RawValue
+		Certificates     asn1.RawValue
+		SignerInfos      asn1.RawValue
+	}{
+		Version:          1,
+		DigestAlgorithms: emptySet,
+		ContentInfo:      asn1.RawValue{FullBytes: content},
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: certificate},
+		SignerInfos:      emptySet,
+	})
+	require.NoError(t, err)
+	signature, err := asn1.Marshal(struct {
+		ContentType asn1.ObjectIdentifier
+		Content     asn1.RawValue
+	}{
+		ContentType: asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2},
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: signedData},
+	})
+	require.NoError(t, err)
+	return signature
+}
+
+// buildAPK zips the entries into an APK.
+func buildAPK(t *testing.T, entries map[string][]byte) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, name := range []string{"AndroidManifest.xml", "classes.dex", "META-INF/CERT.RSA"} {
+		data, ok := entries[name]
+		if !ok {
+			continue
+		}
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write(data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func lengthPrefix(parts ...[]byte) []byte {
+	var data []byte
+	for _, part := range parts {
+		data = append(data, part...)
+	}
+	return append(binary.LittleEndian.AppendUint32(nil, uint32(len(data))), data...)
+}
+
+// withSigningBlock inserts an APK Signing Block with a v2 signature by certificate before the central
+// directory of apk.
+func withSigningBlock(apk, certificate []byte) []byte {
+	signedData := append(lengthPrefix(), lengthPrefix(lengthPrefix(certificate))...)
+	signer := append(lengthPrefix(signedData), append(lengthPrefix(), lengthPrefix()...)...)
+	value := lengthPrefix(lengthPrefix(signer))
+
+	pairs := binary.LittleEndian.AppendUint64(nil, uint64(len(value)+4))
+	pairs = binary.LittleEndian.AppendUint32(pairs, blockIDSignatureV2)
+	pairs = append(pairs, value...)
+	blockSize := uint64(len(pairs) + 24)
+	block := binary.LittleEndian.AppendUint64(nil, blockSize)
+	block = append(block, pairs...)
+	block = binary.LittleEndian.AppendUint64(block, blockSize)
+	block = append(block, signingBlockMagic...)
+
+	eocd := len(apk) - eocdMinSize
+	centralDirectory := int(binary.LittleEndian.Uint32(apk[eocd+16:]))
+	signed := append(append(append([]byte{}, apk[:centralDirectory]...), block...), apk[centralDirectory:]...)
+	binary.LittleEndian.PutUint32(signed[len(signed)-eocdMinSize+16:], uint32(centralDirectory+len(block)))
+	return signed
+}
+
+func digest(certificate []byte) string {
+	sum := sha256.Sum256(certificate)
+	return hex.EncodeToString(sum[:])
+}
+
+// TestInspect_V1Signature tests reading the manifest and the certificate of a JAR signed APK
+func TestInspect_V1Signature(t *testing.T) {
+	certificate := testCertificate(t, "happy")
+	apk := buildAPK(t, map[string][]byte{
+		"AndroidManifest.xml": binaryManifest(false, false),
+		"classes.dex":         []byte("dex\n035"),
+		"META-INF/CERT.RSA":   pkcs7Signature(t, certificate),
+	})
+
+	manifest, err := InspectBytes(apk)
+
+	require.NoError(t, err)
+	assert.Equal(t, &Manifest{
+		PackageName:       "com.happy.elimination",
+		VersionCode:       120,
+		VersionName:       "1.2.0",
+		MinSdkVersion:     21,
+		CertificateSHA256: digest(certificate),
+	}, manifest)
+}
+
+// TestInspect_SigningBlock tests that the v2 signature is preferred over the v1 one
+func TestInspect_SigningBlock(t *testing.T) {
+	v1Certificate, v2Certificate := testCertificate(t, "v1"), testCertificate(t, "v2")
+	apk := buildAPK(t, map[string][]byte{
+		"AndroidManifest.xml": binaryManifest(false, false),
+		"META-INF/CERT.RSA":   pkcs7Signature(t, v1Certificate),
+	})
+
+	manifest, err := InspectBytes(withSigningBlock(apk, v2Certificate))
+
+	require.NoError(t, err)
+	assert.Equal(t, "com.happy.elimination", manifest.PackageName)
+	assert.Equal(t, digest(v2Certificate), manifest.CertificateSHA256)
+}
+
+// TestInspect_ObfuscatedUTF8 tests a UTF-8 manifest whose android: attributes are only known by resource ID
+func TestInspect_ObfuscatedUTF8(t *testing.T) {
+	apk := buildAPK(t, map[string][]byte{"AndroidManifest.xml": binaryManifest(true, true)})
+
+	manifest, err := InspectBytes(apk)
+
+	require.NoError(t, err)
+	assert.Equal(t, &Manifest{PackageName: "com.happy.elimination", VersionCode: 120, VersionName: "1.2.0", MinSdkVersion: 21}, manifest)
+}
+
+// TestInspect_Invalid tests that broken input is rejected with ErrInvalidAPK
+func TestInspect_Invalid(t *testing.T) {
+	truncated := binaryManifest(false, false)
+	truncated = truncated[:len(truncated)-10]
+
+	for name, data := range map[string][]byte{
+		"not a zip":          []byte("not an apk"),
+		"no manifest":        buildAPK(t, map[string][]byte{"classes.dex": []byte("dex\n035")}),
+		"text manifest":      buildAPK(t, map[string][]byte{"AndroidManifest.xml": []byte("<manifest/>")}),
+		"truncated manifest": buildAPK(t, map[string][]byte{"AndroidManifest.xml": truncated}),
+	} {
+		_, err := InspectBytes(data)
+		assert.True(t, errors.Is(err, ErrInvalidAPK), name)
+	}
+}
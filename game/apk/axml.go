@@ -0,0 +1,277 @@
+package apk
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode/utf16"
+)
+
+// Chunk types of the Android binary XML format.
+const (
+	chunkStringPool   = 0x0001
+	chunkXML          = 0x0003
+	chunkStartElement = 0x0102
+	chunkResourceMap  = 0x0180
+)
+
+// Resource IDs of the android: attributes read from the manifest. Obfuscated APKs may strip attribute
+// names, so attributes are matched by resource ID first.
+const (
+	attrMinSdkVersion = 0x0101020c
+	attrVersionCode   = 0x0101021b
+	attrVersionName   = 0x0101021c
+)
+
+// Types of typed attribute values.
+const (
+	valueReference = 0x01
+	valueString    = 0x03
+	valueIntDec    = 0x10
+	valueIntHex    = 0x11
+)
+
+const (
+	stringPoolUTF8 = 1 << 8
+	noIndex        = 0xffffffff
+)
+
+var errTruncated = errors.New("truncated binary xml")
+
+// xmlAttribute is an attribute of a start element.
+type xmlAttribute struct {
+	name       string
+	resourceID uint32
+	rawValue   uint32
+	dataType   uint8
+	data       uint32
+}
+
+// parseManifest reads the fields of Manifest from a binary AndroidManifest.xml.
+func parseManifest(data []byte) (*Manifest, error) {
+	if len(data) < 8 || binary.LittleEndian.Uint16(data) != chunkXML {
+		return nil, errors.New("AndroidManifest.xml is not binary xml")
+	}
+
+	var strings []string
+	var resourceIDs []uint32
+	manifest := &Manifest{}
+	found := false
+	for offset := int(binary.LittleEndian.Uint16(data[2:])); offset < len(data); {
+		if offset+8 > len(data) {
+			return nil, errTruncated
+		}
+		chunkType := binary.LittleEndian.Uint16(data[offset:])
+		chunkSize := int(binary.LittleEndian.Uint32(data[offset+4:]))
+		if chunkSize < 8 || offset+chunkSize > len(data) {
+			return nil, errTruncated
+		}
+		chunk := data[offset : offset+chunkSize]
+		offset += chunkSize
+
+		switch chunkType {
+		case chunkStringPool:
+			pool, err := parseStringPool(chunk)
+			if err != nil {
+				return nil, err
+			}
+			strings = pool
+		case chunkResourceMap:
+			headerSize := int(binary.LittleEndian.Uint16(chunk[2:]))
+			for i := headerSize; i+4 <= len(chunk); i += 4 {
+				resourceIDs = append(resourceIDs, binary.LittleEndian.Uint32(chunk[i:]))
+			}
+		case chunkStartElement:
+			name, attributes, err := parseStartElement(chunk, strings, resourceIDs)
+			if err != nil {
+				return nil, err
+			}
+			switch name {
+			case "manifest":
+				found = true
+				for _, attr := range attributes {
+					switch {
+					case attr.resourceID == attrVersionCode || attr.resourceID == 0 && attr.name == "versionCode":
+						manifest.VersionCode = attributeUint32(attr, strings)
+					case attr.resourceID == attrVersionName || attr.resourceID == 0 && attr.name == "versionName":
+						manifest.VersionName = attributeString(attr, strings)
+					case attr.resourceID == 0 && attr.name == "package":
+						manifest.PackageName = attributeString(attr, strings)
+					}
+				}
+			case "uses-sdk":
+				for _, attr := range attributes {
+					if attr.resourceID == attrMinSdkVersion || attr.resourceID == 0 && attr.name == "minSdkVersion" {
+						// a codename such as "Tiramisu" is a preview SDK and has no number
+						if n, err := strconv.ParseInt(attributeString(attr, strings), 10, 32); err == nil {
+							manifest.MinSdkVersion = int32(n)
+						}
+					}
+				}
+			}
+		}
+	}
+	if !found {
+		return nil, errors.New("AndroidManifest.xml has no manifest element")
+	}
+	if manifest.PackageName == "" {
+		return nil, errors.New("AndroidManifest.xml has no package name")
+	}
+	return manifest, nil
+}
+
+// parseStringPool decodes every string of a string pool chunk.
+func parseStringPool(chunk []byte) ([]string, error) {
+	if len(chunk) < 28 {
+		return nil, errTruncated
+	}
+	headerSize := int(binary.LittleEndian.Uint16(chunk[2:]))
+	count := int(binary.LittleEndian.Uint32(chunk[8:]))
+	flags := binary.LittleEndian.Uint32(chunk[16:])
+	stringsStart := int(binary.LittleEndian.Uint32(chunk[20:]))
+	if count < 0 || headerSize+count*4 > len(chunk) || stringsStart > len(chunk) {
+		return nil, errTruncated
+	}
+
+	pool := make([]string, count)
+	for i := 0; i < count; i++ {
+		pos := stringsStart + int(binary.LittleEndian.Uint32(chunk[headerSize+i*4:]))
+		var s string
+		var err error
+		if flags&stringPoolUTF8 != 0 {
+			s, err = decodeUTF8String(chunk, pos)
+		} else {
+			s, err = decodeUTF16String(chunk, pos)
+		}
+		if err != nil {
+			return nil, err
+		}
+		pool[i] = s
+	}
+	return pool, nil
+}
+
+// decodeUTF8String reads a string of a UTF-8 pool: its length in UTF-16 units, its length in bytes, then
+// the bytes. Each length takes two bytes when its high bit is set.
+func decodeUTF8String(chunk []byte, pos int) (string, error) {
+	readLength := func() (int, error) {
+		if pos < 0 || pos >= len(chunk) {
+			return 0, errTruncated
+		}
+		n := int(chunk[pos])
+		pos++
+		if n&0x80 != 0 {
+			if pos >= len(chunk) {
+				return 0, errTruncated
+			}
+			n = (n&0x7f)<<8 | int(chunk[pos])
+			pos++
+		}
+		return n, nil
+	}
+	if _, err := readLength(); err != nil {
+		return "", err
+	}
+	n, err := readLength()
+	if err != nil {
+		return "", err
+	}
+	if pos+n > len(chunk) {
+		return "", errTruncated
+	}
+	return string(chunk[pos : pos+n]), nil
+}
+
+// decodeUTF16String reads a string of a UTF-16 pool: its length in units, two units long when its high
+// bit is set, then the units.
+func decodeUTF16String(chunk []byte, pos int) (string, error) {
+	if pos < 0 || pos+2 > len(chunk) {
+		return "", errTruncated
+	}
+	n := int(binary.LittleEndian.Uint16(chunk[pos:]))
+	pos += 2
+	if n&0x8000 != 0 {
+		if pos+2 > len(chunk) {
+			return "", errTruncated
+		}
+		n = (n&0x7fff)<<16 | int(binary.LittleEndian.Uint16(chunk[pos:]))
+		pos += 2
+	}
+	if pos+n*2 > len(chunk) {
+		return "", errTruncated
+	}
+	units := make([]uint16, n)
+	for i := range units {
+		units[i] = binary.LittleEndian.Uint16(chunk[pos+i*2:])
+	}
+	return string(utf16.Decode(units)), nil
+}
+
+// parseStartElement returns the name and attributes of a start element chunk.
+func parseStartElement(chunk []byte, strings []string, resourceIDs []uint32) (string, []xmlAttribute, error) {
+	headerSize := int(binary.LittleEndian.Uint16(chunk[2:]))
+	// the attribute extension follows the header: ns, name, attributeStart, attributeSize, attributeCount
+	if headerSize+20 > len(chunk) {
+		return "", nil, errTruncated
+	}
+	ext := chunk[headerSize:]
+	name := lookupString(strings, binary.LittleEndian.Uint32(ext[4:]))
+	attributeStart := int(binary.LittleEndian.Uint16(ext[8:]))
+	attributeSize := int(binary.LittleEndian.Uint16(ext[10:]))
+	attributeCount := int(binary.LittleEndian.Uint16(ext[12:]))
+	if attributeSize < 20 || headerSize+attributeStart+attributeCount*attributeSize > len(chunk) {
+		return "", nil, errTruncated
+	}
+
+	attributes := make([]xmlAttribute, 0, attributeCount)
+	for i := 0; i < attributeCount; i++ {
+		a := ext[attributeStart+i*attributeSize:]
+		nameIndex := binary.LittleEndian.Uint32(a[4:])
+		attr := xmlAttribute{
+			name:     lookupString(strings, nameIndex),
+			rawValue: binary.LittleEndian.Uint32(a[8:]),
+			dataType: a[15],
+			data:     binary.LittleEndian.Uint32(a[16:]),
+		}
+		if int(nameIndex) < len(resourceIDs) {
+			attr.resourceID = resourceIDs[nameIndex]
+		}
+		attributes = append(attributes, attr)
+	}
+	return name, attributes, nil
+}
+
+// attributeString returns the value of an attribute as a string.
+func attributeString(attr xmlAttribute, strings []string) string {
+	switch attr.dataType {
+	case valueString:
+		return lookupString(strings, attr.data)
+	case valueIntDec:
+		return strconv.FormatInt(int64(int32(attr.data)), 10)
+	case valueIntHex:
+		return fmt.Sprintf("0x%08x", attr.data)
+	case valueReference:
+		return fmt.Sprintf("@0x%08x", attr.data)
+	}
+	if attr.rawValue != noIndex {
+		return lookupString(strings, attr.rawValue)
+	}
+	return ""
+}
+
+// attributeUint32 returns the value of an unsigned 32-bit attribute such as versionCode, 0 if it has none.
+func attributeUint32(attr xmlAttribute, strings []string) int64 {
+	if attr.dataType == valueIntDec || attr.dataType == valueIntHex {
+		return int64(attr.data)
+	}
+	n, _ := strconv.ParseUint(attributeString(attr, strings), 10, 32)
+	return int64(n)
+}
+
+func lookupString(strings []string, index uint32) string {
+	if index == noIndex || int(index) >= len(strings) {
+		return ""
+	}
+	return strings[index]
+}
@@ -0,0 +1,203 @@
+package apk
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+)
+
+// IDs of the signature schemes in the APK Signing Block.
+const (
+	blockIDSignatureV2 = 0x7109871a
+	blockIDSignatureV3 = 0xf05368c0
+)
+
+const (
+	eocdSignature      = 0x06054b50
+	eocdMinSize        = 22
+	maxZipCommentSize  = 0xffff
+	signingBlockMagic  = "APK Sig Block 42"
+	maxSigningBlock    = 64 << 20
+	maxV1SignatureSize = 1 << 20
+)
+
+// signingCertificate returns the DER encoded certificate the APK is signed with, or nil if it is not
+// signed. The APK Signing Block is preferred: APKs for newer devices may carry no v1 signature at all.
+func signingCertificate(r io.ReaderAt, size int64, archive *zip.Reader) ([]byte, error) {
+	block, err := readSigningBlock(r, size)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range []uint32{blockIDSignatureV3, blockIDSignatureV2} {
+		if value, ok := block[id]; ok {
+			return firstSignerCertificate(value)
+		}
+	}
+	return v1Certificate(archive)
+}
+
+// readSigningBlock returns the ID-value pairs of the APK Signing Block, which sits right before the
+// central directory, or nil when the APK has none.
+func readSigningBlock(r io.ReaderAt, size int64) (map[uint32][]byte, error) {
+	centralDirectory, err := centralDirectoryOffset(r, size)
+	if err != nil {
+		return nil, err
+	}
+	// the block ends with its size and the magic: uint64 size, "APK Sig Block 42"
+	if centralDirectory < 32 {
+		return nil, nil
+	}
+	footer := make([]byte, 24)
+	if _, err := r.ReadAt(footer, centralDirectory-24); err != nil {
+		return nil, err
+	}
+	if string(footer[8:]) != signingBlockMagic {
+		return nil, nil
+	}
+	blockSize := binary.LittleEndian.Uint64(footer)
+	if blockSize < 24 || blockSize > maxSigningBlock || int64(blockSize)+8 > centralDirectory {
+		return nil, errors.New("invalid apk signing block size")
+	}
+	// the pairs are between the leading size field and the footer
+	pairs := make([]byte, blockSize-24)
+	if _, err := r.ReadAt(pairs, centralDirectory-int64(blockSize)); err != nil {
+		return nil, err
+	}
+
+	block := make(map[uint32][]byte)
+	for len(pairs) > 0 {
+		if len(pairs) < 12 {
+			return nil, errors.New("truncated apk signing block")
+		}
+		length := binary.LittleEndian.Uint64(pairs)
+		if length < 4 || length > uint64(len(pairs)-8) {
+			return nil, errors.New("truncated apk signing block")
+		}
+		block[binary.LittleEndian.Uint32(pairs[8:])] = pairs[12 : 8+length]
+		pairs = pairs[8+length:]
+	}
+	return block, nil
+}
+
+// centralDirectoryOffset finds the end of central directory record and returns where the central
+// directory starts.
+func centralDirectoryOffset(r io.ReaderAt, size int64) (int64, error) {
+	tailSize := int64(eocdMinSize + maxZipCommentSize)
+	if tailSize > size {
+		tailSize = size
+	}
+	tail := make([]byte, tailSize)
+	if _, err := r.ReadAt(tail, size-tailSize); err != nil {
+		return 0, err
+	}
+	for i := len(tail) - eocdMinSize; i >= 0; i-- {
+		if binary.LittleEndian.Uint32(tail[i:]) == eocdSignature {
+			return int64(binary.LittleEndian.Uint32(tail[i+16:])), nil
+		}
+	}
+	return 0, errors.New("end of central directory not found")
+}
+
+// firstSignerCertificate returns the first certificate of the first signer of a v2 or v3 signature.
+// Every level is a sequence of uint32 length-prefixed values:
+// signers > signer > signed data > (digests, certificates) > certificate.
+func firstSignerCertificate(value []byte) ([]byte, error) {
+	signers, err := lengthPrefixed(value)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := lengthPrefixed(signers)
+	if err != nil {
+		return nil, err
+	}
+	signedData, err := lengthPrefixed(signer)
+	if err != nil {
+		return nil, err
+	}
+	digests, err := lengthPrefixed(signedData)
+	if err != nil {
+		return nil, err
+	}
+	certificates, err := lengthPrefixed(signedData[4+len(digests):])
+	if err != nil {
+		return nil, err
+	}
+	return lengthPrefixed(certificates)
+}
+
+// lengthPrefixed returns the first uint32 length-prefixed value of data.
+func lengthPrefixed(data []byte) ([]byte, error) {
+	if len(data) < 4 {
+		return nil, errors.New("truncated apk signature")
+	}
+	n := binary.LittleEndian.Uint32(data)
+	if uint64(n) > uint64(len(data)-4) {
+		return nil, errors.New("truncated apk signature")
+	}
+	return data[4 : 4+n], nil
+}
+
+// v1Certificate returns the signing certificate of a JAR signed APK, read from the PKCS #7 signature
+// block under META-INF, or nil when there is none.
+func v1Certificate(archive *zip.Reader) ([]byte, error) {
+	for _, f := range archive.File {
+		name := strings.ToUpper(f.Name)
+		if !strings.HasPrefix(name, "META-INF/") || strings.Count(name, "/") != 1 {
+			continue
+		}
+		if !strings.HasSuffix(name, ".RSA") && !strings.HasSuffix(name, ".DSA") && !strings.HasSuffix(name, ".EC") {
+			continue
+		}
+		data, err := readEntry(f, maxV1SignatureSize)
+		if err != nil {
+			return nil, err
+		}
+		return pkcs7Certificate(data)
+	}
+	return nil, nil
+}
+
+// pkcs7ContentInfo and pkcs7SignedData are the parts of RFC 2315 needed to reach the certificates.
+type pkcs7ContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,optional,tag:0"`
+}
+
+type pkcs7SignedData struct {
+	Version          int
+	DigestAlgorithms asn1.RawValue
+	ContentInfo      asn1.RawValue
+	Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+}
+
+// pkcs7Certificate returns the first certificate of a PKCS #7 SignedData structure.
+func pkcs7Certificate(data []byte) ([]byte, error) {
+	var contentInfo pkcs7ContentInfo
+	if _, err := asn1.Unmarshal(data, &contentInfo); err != nil {
+		return nil, err
+	}
+	var signedData pkcs7SignedData
+	if _, err := asn1.Unmarshal(contentInfo.Content.Bytes, &signedData); err != nil {
+		return nil, err
+	}
+	if len(signedData.Certificates.Bytes) == 0 {
+		return nil, errors.New("the v1 signature holds no certificate")
+	}
+	var certificate asn1.RawValue
+	if _, err := asn1.Unmarshal(signedData.Certificates.Bytes, &certificate); err != nil {
+		return nil, err
+	}
+	return bytes.Clone(certificate.FullBytes), nil
+}
+
+// certificateDigest returns the SHA-256 digest of a certificate in lowercase hex, as apksigner prints it.
+func certificateDigest(certificate []byte) string {
+	sum := sha256.Sum256(certificate)
+	return hex.EncodeToString(sum[:])
+}
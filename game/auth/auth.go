@@ -0,0 +1,55 @@
+// Package auth carries the identity of the caller from the gateway to the game service.
+//
+// The gateway authenticates the CP from a signed bearer token (see VerifyToken) and puts its ID into the
+// RPC metadata with WithCpID; the game service reads it back with CpIDFromContext. Identity is never taken
+// from request bodies or unsigned headers.
+package auth
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+)
+
+// RPC 元信息中的身份 key
+const (
+	// MetaKeyCpID 调用方 CP ID
+	MetaKeyCpID = "CP_ID"
+	// MetaKeyAdmin 调用方管理员账号
+	MetaKeyAdmin = "ADMIN"
+)
+
+// WithCpID returns a context that sends cpID as the acting CP on the next RPC.
+func WithCpID(ctx context.Context, cpID uint64) context.Context {
+	return metainfo.WithValue(ctx, MetaKeyCpID, strconv.FormatUint(cpID, 10))
+}
+
+// WithAdmin returns a context that sends admin as the acting platform admin on the next RPC.
+func WithAdmin(ctx context.Context, admin string) context.Context {
+	return metainfo.WithValue(ctx, MetaKeyAdmin, admin)
+}
+
+// AdminFromContext returns the acting platform admin set by the caller. ok is false when the caller is
+// not an admin.
+func AdminFromContext(ctx context.Context) (admin string, ok bool) {
+	admin, ok = metainfo.GetValue(ctx, MetaKeyAdmin)
+	if !ok || admin == "" {
+		return "", false
+	}
+	return admin, true
+}
+
+// CpIDFromContext returns the acting CP set by the caller. ok is false when the caller did not set
+// one or set something that is not a CP ID.
+func CpIDFromContext(ctx context.Context) (cpID uint64, ok bool) {
+	value, ok := metainfo.GetValue(ctx, MetaKeyCpID)
+	if !ok {
+		return 0, false
+	}
+	cpID, err := strconv.ParseUint(value, 10, 64)
+	if err != nil || cpID == 0 {
+		return 0, false
+	}
+	return cpID, true
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestVerifyToken_RoundTrip tests that a signed token verifies and gives back its claims
+func TestVerifyToken_RoundTrip(t *testing.T) {
+	secret := []byte("test-secret")
+	now := time.Unix(1700000000, 0)
+	token, err := SignToken(secret, &Claims{CpID: 1001, ExpiresAt: now.Add(time.Hour).Unix()})
+	assert.NoError(t, err)
+
+	claims, err := VerifyToken(secret, token, now)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1001), claims.CpID)
+	assert.Empty(t, claims.Admin)
+
+	token, err = SignToken(secret, &Claims{Admin: "ops_alice", ExpiresAt: now.Add(time.Hour).Unix()})
+	assert.NoError(t, err)
+	claims, err = VerifyToken(secret, token, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "ops_alice", claims.Admin)
+	assert.Zero(t, claims.CpID)
+}
+
+// TestVerifyToken_Rejected tests that forged, tampered, expired and unsigned tokens are refused
+func TestVerifyToken_Rejected(t *testing.T) {
+	secret := []byte("test-secret")
+	now := time.Unix(1700000000, 0)
+	token, err := SignToken(secret, &Claims{CpID: 1001, ExpiresAt: now.Add(time.Hour).Unix()})
+	assert.NoError(t, err)
+	forged, err := SignToken([]byte("other-secret"), &Claims{CpID: 1001, ExpiresAt: now.Add(time.Hour).Unix()})
+	assert.NoError(t, err)
+	otherCp, err := SignToken(secret, &Claims{CpID: 2002, ExpiresAt: now.Add(time.Hour).Unix()})
+	assert.NoError(t, err)
+	noExpiry, err := SignToken(secret, &Claims{CpID: 1001})
+	assert.NoError(t, err)
+
+	// the payload of another CP's token with this token's signature
+	payload, _, _ := strings.Cut(otherCp, ".")
+	_, signature, _ := strings.Cut(token, ".")
+	tampered := payload + "." + signature
+
+	for name, tok := range map[string]string{
+		"forged":     forged,
+		"tampered":   tampered,
+		"no expiry":  noExpiry,
+		"malformed":  "not-a-token",
+		"empty":      "",
+		"no payload": "." + signature,
+	} {
+		_, err := VerifyToken(secret, tok, now)
+		assert.True(t, errors.Is(err, ErrInvalidToken), name)
+	}
+
+	_, err = VerifyToken(secret, token, now.Add(time.Hour))
+	assert.True(t, errors.Is(err, ErrTokenExpired))
+
+	_, err = VerifyToken(nil, token, now)
+	assert.True(t, errors.Is(err, ErrInvalidToken))
+}
@@ -0,0 +1,58 @@
+// Command rebuild_search_index rebuilds the SearchGames index from the database.
+//
+// The running game service keeps the index in memory and saves it periodically, so stop the
+// service before rebuilding, or it will overwrite the rebuilt file with its own copy:
+//
+//	go run ./cmd/rebuild_search_index -config script/config.yaml
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/GameLaunchPad/game_management_project/game/config"
+	"github.com/GameLaunchPad/game_management_project/game/dal"
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/search"
+	"github.com/GameLaunchPad/game_management_project/game/service"
+)
+
+func main() {
+	configPath := flag.String("config", "script/config.yaml", "path of the game service config")
+	indexPath := flag.String("index", "", "path of the index file, defaults to search.index_path of the config")
+	flag.Parse()
+
+	if err := config.Init(*configPath); err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+	if *indexPath == "" {
+		*indexPath = config.SearchIndexPath()
+	}
+
+	ctx := context.Background()
+	dal.InitClient(ctx)
+
+	index, err := search.Open(*indexPath)
+	if err != nil {
+		// a corrupt file is exactly what a rebuild is for, start over from an empty index
+		log.Printf("discarding unreadable search index: %v", err)
+		if err := os.Remove(*indexPath); err != nil {
+			log.Fatalf("failed to remove search index: %v", err)
+		}
+		if index, err = search.Open(*indexPath); err != nil {
+			log.Fatalf("failed to open search index: %v", err)
+		}
+	}
+
+	count, err := service.RebuildSearchIndex(ctx, dao.NewGameDAO(), index)
+	if err != nil {
+		log.Fatalf("failed to rebuild search index: %v", err)
+	}
+	// mark the file complete, or the service would rebuild it again on start
+	if err := index.Close(); err != nil {
+		log.Fatalf("failed to save search index: %v", err)
+	}
+	log.Printf("rebuilt search index %s with %d games", *indexPath, count)
+}
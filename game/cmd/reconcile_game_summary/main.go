@@ -0,0 +1,45 @@
+// Command reconcile_game_summary finds games whose summary columns in gp_game, the name, icon and other
+// fields shown by GetGameList, no longer match the version they mirror, and rewrites them from it.
+//
+// The game service keeps the columns in sync on every publish, so drift is left over from rows written
+// before it did, or from manual edits of the database. Run with -dry-run first to only list the drift:
+//
+//	go run ./cmd/reconcile_game_summary -config script/config.yaml -dry-run
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"strings"
+
+	"github.com/GameLaunchPad/game_management_project/game/config"
+	"github.com/GameLaunchPad/game_management_project/game/dal"
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/service"
+)
+
+func main() {
+	configPath := flag.String("config", "script/config.yaml", "path of the game service config")
+	dryRun := flag.Bool("dry-run", false, "only list the drifted games, without repairing them")
+	flag.Parse()
+
+	if err := config.Init(*configPath); err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	ctx := context.Background()
+	dal.InitClient(ctx)
+
+	count, err := service.ReconcileGameSummaries(ctx, dao.NewGameDAO(), !*dryRun, func(gameID uint64, columns []string) {
+		log.Printf("game %d: %s", gameID, strings.Join(columns, ", "))
+	})
+	if err != nil {
+		log.Fatalf("failed to reconcile game summaries: %v", err)
+	}
+	if *dryRun {
+		log.Printf("found %d drifted games", count)
+	} else {
+		log.Printf("repaired %d drifted games", count)
+	}
+}
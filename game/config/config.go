@@ -5,7 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/GameLaunchPad/game_management_project/game/constdef"
 )
 
 var GlobalConfig *Config
@@ -14,6 +18,43 @@ type Config struct {
 	MySQL struct {
 		DSN string `yaml:"dsn" json:"dsn"`
 	} `yaml:"mysql" json:"mysql"`
+	Trash struct {
+		RetentionDays int `yaml:"retention_days" json:"retention_days"`
+	} `yaml:"trash" json:"trash"`
+	Search struct {
+		IndexPath string `yaml:"index_path" json:"index_path"`
+	} `yaml:"search" json:"search"`
+	Apk struct {
+		UploadDir string `yaml:"upload_dir" json:"upload_dir"`
+	} `yaml:"apk" json:"apk"`
+}
+
+// TrashRetention returns how long a deleted draft stays restorable, falling back to the default
+// when the config is not loaded or does not set trash.retention_days.
+func TrashRetention() time.Duration {
+	days := constdef.DefaultTrashRetentionDays
+	if GlobalConfig != nil && GlobalConfig.Trash.RetentionDays > 0 {
+		days = GlobalConfig.Trash.RetentionDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// SearchIndexPath returns where the search index is persisted, falling back to the default when the
+// config is not loaded or does not set search.index_path.
+func SearchIndexPath() string {
+	if GlobalConfig != nil && GlobalConfig.Search.IndexPath != "" {
+		return GlobalConfig.Search.IndexPath
+	}
+	return constdef.DefaultSearchIndexPath
+}
+
+// ApkUploadDir returns the directory SubmitGameVersion may read APKs from by path, or "" when apk.upload_dir
+// is not set and APKs can only be sent in the request.
+func ApkUploadDir() string {
+	if GlobalConfig == nil {
+		return ""
+	}
+	return GlobalConfig.Apk.UploadDir
 }
 
 func Init(path string) error {
@@ -74,6 +115,19 @@ func parseSimpleYAML(data []byte, cfg *Config) error {
 				if currentSection == "mysql" && key == "dsn" {
 					cfg.MySQL.DSN = value
 				}
+				if currentSection == "trash" && key == "retention_days" {
+					days, err := strconv.Atoi(value)
+					if err != nil {
+						return fmt.Errorf("invalid trash.retention_days %q: %w", value, err)
+					}
+					cfg.Trash.RetentionDays = days
+				}
+				if currentSection == "search" && key == "index_path" {
+					cfg.Search.IndexPath = value
+				}
+				if currentSection == "apk" && key == "upload_dir" {
+					cfg.Apk.UploadDir = value
+				}
 			}
 		}
 	}
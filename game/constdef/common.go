@@ -1,5 +1,30 @@
 package constdef
 
+import "time"
+
 const (
 	IDWorkers = 6
+
+	// PublishSchedulerInterval 定时发布扫描间隔
+	PublishSchedulerInterval = 10 * time.Second
+	// SearchIndexSaveInterval 搜索索引落盘间隔，写入只改内存，由后台按此间隔保存
+	SearchIndexSaveInterval = 30 * time.Second
+
+	// DefaultTrashRetentionDays 已删除草稿在回收站中的默认保留天数，可通过配置 trash.retention_days 覆盖
+	DefaultTrashRetentionDays = 30
+
+	// MaxBatchGetGameDetails BatchGetGameDetails 单次最多查询的游戏数
+	MaxBatchGetGameDetails = 100
+
+	// DefaultSearchIndexPath 搜索索引文件的默认路径，可通过配置 search.index_path 覆盖
+	DefaultSearchIndexPath = "data/search/games.idx"
+
+	// SearchIndexRebuildBatchSize 重建搜索索引时每批读取的游戏数
+	SearchIndexRebuildBatchSize = 500
+
+	// GameSummaryReconcileBatchSize 核对游戏摘要字段时每批读取的游戏数
+	GameSummaryReconcileBatchSize = 500
+
+	// MaxApkFileSize 提交审核时随请求上传的 APK 的大小上限，更大的安装包通过 apk.upload_dir 下的路径提交
+	MaxApkFileSize = 512 << 20
 )
@@ -0,0 +1,18 @@
+package constdef
+
+// 游戏运营操作类型，对应 gp_game_operation_log.operation_type
+const (
+	GameOperationRollback = 1 // 回滚上线版本
+	GameOperationTakedown = 2 // 下架游戏
+	GameOperationRestore  = 3 // 恢复下架游戏
+	// GameOperationReleasePackage 管理员释放包名占用，记录在原占用游戏上
+	GameOperationReleasePackage = 4
+	// GameOperationTransferPackage 管理员将包名占用转移给其他游戏，记录在原占用游戏上
+	GameOperationTransferPackage = 5
+)
+
+// 包名占用状态，对应 gp_package_claim.state
+const (
+	PackageClaimReserved = 1 // 版本提交审核时预留
+	PackageClaimLocked   = 2 // 版本发布时锁定
+)
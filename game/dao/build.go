@@ -0,0 +1,33 @@
+package dao
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+)
+
+// PlatformBuild is the build of a version for one platform, as stored in gp_game_version.builds.
+type PlatformBuild struct {
+	Platform     int    `json:"platform"`
+	Identifier   string `json:"identifier,omitempty"`
+	VersionName  string `json:"version_name,omitempty"`
+	VersionCode  int64  `json:"version_code,omitempty"`
+	DownloadURL  string `json:"download_url,omitempty"`
+	MinOSVersion string `json:"min_os_version,omitempty"`
+	FileSize     int64  `json:"file_size,omitempty"`
+	SHA256       string `json:"sha256,omitempty"`
+}
+
+// ParseBuilds parses the builds column of a version. An empty column holds no builds: the version was
+// written before builds existed and only has package_name and download_url.
+func ParseBuilds(version *ddl.GpGameVersion) ([]*PlatformBuild, error) {
+	if version.Builds == "" {
+		return nil, nil
+	}
+	var builds []*PlatformBuild
+	if err := json.Unmarshal([]byte(version.Builds), &builds); err != nil {
+		return nil, fmt.Errorf("failed to parse builds of version %d: %w", version.Id, err)
+	}
+	return builds, nil
+}
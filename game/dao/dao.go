@@ -4,14 +4,50 @@ import (
 	"context"
 
 	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
 )
 
 // IGameDAO defines the interface for game data access operations.
 type IGameDAO interface {
 	CreateGame(ctx context.Context, game *ddl.GpGame, version *ddl.GpGameVersion) error
-	UpdateGameDraft(ctx context.Context, gameID uint64, version *ddl.GpGameVersion) error
-	GetGameList(ctx context.Context, filterText *string, pageNum, pageSize int) ([]*GameWithVersionStatus, int64, error)
+	UpdateGameDraft(ctx context.Context, gameID uint64, version *ddl.GpGameVersion, expectedRevision *int64) error
+	GetGameList(ctx context.Context, opts *GameListOptions, pageNum, pageSize int) ([]*GameWithVersionStatus, int64, error)
+	GetGameListAfter(ctx context.Context, opts *GameListOptions, after *GameListCursor, limit int) ([]*GameWithVersionStatus, error)
+	CountGames(ctx context.Context, opts *GameListOptions) (int64, error)
+	GetGame(ctx context.Context, gameID uint64) (*ddl.GpGame, error)
 	GetGameDetail(ctx context.Context, gameID uint64) (*ddl.GpGame, *ddl.GpGameVersion, *ddl.GpGameVersion, error)
-	ReviewGameVersion(ctx context.Context, gameID, versionID uint64, newStatus int, reviewComment string) error
-	DeleteGameDraft(ctx context.Context, gameID uint64) error
+	BatchGetGameDetails(ctx context.Context, gameIDs []uint64) (map[uint64]*GameDetailRecord, error)
+	ScanGamesWithNewestVersion(ctx context.Context, afterID uint64, limit int) ([]*GameWithNewestVersion, error)
+	ScanGameSummaries(ctx context.Context, afterID uint64, limit int) ([]*GameWithSummaryVersion, error)
+	RepairGameSummary(ctx context.Context, gameID uint64) ([]string, error)
+	ReviewGameVersion(ctx context.Context, gameID, versionID uint64, newStatus int, reviewLog *ddl.GpGameReviewLog) error
+	DeleteGameDraft(ctx context.Context, gameID uint64) (uint64, error)
+	ListGameVersions(ctx context.Context, gameID uint64, statuses []int, pageNum, pageSize int) ([]*ddl.GpGameVersion, int64, error)
+	RollbackGameVersion(ctx context.Context, gameID, versionID uint64, operationLog *ddl.GpGameOperationLog) error
+	ScheduleGameVersion(ctx context.Context, gameID, versionID uint64, publishAt int64, reviewLog *ddl.GpGameReviewLog) error
+	CancelScheduledPublish(ctx context.Context, gameID, versionID uint64) error
+	ListDueScheduledVersions(ctx context.Context, now int64) ([]*ddl.GpGameVersion, error)
+	PublishScheduledVersion(ctx context.Context, gameID, versionID uint64) error
+	ListGameReviewLogs(ctx context.Context, gameID, versionID uint64) ([]*ddl.GpGameReviewLog, error)
+	SubmitGameVersion(ctx context.Context, gameID, versionID uint64, expectedRevision int64, apkManifest string) error
+	WithdrawGameVersion(ctx context.Context, gameID, versionID uint64) error
+	GetGameVersion(ctx context.Context, gameID, versionID uint64) (*ddl.GpGameVersion, error)
+	TakedownGame(ctx context.Context, gameID uint64, operationLog *ddl.GpGameOperationLog) error
+	RestoreGame(ctx context.Context, gameID uint64, operationLog *ddl.GpGameOperationLog) error
+	GetLatestGameOperationLog(ctx context.Context, gameID uint64, operationType int) (*ddl.GpGameOperationLog, error)
+	GetLatestGameOperationLogs(ctx context.Context, gameIDs []uint64, operationType int) (map[uint64]*ddl.GpGameOperationLog, error)
+	ListDeletedGameDrafts(ctx context.Context, gameID uint64, deletedAfter int64) ([]*ddl.GpGameVersion, error)
+	ReleasePackageName(ctx context.Context, platform int, packageName string, transferToGameID uint64, operationLog *ddl.GpGameOperationLog) (uint64, error)
+	RestoreGameDraft(ctx context.Context, gameID, versionID uint64, deletedAfter int64) (*ddl.GpGameVersion, error)
+	CreateCategory(ctx context.Context, category *ddl.GpCategory) error
+	UpdateCategory(ctx context.Context, category *ddl.GpCategory) error
+	DeleteCategory(ctx context.Context, categoryID uint64) error
+	ListCategories(ctx context.Context) ([]*ddl.GpCategory, error)
+	CreateTag(ctx context.Context, tag *ddl.GpTag) error
+	UpdateTag(ctx context.Context, tagID uint64, name string) error
+	DeleteTag(ctx context.Context, tagID uint64) error
+	ListTags(ctx context.Context) ([]*ddl.GpTag, error)
+	StartGameRollout(ctx context.Context, gameID, versionID uint64, percentage int, reviewLog *ddl.GpGameReviewLog, rolloutLog *ddl.GpGameRolloutLog) error
+	UpdateGameRollout(ctx context.Context, gameID, versionID uint64, action game.RolloutAction, percentage int, rolloutLog *ddl.GpGameRolloutLog) (*ddl.GpGame, error)
+	ListGameRolloutLogs(ctx context.Context, gameID uint64) ([]*ddl.GpGameRolloutLog, error)
 }
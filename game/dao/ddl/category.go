@@ -0,0 +1,17 @@
+package ddl
+
+import "time"
+
+// 游戏分类，树形结构
+type GpCategory struct {
+	Id        uint64    `gorm:"column:id;type:bigint(20) unsigned;primary_key;comment:分类ID" json:"id"`
+	ParentId  uint64    `gorm:"column:parent_id;type:bigint(20) unsigned;default:0;comment:父分类ID，0表示顶层分类;NOT NULL" json:"parent_id"`
+	Name      string    `gorm:"column:name;type:varchar(64);comment:分类名，同一父分类下唯一;NOT NULL" json:"name"`
+	SortOrder int       `gorm:"column:sort_order;type:int(11);default:0;comment:同级排序，升序;NOT NULL" json:"sort_order"`
+	CreateTs  time.Time `gorm:"column:create_ts;type:timestamp;default:CURRENT_TIMESTAMP;comment:创建时间;NOT NULL" json:"create_ts"`
+	ModifyTs  time.Time `gorm:"column:modify_ts;type:timestamp;default:CURRENT_TIMESTAMP;comment:更新时间;NOT NULL" json:"modify_ts"`
+}
+
+func (m *GpCategory) TableName() string {
+	return "gp_category"
+}
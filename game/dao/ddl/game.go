@@ -16,6 +16,10 @@ type GpGame struct {
 	DownloadUrl            string    `gorm:"column:download_url;type:text;comment:游戏下载链接" json:"download_url"`
 	NewestGameVersionId    uint64    `gorm:"column:newest_game_version_id;type:bigint(20) unsigned;comment:最新游戏版本id" json:"newest_game_version_id"`
 	OnlineGameVersionId    uint64    `gorm:"column:online_game_version_id;type:bigint(20) unsigned;comment:上线游戏版本id" json:"online_game_version_id"`
+	TakedownVersionId      uint64    `gorm:"column:takedown_version_id;type:bigint(20) unsigned;default:0;comment:被下架的版本id，0表示未下架;NOT NULL" json:"takedown_version_id"`
+	RolloutVersionId       uint64    `gorm:"column:rollout_version_id;type:bigint(20) unsigned;default:0;comment:灰度中的版本id，0表示没有进行中的灰度;NOT NULL" json:"rollout_version_id"`
+	RolloutPercentage      int       `gorm:"column:rollout_percentage;type:int(11);default:0;comment:灰度比例 1-99;NOT NULL" json:"rollout_percentage"`
+	RolloutState           int       `gorm:"column:rollout_state;type:int(11);default:0;comment:灰度状态 0-无, 1-灰度中, 2-已暂停;NOT NULL" json:"rollout_state"`
 	CreateTs               time.Time `gorm:"column:create_ts;type:timestamp;default:CURRENT_TIMESTAMP;comment:创建时间;NOT NULL" json:"create_ts"`
 	ModifyTs               time.Time `gorm:"column:modify_ts;type:timestamp;default:CURRENT_TIMESTAMP;comment:更新时间;NOT NULL" json:"modify_ts"`
 }
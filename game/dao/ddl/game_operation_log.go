@@ -0,0 +1,19 @@
+package ddl
+
+import "time"
+
+// 游戏运营操作记录（只追加）
+type GpGameOperationLog struct {
+	Id            uint64    `gorm:"column:id;type:bigint(20) unsigned;primary_key;comment:记录ID" json:"id"`
+	GameId        uint64    `gorm:"column:game_id;type:bigint(20) unsigned;comment:游戏ID;NOT NULL" json:"game_id"`
+	OperationType int       `gorm:"column:operation_type;type:int(11);comment:操作类型 1-版本回滚, 2-下架, 3-恢复, 4-释放包名, 5-转移包名;NOT NULL" json:"operation_type"`
+	FromVersionId uint64    `gorm:"column:from_version_id;type:bigint(20) unsigned;default:0;comment:操作前上线版本id;NOT NULL" json:"from_version_id"`
+	ToVersionId   uint64    `gorm:"column:to_version_id;type:bigint(20) unsigned;default:0;comment:操作后上线版本id;NOT NULL" json:"to_version_id"`
+	Operator      string    `gorm:"column:operator;type:varchar(45);comment:操作人;NOT NULL" json:"operator"`
+	Reason        string    `gorm:"column:reason;type:text;comment:操作原因" json:"reason"`
+	CreateTs      time.Time `gorm:"column:create_ts;type:timestamp;default:CURRENT_TIMESTAMP;comment:创建时间;NOT NULL" json:"create_ts"`
+}
+
+func (m *GpGameOperationLog) TableName() string {
+	return "gp_game_operation_log"
+}
@@ -0,0 +1,22 @@
+package ddl
+
+import "time"
+
+// 游戏版本审核记录（只追加，重复审核不会覆盖之前的结论）
+type GpGameReviewLog struct {
+	Id            uint64    `gorm:"column:id;type:bigint(20) unsigned;primary_key;comment:记录ID" json:"id"`
+	GameId        uint64    `gorm:"column:game_id;type:bigint(20) unsigned;comment:游戏ID;NOT NULL" json:"game_id"`
+	GameVersionId uint64    `gorm:"column:game_version_id;type:bigint(20) unsigned;comment:版本ID;NOT NULL" json:"game_version_id"`
+	ReviewResult  int       `gorm:"column:review_result;type:int(11);comment:审核结论 1-通过, 2-拒绝;NOT NULL" json:"review_result"`
+	ResultStatus  int       `gorm:"column:result_status;type:int(11);comment:审核后版本状态;NOT NULL" json:"result_status"`
+	Remark        string    `gorm:"column:remark;type:text;comment:审核意见" json:"remark"`
+	Operator      string    `gorm:"column:operator;type:varchar(45);comment:审核人;NOT NULL" json:"operator"`
+	Meta          string    `gorm:"column:meta;type:text;comment:附加信息" json:"meta"`
+	PublishAt     int64     `gorm:"column:publish_at;type:bigint(20);default:0;comment:定时发布时间;NOT NULL" json:"publish_at"`
+	ReviewTime    int64     `gorm:"column:review_time;type:bigint(20);default:0;comment:审核时间;NOT NULL" json:"review_time"`
+	CreateTs      time.Time `gorm:"column:create_ts;type:timestamp;default:CURRENT_TIMESTAMP;comment:创建时间;NOT NULL" json:"create_ts"`
+}
+
+func (m *GpGameReviewLog) TableName() string {
+	return "gp_game_review_log"
+}
@@ -0,0 +1,20 @@
+package ddl
+
+import "time"
+
+// 游戏灰度发布操作记录（只追加）
+type GpGameRolloutLog struct {
+	Id             uint64    `gorm:"column:id;type:bigint(20) unsigned;primary_key;comment:记录ID" json:"id"`
+	GameId         uint64    `gorm:"column:game_id;type:bigint(20) unsigned;comment:游戏ID;NOT NULL" json:"game_id"`
+	GameVersionId  uint64    `gorm:"column:game_version_id;type:bigint(20) unsigned;comment:灰度版本ID;NOT NULL" json:"game_version_id"`
+	Action         int       `gorm:"column:action;type:int(11);comment:操作 1-开始, 2-提高比例, 3-暂停, 4-恢复, 5-回滚, 6-全量发布;NOT NULL" json:"action"`
+	FromPercentage int       `gorm:"column:from_percentage;type:int(11);default:0;comment:操作前灰度比例;NOT NULL" json:"from_percentage"`
+	ToPercentage   int       `gorm:"column:to_percentage;type:int(11);default:0;comment:操作后灰度比例;NOT NULL" json:"to_percentage"`
+	Operator       string    `gorm:"column:operator;type:varchar(45);comment:操作人;NOT NULL" json:"operator"`
+	Reason         string    `gorm:"column:reason;type:text;comment:操作原因" json:"reason"`
+	CreateTs       time.Time `gorm:"column:create_ts;type:timestamp;default:CURRENT_TIMESTAMP;comment:创建时间;NOT NULL" json:"create_ts"`
+}
+
+func (m *GpGameRolloutLog) TableName() string {
+	return "gp_game_rollout_log"
+}
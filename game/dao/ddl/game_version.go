@@ -14,10 +14,21 @@ type GpGameVersion struct {
 	Platform               string    `gorm:"column:platform;type:varchar(256);comment:游戏推广平台 0-unset, 1-android, 2-ios, 3-web,可以支持多端配置，为Json数组;NOT NULL" json:"platform"`
 	PackageName            string    `gorm:"column:package_name;type:varchar(256);comment:游戏包名（APP端使用）;NOT NULL" json:"package_name"`
 	DownloadUrl            string    `gorm:"column:download_url;type:text;comment:游戏下载链接" json:"download_url"`
-	Status                 int       `gorm:"column:status;type:int(11);comment:0-Unset, 1-草稿, 2-审核中, 3-已发布, 4-审核拒绝;NOT NULL" json:"status"`
+	Status                 int       `gorm:"column:status;type:int(11);comment:0-Unset, 1-草稿, 2-审核中, 3-已发布, 4-审核拒绝, 5-待定时发布, 6-已下架, 7-已删除;NOT NULL" json:"status"`
 	ReviewTime             int64     `gorm:"column:review_time;type:bigint(20);default:0;comment:审核时间;NOT NULL" json:"review_time"`
 	Operator               string    `gorm:"column:operator;type:varchar(45);comment:审核人;NOT NULL" json:"operator"`
 	ReviewComment          string    `gorm:"column:review_comment;type:text;comment:审核意见" json:"review_comment"`
+	PublishAt              int64     `gorm:"column:publish_at;type:bigint(20);default:0;comment:定时发布时间;NOT NULL" json:"publish_at"`
+	Revision               int64     `gorm:"column:revision;type:bigint(20);default:1;comment:修订号，每次写入递增;NOT NULL" json:"revision"`
+	CategoryId             uint64    `gorm:"column:category_id;type:bigint(20) unsigned;default:0;comment:所属分类ID，0表示未分类;NOT NULL" json:"category_id"`
+	TagIds                 string    `gorm:"column:tag_ids;type:varchar(1024);default:'[]';comment:标签ID，为Json数组;NOT NULL" json:"tag_ids"`
+	DefaultLocale          string    `gorm:"column:default_locale;type:varchar(16);default:zh-CN;comment:顶层商店信息所用的语言;NOT NULL" json:"default_locale"`
+	Listings               string    `gorm:"column:listings;type:mediumtext;comment:其他语言的商店信息，为Json对象，key为语言标签" json:"listings"`
+	ReleaseNotes           string    `gorm:"column:release_notes;type:text;comment:默认语言的更新说明，富文本，其他语言的在listings中" json:"release_notes"`
+	Media                  string    `gorm:"column:media;type:mediumtext;comment:媒体库，为Json数组，按position排序，为空时由game_introduction_images生成截图" json:"media"`
+	Builds                 string    `gorm:"column:builds;type:text;comment:各平台的构建信息，为Json数组，为空时使用package_name和download_url" json:"builds"`
+	ApkManifest            string    `gorm:"column:apk_manifest;type:text;comment:提交审核时上传的APK的解析结果，为Json对象" json:"apk_manifest"`
+	DeleteTime             int64     `gorm:"column:delete_time;type:bigint(20);default:0;comment:草稿删除时间，用于回收站保留期;NOT NULL" json:"delete_time"`
 	CreateTs               time.Time `gorm:"column:create_ts;type:timestamp;default:CURRENT_TIMESTAMP;comment:创建时间;NOT NULL" json:"create_ts"`
 	ModifyTs               time.Time `gorm:"column:modify_ts;type:timestamp;default:CURRENT_TIMESTAMP;comment:更新时间;NOT NULL" json:"modify_ts"`
 }
@@ -0,0 +1,18 @@
+package ddl
+
+import "time"
+
+// 包名占用记录，同一平台的包名只能被一个游戏占用
+type GpPackageClaim struct {
+	Id          uint64    `gorm:"column:id;type:bigint(20) unsigned;primary_key;AUTO_INCREMENT;comment:记录ID" json:"id"`
+	Platform    int       `gorm:"column:platform;type:int(11);comment:平台 1-android, 2-ios;NOT NULL" json:"platform"`
+	PackageName string    `gorm:"column:package_name;type:varchar(256);comment:包名;NOT NULL" json:"package_name"`
+	GameId      uint64    `gorm:"column:game_id;type:bigint(20) unsigned;comment:占用该包名的游戏ID;NOT NULL" json:"game_id"`
+	State       int       `gorm:"column:state;type:int(11);comment:1-已预留（提交审核）, 2-已锁定（已发布）;NOT NULL" json:"state"`
+	CreateTs    time.Time `gorm:"column:create_ts;type:timestamp;default:CURRENT_TIMESTAMP;comment:创建时间;NOT NULL" json:"create_ts"`
+	ModifyTs    time.Time `gorm:"column:modify_ts;type:timestamp;default:CURRENT_TIMESTAMP;comment:更新时间;NOT NULL" json:"modify_ts"`
+}
+
+func (m *GpPackageClaim) TableName() string {
+	return "gp_package_claim"
+}
@@ -0,0 +1,15 @@
+package ddl
+
+import "time"
+
+// 游戏标签
+type GpTag struct {
+	Id       uint64    `gorm:"column:id;type:bigint(20) unsigned;primary_key;comment:标签ID" json:"id"`
+	Name     string    `gorm:"column:name;type:varchar(64);comment:标签名，全局唯一;NOT NULL" json:"name"`
+	CreateTs time.Time `gorm:"column:create_ts;type:timestamp;default:CURRENT_TIMESTAMP;comment:创建时间;NOT NULL" json:"create_ts"`
+	ModifyTs time.Time `gorm:"column:modify_ts;type:timestamp;default:CURRENT_TIMESTAMP;comment:更新时间;NOT NULL" json:"modify_ts"`
+}
+
+func (m *GpTag) TableName() string {
+	return "gp_tag"
+}
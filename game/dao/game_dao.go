@@ -3,8 +3,12 @@ package dao
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/GameLaunchPad/game_management_project/game/constdef"
 	"github.com/GameLaunchPad/game_management_project/game/dal"
 	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
 	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
@@ -18,62 +22,252 @@ type GameWithVersionStatus struct {
 	Status int `gorm:"column:status"`
 }
 
+// GameWithNewestVersion is a game together with its newest version, which is nil for a game whose only
+// draft was deleted.
+type GameWithNewestVersion struct {
+	Game          *ddl.GpGame
+	NewestVersion *ddl.GpGameVersion
+}
+
+// GameWithSummaryVersion is a game together with the version its summary columns mirror, which is nil for a
+// game whose only draft was deleted.
+type GameWithSummaryVersion struct {
+	Game           *ddl.GpGame
+	SummaryVersion *ddl.GpGameVersion
+}
+
+// GameDetailRecord is a game with its newest and online versions; a version is nil when the game has none.
+type GameDetailRecord struct {
+	Game          *ddl.GpGame
+	NewestVersion *ddl.GpGameVersion
+	OnlineVersion *ddl.GpGameVersion
+}
+
+// GameListOptions narrows and orders GetGameList. Nil and empty fields mean "no filter".
+type GameListOptions struct {
+	FilterText      *string
+	CpID            *uint64
+	NewestStatuses  []game.GameStatus
+	OnlineStatus    *game.OnlineStatus
+	Platforms       []game.GamePlatform
+	CategoryIDs     []uint64 // the category and its subcategories, expanded by the caller
+	TagIDs          []uint64
+	CreateTimeStart *time.Time
+	CreateTimeEnd   *time.Time
+	UpdateTimeStart *time.Time
+	UpdateTimeEnd   *time.Time
+	SortField       game.GameListSortField // Unset sorts by update time
+	SortOrder       game.SortOrder         // Unset sorts descending
+}
+
+// GameListCursor is the position of the last game of a page in the GetGameList order.
+// Only the sort value matching the sort field of the listing is used.
+type GameListCursor struct {
+	SortTime time.Time // create_ts or modify_ts of the last game when sorting by time
+	SortName string    // game_name of the last game when sorting by name
+	ID       uint64
+}
+
+// gameListStatusExpr is the status GetGameList reports for a game: a taken-down game is always reported
+// as Offline, even if the CP has already started a new version, and a game whose only draft was deleted is Unset.
+var gameListStatusExpr = fmt.Sprintf("CASE WHEN g.takedown_version_id <> 0 THEN %d ELSE COALESCE(gv.status, %d) END",
+	game.GameStatus_Offline, game.GameStatus_Unset)
+
+// gameListSortColumns maps a sort field to the gp_game column it orders by.
+var gameListSortColumns = map[game.GameListSortField]string{
+	game.GameListSortField_Unset:      "g.modify_ts",
+	game.GameListSortField_CreateTime: "g.create_ts",
+	game.GameListSortField_UpdateTime: "g.modify_ts",
+	game.GameListSortField_GameName:   "g.game_name",
+}
+
 // CreateGame creates a new game and its initial version in a transaction.
-func (d *gameDAO) CreateGame(ctx context.Context, game *ddl.GpGame, version *ddl.GpGameVersion) error {
+// The package names of the version must not be used by another game; they are reserved right away when
+// the version goes straight to review. Its category and tags must exist. The summary columns of gp_game
+// are filled in from the version.
+func (d *gameDAO) CreateGame(ctx context.Context, gameRecord *ddl.GpGame, version *ddl.GpGameVersion) error {
+	if err := CheckTransition(game.GameStatus_Unset, game.GameStatus(version.Status)); err != nil {
+		return err
+	}
 	return dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 0. check or reserve the package names, and check the category and tags
+		if err := checkOrReservePackageNames(tx, gameRecord.Id, version); err != nil {
+			return err
+		}
+		if err := checkTaxonomyReferences(tx, version); err != nil {
+			return err
+		}
 		// 1. create gp_game record
-		if err := tx.Create(game).Error; err != nil {
+		if err := tx.Create(gameRecord).Error; err != nil {
 			return err
 		}
 		// 2. create gp_game_version record
+		version.Revision = 1
 		if err := tx.Create(version).Error; err != nil {
 			return err
 		}
 		// 3. rollback newest_game_version_id
-		if err := tx.Model(game).Update("newest_game_version_id", version.Id).Error; err != nil {
+		if err := tx.Model(gameRecord).Update("newest_game_version_id", version.Id).Error; err != nil {
 			return err
 		}
-		return nil
+		// 4. mirror the version in the summary columns until the game goes online
+		_, err := syncGameSummary(tx, gameRecord)
+		return err
 	})
 }
 
-// UpdateGameDraft creates a new draft version for an existing game and updates the game's newest version ID.
-func (d *gameDAO) UpdateGameDraft(ctx context.Context, gameID uint64, version *ddl.GpGameVersion) error {
+var ErrRevisionConflict = errors.New("the game version has been modified since it was loaded")
+
+// UpdateGameDraft saves the editor's content for a game.
+// If the newest version is still a Draft it is updated in place; if it is Reviewing, Published, Rejected or
+// Offline a new version is forked from it and becomes the newest version. A Reviewing version that gets forked is
+// withdrawn back to Draft, so that reviewers never approve content the CP has already replaced.
+// When expectedRevision is set, the write is rejected with ErrRevisionConflict unless it matches the revision
+// of the newest version. Package names used by another game are rejected with ErrPackageNameConflict, and
+// reserved when the content is submitted for review. A category or tag that does not exist is rejected with
+// ErrCategoryNotFound or ErrTagNotFound, and content submitted for review without the release notes a
+// released game needs with ErrReleaseNotesRequired. On success version.Id and version.Revision hold the
+// row that was written.
+func (d *gameDAO) UpdateGameDraft(ctx context.Context, gameID uint64, version *ddl.GpGameVersion, expectedRevision *int64) error {
 	return dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 1. create new gp_game_version record
-		if err := tx.Create(version).Error; err != nil {
+		// 1. lock the game record and its newest version
+		gameRecord, err := lockGame(tx, gameID)
+		if err != nil {
+			return err
+		}
+		var newestVersion *ddl.GpGameVersion
+		if gameRecord.NewestGameVersionId != 0 {
+			newestVersion, err = lockVersion(tx, gameID, gameRecord.NewestGameVersionId)
+			if err != nil {
+				return err
+			}
+		}
+
+		// 2. reject stale writes, missing release notes, package names used by another game and unknown
+		// categories or tags
+		if expectedRevision != nil && (newestVersion == nil || newestVersion.Revision != *expectedRevision) {
+			return ErrRevisionConflict
+		}
+		if version.Status == int(game.GameStatus_Reviewing) {
+			released, err := gameEverReleased(tx, gameID)
+			if err != nil {
+				return err
+			}
+			if err := checkReleaseNotes(released, version); err != nil {
+				return err
+			}
+		}
+		if err := checkOrReservePackageNames(tx, gameID, version); err != nil {
+			return err
+		}
+		if err := checkTaxonomyReferences(tx, version); err != nil {
 			return err
 		}
 
-		// 2. update gp_game's newest_game_version_id
-		result := tx.Model(&ddl.GpGame{}).Where("id = ?", gameID).Update("newest_game_version_id", version.Id)
-		if result.Error != nil {
-			return result.Error
+		// 3. a draft is edited in place
+		if newestVersion != nil && newestVersion.Status == int(game.GameStatus_Draft) {
+			if err := CheckTransition(game.GameStatus_Draft, game.GameStatus(version.Status)); err != nil {
+				return err
+			}
+			if err := updateLockedVersion(tx, newestVersion, versionContentColumns(version)); err != nil {
+				return err
+			}
+			version.Id = newestVersion.Id
+			version.Revision = newestVersion.Revision
+			return syncDraftSummary(tx, gameRecord)
 		}
 
-		if result.RowsAffected == 0 {
-			return gorm.ErrRecordNotFound
+		// 4. otherwise fork a new version on top of the newest one
+		if err := CheckTransition(game.GameStatus_Unset, game.GameStatus(version.Status)); err != nil {
+			return err
+		}
+		version.Revision = 1
+		if newestVersion != nil {
+			switch game.GameStatus(newestVersion.Status) {
+			case game.GameStatus_Published, game.GameStatus_Rejected, game.GameStatus_Offline:
+			case game.GameStatus_Reviewing:
+				if err := CheckTransition(game.GameStatus_Reviewing, game.GameStatus_Draft); err != nil {
+					return err
+				}
+				withdraw := map[string]interface{}{"status": int(game.GameStatus_Draft)}
+				if err := updateLockedVersion(tx, newestVersion, withdraw); err != nil {
+					return err
+				}
+				// the new version reserved its own names in step 2, the withdrawn one may have used others
+				if err := releaseUnusedReservations(tx, gameID); err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("%w: the newest version is %s", ErrIllegalStatusTransition, game.GameStatus(newestVersion.Status))
+			}
+			// keep the revision increasing along the game, so that an old revision never matches a fork
+			version.Revision = newestVersion.Revision + 1
 		}
 
-		return nil
+		if err := tx.Create(version).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(gameRecord).Update("newest_game_version_id", version.Id).Error; err != nil {
+			return err
+		}
+		return syncDraftSummary(tx, gameRecord)
 	})
 }
 
+// checkOrReservePackageNames makes sure no other game uses the package names of a version about to be
+// written, and reserves them when the version goes to review.
+func checkOrReservePackageNames(tx *gorm.DB, gameID uint64, version *ddl.GpGameVersion) error {
+	if version.Status == int(game.GameStatus_Reviewing) {
+		return claimPackageNames(tx, gameID, version, constdef.PackageClaimReserved)
+	}
+	return checkPackageClaims(tx, gameID, version)
+}
+
+// versionContentColumns returns the editable content of a version, as written by an in-place draft save.
+// The edit may change the package, so the APK inspected on an earlier submit goes with it.
+func versionContentColumns(version *ddl.GpGameVersion) map[string]interface{} {
+	return map[string]interface{}{
+		"game_name":                version.GameName,
+		"game_icon":                version.GameIcon,
+		"header_image":             version.HeaderImage,
+		"game_introduction":        version.GameIntroduction,
+		"game_introduction_images": version.GameIntroductionImages,
+		"platform":                 version.Platform,
+		"package_name":             version.PackageName,
+		"download_url":             version.DownloadUrl,
+		"builds":                   version.Builds,
+		"apk_manifest":             version.ApkManifest,
+		"category_id":              version.CategoryId,
+		"tag_ids":                  version.TagIds,
+		"default_locale":           version.DefaultLocale,
+		"listings":                 version.Listings,
+		"release_notes":            version.ReleaseNotes,
+		"media":                    version.Media,
+		"status":                   version.Status,
+	}
+}
+
+// updateLockedVersion writes updateData to a version row locked by the current transaction and bumps its
+// revision, so that editors still holding the old revision get a conflict on their next save.
+func updateLockedVersion(tx *gorm.DB, version *ddl.GpGameVersion, updateData map[string]interface{}) error {
+	updateData["revision"] = version.Revision + 1
+	if err := tx.Model(version).Updates(updateData).Error; err != nil {
+		return err
+	}
+	version.Revision++
+	return nil
+}
+
 // GetGameList retrieves a paginated list of games with the status of their newest version.
-func (d *gameDAO) GetGameList(ctx context.Context, filterText *string, pageNum, pageSize int) ([]*GameWithVersionStatus, int64, error) {
+func (d *gameDAO) GetGameList(ctx context.Context, opts *GameListOptions, pageNum, pageSize int) ([]*GameWithVersionStatus, int64, error) {
 	var results []*GameWithVersionStatus
 	var total int64
-
-	// Start building the query on the gp_game table, aliased as 'g'
-	db := dal.DB.WithContext(ctx).Model(&ddl.GpGame{}).Table("gp_game AS g")
-
-	// Apply filter if provided
-	if filterText != nil && *filterText != "" {
-		db = db.Where("g.game_name LIKE ?", "%"+*filterText+"%")
+	if opts == nil {
+		opts = &GameListOptions{}
 	}
+	db := gameListQuery(ctx, opts)
 
 	// First, count the total number of records that match the filter
-	// We need to perform the JOIN even for counting if the filter applies to the joined table in the future.
 	if err := db.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
@@ -84,12 +278,8 @@ func (d *gameDAO) GetGameList(ctx context.Context, filterText *string, pageNum,
 		offset = 0
 	}
 
-	// Now, perform the JOIN query to get the full data for the current page
-	// JOIN gp_game_version (aliased as 'gv') on the newest_game_version_id
-	// SELECT g.* (all columns from gp_game) and gv.status
-	err := db.Select("g.*, gv.status").
-		Joins("LEFT JOIN gp_game_version AS gv ON g.newest_game_version_id = gv.id").
-		Order("g.modify_ts DESC").
+	// Now, get the full data for the current page
+	err := orderGameList(db, opts).
 		Limit(pageSize).
 		Offset(offset).
 		Scan(&results).Error
@@ -100,84 +290,448 @@ func (d *gameDAO) GetGameList(ctx context.Context, filterText *string, pageNum,
 	return results, total, nil
 }
 
+// GetGameListAfter retrieves up to limit games that come after the cursor in the GetGameList order.
+// It seeks on (sort column, id) instead of skipping rows with OFFSET, so deep pages cost the same as
+// the first one. A nil cursor starts from the beginning.
+func (d *gameDAO) GetGameListAfter(ctx context.Context, opts *GameListOptions, after *GameListCursor, limit int) ([]*GameWithVersionStatus, error) {
+	var results []*GameWithVersionStatus
+	if opts == nil {
+		opts = &GameListOptions{}
+	}
+	db := gameListQuery(ctx, opts)
+
+	if after != nil {
+		sortColumn, direction := gameListOrder(opts)
+		operator := "<"
+		if direction == "ASC" {
+			operator = ">"
+		}
+		var sortValue interface{} = after.SortTime
+		if opts.SortField == game.GameListSortField_GameName {
+			sortValue = after.SortName
+		}
+		db = db.Where(fmt.Sprintf("(%s %s ? OR (%s = ? AND g.id %s ?))", sortColumn, operator, sortColumn, operator),
+			sortValue, sortValue, after.ID)
+	}
+
+	err := orderGameList(db, opts).
+		Limit(limit).
+		Scan(&results).Error
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// CountGames counts the games that match the filters of opts.
+func (d *gameDAO) CountGames(ctx context.Context, opts *GameListOptions) (int64, error) {
+	var total int64
+	if opts == nil {
+		opts = &GameListOptions{}
+	}
+	if err := gameListQuery(ctx, opts).Count(&total).Error; err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// gameListQuery starts a query on the gp_game table, aliased as 'g', JOIN gp_game_version (aliased as 'gv')
+// on the newest_game_version_id, with the filters of opts applied.
+func gameListQuery(ctx context.Context, opts *GameListOptions) *gorm.DB {
+	db := dal.DB.WithContext(ctx).Model(&ddl.GpGame{}).Table("gp_game AS g").
+		Joins("LEFT JOIN gp_game_version AS gv ON g.newest_game_version_id = gv.id")
+	return applyGameListFilters(db, opts)
+}
+
+// gameListOrder returns the column and direction a game list is sorted by.
+func gameListOrder(opts *GameListOptions) (string, string) {
+	direction := "DESC"
+	if opts.SortOrder == game.SortOrder_Asc {
+		direction = "ASC"
+	}
+	sortColumn, ok := gameListSortColumns[opts.SortField]
+	if !ok {
+		sortColumn = gameListSortColumns[game.GameListSortField_Unset]
+	}
+	return sortColumn, direction
+}
+
+// orderGameList selects the listed columns and sorts them, using id as a tie-breaker so that pages never overlap.
+func orderGameList(db *gorm.DB, opts *GameListOptions) *gorm.DB {
+	sortColumn, direction := gameListOrder(opts)
+	return db.Select("g.*, " + gameListStatusExpr + " AS status").
+		Order(sortColumn + " " + direction).
+		Order("g.id " + direction)
+}
+
+// applyGameListFilters adds the WHERE conditions of opts to a query on gp_game AS g joined with its newest version AS gv.
+func applyGameListFilters(db *gorm.DB, opts *GameListOptions) *gorm.DB {
+	if opts.FilterText != nil && *opts.FilterText != "" {
+		db = db.Where("g.game_name LIKE ?", "%"+*opts.FilterText+"%")
+	}
+	if opts.CpID != nil {
+		db = db.Where("g.cp_id = ?", *opts.CpID)
+	}
+	if len(opts.NewestStatuses) > 0 {
+		statuses := make([]int, 0, len(opts.NewestStatuses))
+		for _, status := range opts.NewestStatuses {
+			statuses = append(statuses, int(status))
+		}
+		db = db.Where(gameListStatusExpr+" IN ?", statuses)
+	}
+	if opts.OnlineStatus != nil {
+		switch *opts.OnlineStatus {
+		case game.OnlineStatus_Online:
+			db = db.Where("g.online_game_version_id <> 0")
+		case game.OnlineStatus_NeverOnline:
+			db = db.Where("g.online_game_version_id = 0 AND g.takedown_version_id = 0")
+		case game.OnlineStatus_TakenDown:
+			db = db.Where("g.takedown_version_id <> 0")
+		}
+	}
+	if len(opts.Platforms) > 0 {
+		// platform is a JSON array of GamePlatform values, a game matches if it supports any of the platforms
+		conditions := make([]string, 0, len(opts.Platforms))
+		args := make([]interface{}, 0, len(opts.Platforms))
+		for _, platform := range opts.Platforms {
+			conditions = append(conditions, "JSON_CONTAINS(gv.platform, ?)")
+			args = append(args, strconv.Itoa(int(platform)))
+		}
+		db = db.Where("("+strings.Join(conditions, " OR ")+")", args...)
+	}
+	if len(opts.CategoryIDs) > 0 {
+		db = db.Where("gv.category_id IN ?", opts.CategoryIDs)
+	}
+	if len(opts.TagIDs) > 0 {
+		// tag_ids is a JSON array of tag IDs, a game matches if it carries any of the tags
+		conditions := make([]string, 0, len(opts.TagIDs))
+		args := make([]interface{}, 0, len(opts.TagIDs))
+		for _, tagID := range opts.TagIDs {
+			conditions = append(conditions, "JSON_CONTAINS(gv.tag_ids, ?)")
+			args = append(args, strconv.FormatUint(tagID, 10))
+		}
+		db = db.Where("("+strings.Join(conditions, " OR ")+")", args...)
+	}
+	if opts.CreateTimeStart != nil {
+		db = db.Where("g.create_ts >= ?", *opts.CreateTimeStart)
+	}
+	if opts.CreateTimeEnd != nil {
+		db = db.Where("g.create_ts <= ?", *opts.CreateTimeEnd)
+	}
+	if opts.UpdateTimeStart != nil {
+		db = db.Where("g.modify_ts >= ?", *opts.UpdateTimeStart)
+	}
+	if opts.UpdateTimeEnd != nil {
+		db = db.Where("g.modify_ts <= ?", *opts.UpdateTimeEnd)
+	}
+	return db
+}
+
+// GetGame retrieves the gp_game row alone, without its versions.
+func (d *gameDAO) GetGame(ctx context.Context, gameID uint64) (*ddl.GpGame, error) {
+	var gameRecord ddl.GpGame
+	if err := dal.DB.WithContext(ctx).Where("id = ?", gameID).First(&gameRecord).Error; err != nil {
+		return nil, err
+	}
+	return &gameRecord, nil
+}
+
+var ErrGameVersionMissing = errors.New("a version referenced by the game does not exist")
+
 // GetGameDetail retrieves the main game info and its associated newest and online versions.
+// Both versions are loaded with one query; a version the game points at but that cannot be found is
+// reported as ErrGameVersionMissing instead of being left out.
 func (d *gameDAO) GetGameDetail(ctx context.Context, gameID uint64) (*ddl.GpGame, *ddl.GpGameVersion, *ddl.GpGameVersion, error) {
-	var game ddl.GpGame
-	var newestVersion *ddl.GpGameVersion
-	var onlineVersion *ddl.GpGameVersion
-
-	// 1. get the main game info
-	if err := dal.DB.WithContext(ctx).First(&game, gameID).Error; err != nil {
-		// if record not found, return nils
+	details, err := loadGameDetails(dal.DB.WithContext(ctx), []uint64{gameID})
+	if err != nil {
 		return nil, nil, nil, err
 	}
+	detail, ok := details[gameID]
+	if !ok {
+		return nil, nil, nil, gorm.ErrRecordNotFound
+	}
+	return detail.Game, detail.NewestVersion, detail.OnlineVersion, nil
+}
 
-	// 2. get the newest game version
-	if game.NewestGameVersionId != 0 {
-		var nv ddl.GpGameVersion
-		if err := dal.DB.WithContext(ctx).First(&nv, game.NewestGameVersionId).Error; err == nil {
-			newestVersion = &nv
+// BatchGetGameDetails loads several games with their newest and online versions in two queries, however
+// many games are asked for. Games that do not exist are simply absent from the returned map.
+func (d *gameDAO) BatchGetGameDetails(ctx context.Context, gameIDs []uint64) (map[uint64]*GameDetailRecord, error) {
+	return loadGameDetails(dal.DB.WithContext(ctx), gameIDs)
+}
+
+// loadGameDetails reads the games and then every version they reference in a single query.
+func loadGameDetails(db *gorm.DB, gameIDs []uint64) (map[uint64]*GameDetailRecord, error) {
+	details := make(map[uint64]*GameDetailRecord, len(gameIDs))
+	if len(gameIDs) == 0 {
+		return details, nil
+	}
+
+	// 1. get the games
+	var games []*ddl.GpGame
+	if err := db.Where("id IN ?", gameIDs).Find(&games).Error; err != nil {
+		return nil, err
+	}
+
+	// 2. get the newest and online versions of all of them at once
+	versionIDs := make([]uint64, 0, 2*len(games))
+	for _, gameRecord := range games {
+		if gameRecord.NewestGameVersionId != 0 {
+			versionIDs = append(versionIDs, gameRecord.NewestGameVersionId)
+		}
+		if gameRecord.OnlineGameVersionId != 0 && gameRecord.OnlineGameVersionId != gameRecord.NewestGameVersionId {
+			versionIDs = append(versionIDs, gameRecord.OnlineGameVersionId)
+		}
+	}
+	versionsByID := make(map[uint64]*ddl.GpGameVersion, len(versionIDs))
+	if len(versionIDs) > 0 {
+		var versions []*ddl.GpGameVersion
+		if err := db.Where("id IN ?", versionIDs).Find(&versions).Error; err != nil {
+			return nil, err
+		}
+		for _, version := range versions {
+			versionsByID[version.Id] = version
 		}
 	}
 
-	// 3. get the online game version
-	if game.OnlineGameVersionId != 0 {
-		// if the online version is the same as the newest version, reuse it
-		if newestVersion != nil && game.OnlineGameVersionId == newestVersion.Id {
-			onlineVersion = newestVersion
-		} else {
-			var ov ddl.GpGameVersion
-			if err := dal.DB.WithContext(ctx).First(&ov, game.OnlineGameVersionId).Error; err == nil {
-				onlineVersion = &ov
-			}
+	// 3. attach the versions; the online version is reused when it is also the newest one
+	for _, gameRecord := range games {
+		detail := &GameDetailRecord{Game: gameRecord}
+		var err error
+		if detail.NewestVersion, err = referencedVersion(versionsByID, gameRecord, gameRecord.NewestGameVersionId); err != nil {
+			return nil, err
+		}
+		if detail.OnlineVersion, err = referencedVersion(versionsByID, gameRecord, gameRecord.OnlineGameVersionId); err != nil {
+			return nil, err
+		}
+		details[gameRecord.Id] = detail
+	}
+	return details, nil
+}
+
+// referencedVersion looks up a version a game points at; 0 means the game has no such version.
+func referencedVersion(versionsByID map[uint64]*ddl.GpGameVersion, gameRecord *ddl.GpGame, versionID uint64) (*ddl.GpGameVersion, error) {
+	if versionID == 0 {
+		return nil, nil
+	}
+	version, ok := versionsByID[versionID]
+	if !ok || version.GameId != gameRecord.Id {
+		return nil, fmt.Errorf("%w: game %d, version %d", ErrGameVersionMissing, gameRecord.Id, versionID)
+	}
+	return version, nil
+}
+
+// GetLatestGameOperationLogs returns the latest operation log of the given type of each game, keyed by game
+// ID. Games without such a log are absent from the map.
+func (d *gameDAO) GetLatestGameOperationLogs(ctx context.Context, gameIDs []uint64, operationType int) (map[uint64]*ddl.GpGameOperationLog, error) {
+	latest := make(map[uint64]*ddl.GpGameOperationLog, len(gameIDs))
+	if len(gameIDs) == 0 {
+		return latest, nil
+	}
+
+	var operationLogs []*ddl.GpGameOperationLog
+	err := dal.DB.WithContext(ctx).
+		Where("game_id IN ? AND operation_type = ?", gameIDs, operationType).
+		Order("create_ts DESC").Order("id DESC").
+		Find(&operationLogs).Error
+	if err != nil {
+		return nil, err
+	}
+	// newest first, so the first log seen for a game is its latest
+	for _, operationLog := range operationLogs {
+		if _, ok := latest[operationLog.GameId]; !ok {
+			latest[operationLog.GameId] = operationLog
 		}
 	}
+	return latest, nil
+}
 
-	return &game, newestVersion, onlineVersion, nil
+// ScanGamesWithNewestVersion returns up to limit games with an id greater than afterID in id order, each with
+// its newest version. Callers walk the whole table by passing the id of the last game of the previous batch.
+func (d *gameDAO) ScanGamesWithNewestVersion(ctx context.Context, afterID uint64, limit int) ([]*GameWithNewestVersion, error) {
+	var games []*ddl.GpGame
+	if err := dal.DB.WithContext(ctx).Where("id > ?", afterID).Order("id").Limit(limit).Find(&games).Error; err != nil {
+		return nil, err
+	}
+
+	// load the newest versions of the whole batch with one query
+	versionIDs := make([]uint64, 0, len(games))
+	for _, gameRecord := range games {
+		if gameRecord.NewestGameVersionId != 0 {
+			versionIDs = append(versionIDs, gameRecord.NewestGameVersionId)
+		}
+	}
+	versionsByID := make(map[uint64]*ddl.GpGameVersion, len(versionIDs))
+	if len(versionIDs) > 0 {
+		var versions []*ddl.GpGameVersion
+		if err := dal.DB.WithContext(ctx).Where("id IN ?", versionIDs).Find(&versions).Error; err != nil {
+			return nil, err
+		}
+		for _, version := range versions {
+			versionsByID[version.Id] = version
+		}
+	}
+
+	result := make([]*GameWithNewestVersion, 0, len(games))
+	for _, gameRecord := range games {
+		result = append(result, &GameWithNewestVersion{Game: gameRecord, NewestVersion: versionsByID[gameRecord.NewestGameVersionId]})
+	}
+	return result, nil
+}
+
+// ScanGameSummaries returns up to limit games with an id greater than afterID in id order, each with the
+// version its summary columns mirror, see SummaryVersionID. It pages like ScanGamesWithNewestVersion.
+func (d *gameDAO) ScanGameSummaries(ctx context.Context, afterID uint64, limit int) ([]*GameWithSummaryVersion, error) {
+	var games []*ddl.GpGame
+	if err := dal.DB.WithContext(ctx).Where("id > ?", afterID).Order("id").Limit(limit).Find(&games).Error; err != nil {
+		return nil, err
+	}
+
+	// load the mirrored versions of the whole batch with one query
+	versionIDs := make([]uint64, 0, len(games))
+	for _, gameRecord := range games {
+		if versionID := SummaryVersionID(gameRecord); versionID != 0 {
+			versionIDs = append(versionIDs, versionID)
+		}
+	}
+	versionsByID := make(map[uint64]*ddl.GpGameVersion, len(versionIDs))
+	if len(versionIDs) > 0 {
+		var versions []*ddl.GpGameVersion
+		if err := dal.DB.WithContext(ctx).Where("id IN ?", versionIDs).Find(&versions).Error; err != nil {
+			return nil, err
+		}
+		for _, version := range versions {
+			versionsByID[version.Id] = version
+		}
+	}
+
+	result := make([]*GameWithSummaryVersion, 0, len(games))
+	for _, gameRecord := range games {
+		result = append(result, &GameWithSummaryVersion{Game: gameRecord, SummaryVersion: versionsByID[SummaryVersionID(gameRecord)]})
+	}
+	return result, nil
+}
+
+// RepairGameSummary rewrites the summary columns of a game from the version they mirror and returns the
+// columns that had drifted. The game is locked, so a concurrent publish is either seen or waits.
+func (d *gameDAO) RepairGameSummary(ctx context.Context, gameID uint64) ([]string, error) {
+	var drifted []string
+	err := dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		gameRecord, err := lockGame(tx, gameID)
+		if err != nil {
+			return err
+		}
+		drifted, err = syncGameSummary(tx, gameRecord)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return drifted, nil
 }
 
 // ReviewGameVersion updates a game version's status and potentially the main game's online version.
-func (d *gameDAO) ReviewGameVersion(ctx context.Context, gameID, versionID uint64, newStatus int, reviewComment string) error {
+// The decision is also appended to gp_game_review_log, so re-reviews never overwrite earlier verdicts.
+func (d *gameDAO) ReviewGameVersion(ctx context.Context, gameID, versionID uint64, newStatus int, reviewLog *ddl.GpGameReviewLog) error {
 	return dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 1. update gp_game_version status and review info
-		updateData := map[string]interface{}{
-			"status":         newStatus,
-			"review_comment": reviewComment,
-			"review_time":    time.Now().Unix(),
+		// 1. lock the game and the version, so that two concurrent reviews cannot both win
+		gameRecord, version, err := lockVersionForTransition(tx, gameID, versionID, game.GameStatus(newStatus))
+		if err != nil {
+			return err
 		}
 
-		result := tx.Model(&ddl.GpGameVersion{}).Where("id = ? AND game_id = ?", versionID, gameID).Updates(updateData)
-		if result.Error != nil {
-			return result.Error
+		// 2. update gp_game_version status and review info
+		reviewTime := time.Now().Unix()
+		updateData := map[string]interface{}{
+			"status":         newStatus,
+			"review_comment": reviewLog.Remark,
+			"operator":       reviewLog.Operator,
+			"review_time":    reviewTime,
 		}
-		if result.RowsAffected == 0 {
-			// if no rows were affected, it means either the version_id or game_id is invalid
-			return gorm.ErrRecordNotFound
+		if err := updateLockedVersion(tx, version, updateData); err != nil {
+			return err
 		}
 
-		// 2. if the new status is Published, update gp_game's online_game_version_id
+		// 3. if the new status is Published, lock its package names and update gp_game's online_game_version_id;
+		// a rejected version gives up the names it reserved
 		if newStatus == int(game.GameStatus_Published) {
-			result = tx.Model(&ddl.GpGame{}).Where("id = ?", gameID).Update("online_game_version_id", versionID)
-			if result.Error != nil {
-				return result.Error
+			if err := claimPackageNames(tx, gameID, version, constdef.PackageClaimLocked); err != nil {
+				return err
+			}
+			if err := putVersionOnline(tx, gameRecord, versionID); err != nil {
+				return err
 			}
-			if result.RowsAffected == 0 {
-				return gorm.ErrRecordNotFound
+		}
+		if newStatus == int(game.GameStatus_Rejected) {
+			if err := releaseUnusedReservations(tx, gameID); err != nil {
+				return err
 			}
 		}
 
-		return nil
+		// 4. append the decision to the review log
+		return appendReviewLog(tx, gameID, versionID, newStatus, 0, reviewTime, reviewLog)
 	})
 }
 
+// lockVersionForTransition locks a version that is about to change status and checks that the change
+// is legal: the version must still be the newest version of the game and may only move along the
+// transition table.
+func lockVersionForTransition(tx *gorm.DB, gameID, versionID uint64, newStatus game.GameStatus) (*ddl.GpGame, *ddl.GpGameVersion, error) {
+	gameRecord, version, err := lockGameVersion(tx, gameID, versionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if gameRecord.NewestGameVersionId != versionID {
+		return nil, nil, fmt.Errorf("%w: version %d is no longer the newest version", ErrIllegalStatusTransition, versionID)
+	}
+	if err := CheckTransition(game.GameStatus(version.Status), newStatus); err != nil {
+		return nil, nil, err
+	}
+	return gameRecord, version, nil
+}
+
+// putVersionOnline makes a freshly reviewed version the online version of a locked game. Passing review also
+// ends a takedown, since that is the only way a taken-down game may go live again, and replaces any rollout.
+// The summary columns of the game follow the new online version.
+func putVersionOnline(tx *gorm.DB, gameRecord *ddl.GpGame, versionID uint64) error {
+	updateData := map[string]interface{}{
+		"online_game_version_id": versionID,
+		"takedown_version_id":    0,
+	}
+	clearRollout(updateData)
+	if err := tx.Model(gameRecord).Updates(updateData).Error; err != nil {
+		return err
+	}
+	_, err := syncGameSummary(tx, gameRecord)
+	return err
+}
+
+// appendReviewLog fills in the identifying fields of a review log record and inserts it.
+func appendReviewLog(tx *gorm.DB, gameID, versionID uint64, resultStatus int, publishAt, reviewTime int64, reviewLog *ddl.GpGameReviewLog) error {
+	reviewLog.GameId = gameID
+	reviewLog.GameVersionId = versionID
+	reviewLog.ResultStatus = resultStatus
+	reviewLog.PublishAt = publishAt
+	reviewLog.ReviewTime = reviewTime
+	return tx.Create(reviewLog).Error
+}
+
 var ErrVersionIsNotDraft = errors.New("the newest version of the game is not a draft")
 
-// DeleteGameDraft finds the newest version of a game, and if it's a draft, updates its status to Rejected.
-func (d *gameDAO) DeleteGameDraft(ctx context.Context, gameID uint64) error {
-	return dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 1. find the game record
-		var gameRecord ddl.GpGame
-		if err := tx.First(&gameRecord, gameID).Error; err != nil {
+var (
+	ErrVersionNotDeleted = errors.New("the version is not a deleted draft")
+	ErrDraftExpired      = errors.New("the deleted draft is past its retention period")
+	ErrDraftSuperseded   = errors.New("a newer version was created after the draft was deleted")
+)
+
+// DeleteGameDraft moves the newest version of a game to the trash if it's a draft, and points
+// newest_game_version_id back at the prior non-deleted version, or at the online version if there is none.
+// It returns the new newest version id, 0 when the game has no version left.
+func (d *gameDAO) DeleteGameDraft(ctx context.Context, gameID uint64) (uint64, error) {
+	var newestVersionID uint64
+	err := dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. find and lock the game record
+		gameRecord, err := lockGame(tx, gameID)
+		if err != nil {
 			return err
 		}
 
@@ -186,9 +740,9 @@ func (d *gameDAO) DeleteGameDraft(ctx context.Context, gameID uint64) error {
 			return gorm.ErrRecordNotFound
 		}
 
-		// 2. find the newest version record
-		var newestVersion ddl.GpGameVersion
-		if err := tx.First(&newestVersion, gameRecord.NewestGameVersionId).Error; err != nil {
+		// 2. find and lock the newest version record
+		newestVersion, err := lockVersion(tx, gameID, gameRecord.NewestGameVersionId)
+		if err != nil {
 			return err
 		}
 
@@ -197,15 +751,515 @@ func (d *gameDAO) DeleteGameDraft(ctx context.Context, gameID uint64) error {
 			// if it's not a draft, cannot delete
 			return ErrVersionIsNotDraft
 		}
+		if err := CheckTransition(game.GameStatus_Draft, game.GameStatus_Deleted); err != nil {
+			return err
+		}
+
+		// 4. move the draft to the trash
+		updateData := map[string]interface{}{
+			"status":      int(game.GameStatus_Deleted),
+			"delete_time": time.Now().Unix(),
+		}
+		if err := updateLockedVersion(tx, newestVersion, updateData); err != nil {
+			return err
+		}
+
+		// 5. fall back to the version the draft was based on, so that the CP keeps an editing baseline
+		var priorVersion ddl.GpGameVersion
+		err = tx.Where("game_id = ? AND id < ? AND status <> ?", gameID, newestVersion.Id, int(game.GameStatus_Deleted)).
+			Order("id DESC").
+			First(&priorVersion).Error
+		switch {
+		case err == nil:
+			newestVersionID = priorVersion.Id
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			newestVersionID = gameRecord.OnlineGameVersionId
+		default:
+			return err
+		}
+		if err := tx.Model(gameRecord).Update("newest_game_version_id", newestVersionID).Error; err != nil {
+			return err
+		}
+		return syncDraftSummary(tx, gameRecord)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return newestVersionID, nil
+}
+
+// ListDeletedGameDrafts returns the drafts of a game that were deleted after deletedAfter and can
+// still be restored, most recently deleted first.
+func (d *gameDAO) ListDeletedGameDrafts(ctx context.Context, gameID uint64, deletedAfter int64) ([]*ddl.GpGameVersion, error) {
+	// make sure the game exists, so that an unknown game is not reported as an empty trash
+	var gameRecord ddl.GpGame
+	if err := dal.DB.WithContext(ctx).Select("id").First(&gameRecord, gameID).Error; err != nil {
+		return nil, err
+	}
+
+	var versions []*ddl.GpGameVersion
+	err := dal.DB.WithContext(ctx).
+		Where("game_id = ? AND status = ? AND delete_time > ?", gameID, int(game.GameStatus_Deleted), deletedAfter).
+		Order("delete_time DESC").Order("id DESC").
+		Find(&versions).Error
+	if err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// RestoreGameDraft takes a deleted draft out of the trash and makes it the newest version again.
+// The draft must have been deleted after deletedAfter, and no newer version may have been created
+// since, otherwise the restored draft would silently replace the CP's later work.
+func (d *gameDAO) RestoreGameDraft(ctx context.Context, gameID, versionID uint64, deletedAfter int64) (*ddl.GpGameVersion, error) {
+	var restored *ddl.GpGameVersion
+	err := dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. lock the game and the deleted draft
+		gameRecord, version, err := lockGameVersion(tx, gameID, versionID)
+		if err != nil {
+			return err
+		}
 
-		// 4. delete (mark as Rejected) the draft version
+		// 2. only a draft still within its retention period can be restored
+		if version.Status != int(game.GameStatus_Deleted) {
+			return ErrVersionNotDeleted
+		}
+		if version.DeleteTime <= deletedAfter {
+			return ErrDraftExpired
+		}
+		if gameRecord.NewestGameVersionId > version.Id {
+			return ErrDraftSuperseded
+		}
+		if err := CheckTransition(game.GameStatus_Deleted, game.GameStatus_Draft); err != nil {
+			return err
+		}
+
+		// 3. put the draft back and make it the newest version
 		updateData := map[string]interface{}{
-			"status": int(game.GameStatus_Rejected),
+			"status":      int(game.GameStatus_Draft),
+			"delete_time": 0,
+		}
+		if err := updateLockedVersion(tx, version, updateData); err != nil {
+			return err
+		}
+		if err := tx.Model(gameRecord).Update("newest_game_version_id", version.Id).Error; err != nil {
+			return err
 		}
-		if err := tx.Model(&newestVersion).Updates(updateData).Error; err != nil {
+		if err := syncDraftSummary(tx, gameRecord); err != nil {
+			return err
+		}
+		restored = version
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return restored, nil
+}
+
+// ListGameVersions retrieves a paginated list of all versions of a game, newest first,
+// optionally restricted to the given statuses. Deleted drafts live in the trash and are only
+// listed when asked for explicitly.
+func (d *gameDAO) ListGameVersions(ctx context.Context, gameID uint64, statuses []int, pageNum, pageSize int) ([]*ddl.GpGameVersion, int64, error) {
+	var versions []*ddl.GpGameVersion
+	var total int64
+
+	// 1. make sure the game exists, so that an unknown game is not reported as an empty history
+	var gameRecord ddl.GpGame
+	if err := dal.DB.WithContext(ctx).Select("id").First(&gameRecord, gameID).Error; err != nil {
+		return nil, 0, err
+	}
+
+	db := dal.DB.WithContext(ctx).Model(&ddl.GpGameVersion{}).Where("game_id = ?", gameID)
+	if len(statuses) > 0 {
+		db = db.Where("status IN ?", statuses)
+	} else {
+		db = db.Where("status <> ?", int(game.GameStatus_Deleted))
+	}
+
+	// 2. count the versions that match the filter
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (pageNum - 1) * pageSize
+	if offset < 0 {
+		offset = 0
+	}
+
+	// 3. load the current page, using id as a tie-breaker for versions created in the same second
+	err := db.Order("create_ts DESC").Order("id DESC").
+		Limit(pageSize).
+		Offset(offset).
+		Find(&versions).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return versions, total, nil
+}
+
+var (
+	ErrVersionNeverPublished = errors.New("the target version has never been published")
+	ErrVersionOffline        = errors.New("the target version has been taken offline and must pass a fresh review first")
+	ErrVersionAlreadyOnline  = errors.New("the target version is already online")
+	ErrGameTakenDown         = errors.New("the game is taken down and must pass a fresh review first")
+)
+
+// RollbackGameVersion points the game's online version back at an earlier published version
+// and records the operation. The game row is locked so that concurrent publishes and rollbacks are serialized.
+func (d *gameDAO) RollbackGameVersion(ctx context.Context, gameID, versionID uint64, operationLog *ddl.GpGameOperationLog) error {
+	return dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. lock the game record and the target version
+		gameRecord, targetVersion, err := lockGameVersion(tx, gameID, versionID)
+		if err != nil {
+			return err
+		}
+
+		// 2. a taken-down game needs a fresh review, and the target version must still be published
+		if err := checkRollbackTarget(gameRecord, targetVersion); err != nil {
+			return err
+		}
+
+		// 3. lock the package names of the target version, an admin may have handed them to another game
+		// since it was last online, then move the online pointer, which ends any rollout, and the summary with it
+		if err := claimPackageNames(tx, gameID, targetVersion, constdef.PackageClaimLocked); err != nil {
+			return err
+		}
+		previousOnlineVersionID := gameRecord.OnlineGameVersionId
+		updateData := map[string]interface{}{"online_game_version_id": versionID}
+		clearRollout(updateData)
+		if err := tx.Model(gameRecord).Updates(updateData).Error; err != nil {
+			return err
+		}
+		if _, err := syncGameSummary(tx, gameRecord); err != nil {
+			return err
+		}
+
+		// 4. record who rolled back from which version and why
+		operationLog.GameId = gameID
+		operationLog.FromVersionId = previousOnlineVersionID
+		operationLog.ToVersionId = versionID
+		if err := tx.Create(operationLog).Error; err != nil {
 			return err
 		}
 
 		return nil
 	})
 }
+
+// checkRollbackTarget returns why a game may not be rolled back to version, or nil if it may. A version
+// that was taken offline, by a takedown or a rolled back rollout, is no longer published and is refused.
+// A rollback only swaps the online version of a live game: a game without one, such as a restored game
+// waiting for its fresh review, cannot use it to go live with an older version that was never re-reviewed.
+func checkRollbackTarget(gameRecord *ddl.GpGame, version *ddl.GpGameVersion) error {
+	if gameRecord.TakedownVersionId != 0 {
+		return ErrGameTakenDown
+	}
+	if gameRecord.OnlineGameVersionId == 0 {
+		return ErrGameNotOnline
+	}
+	switch game.GameStatus(version.Status) {
+	case game.GameStatus_Published:
+	case game.GameStatus_Offline:
+		return ErrVersionOffline
+	default:
+		return ErrVersionNeverPublished
+	}
+	if gameRecord.OnlineGameVersionId == version.Id {
+		return ErrVersionAlreadyOnline
+	}
+	return nil
+}
+
+var ErrVersionNotScheduled = errors.New("the version is not waiting for a scheduled publish")
+
+// ScheduleGameVersion approves a game version and parks it in the Scheduled status until publishAt.
+// The online version is left untouched; the publish scheduler flips it once publishAt has passed.
+func (d *gameDAO) ScheduleGameVersion(ctx context.Context, gameID, versionID uint64, publishAt int64, reviewLog *ddl.GpGameReviewLog) error {
+	return dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		_, version, err := lockVersionForTransition(tx, gameID, versionID, game.GameStatus_Scheduled)
+		if err != nil {
+			return err
+		}
+
+		reviewTime := time.Now().Unix()
+		updateData := map[string]interface{}{
+			"status":         int(game.GameStatus_Scheduled),
+			"review_comment": reviewLog.Remark,
+			"operator":       reviewLog.Operator,
+			"review_time":    reviewTime,
+			"publish_at":     publishAt,
+		}
+		if err := updateLockedVersion(tx, version, updateData); err != nil {
+			return err
+		}
+
+		return appendReviewLog(tx, gameID, versionID, int(game.GameStatus_Scheduled), publishAt, reviewTime, reviewLog)
+	})
+}
+
+// CancelScheduledPublish withdraws a pending scheduled publish and puts the version back under review.
+func (d *gameDAO) CancelScheduledPublish(ctx context.Context, gameID, versionID uint64) error {
+	return dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		_, version, err := lockGameVersion(tx, gameID, versionID)
+		if err != nil {
+			return err
+		}
+		if version.Status != int(game.GameStatus_Scheduled) {
+			return ErrVersionNotScheduled
+		}
+		if err := CheckTransition(game.GameStatus_Scheduled, game.GameStatus_Reviewing); err != nil {
+			return err
+		}
+
+		updateData := map[string]interface{}{
+			"status":     int(game.GameStatus_Reviewing),
+			"publish_at": 0,
+		}
+		return updateLockedVersion(tx, version, updateData)
+	})
+}
+
+// ListDueScheduledVersions returns every scheduled version whose publish time is not after now,
+// oldest schedule first, so that a later schedule of the same game wins.
+func (d *gameDAO) ListDueScheduledVersions(ctx context.Context, now int64) ([]*ddl.GpGameVersion, error) {
+	var versions []*ddl.GpGameVersion
+	err := dal.DB.WithContext(ctx).
+		Where("status = ? AND publish_at <= ?", int(game.GameStatus_Scheduled), now).
+		Order("publish_at ASC").
+		Find(&versions).Error
+	if err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// PublishScheduledVersion publishes a scheduled version and makes it the game's online version.
+// It returns ErrVersionNotScheduled if the schedule was cancelled in the meantime.
+func (d *gameDAO) PublishScheduledVersion(ctx context.Context, gameID, versionID uint64) error {
+	return dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. lock the game and the version and make sure the version is still scheduled
+		gameRecord, version, err := lockGameVersion(tx, gameID, versionID)
+		if err != nil {
+			return err
+		}
+		if version.Status != int(game.GameStatus_Scheduled) {
+			return ErrVersionNotScheduled
+		}
+		if err := CheckTransition(game.GameStatus_Scheduled, game.GameStatus_Published); err != nil {
+			return err
+		}
+
+		// 2. publish the version
+		if err := updateLockedVersion(tx, version, map[string]interface{}{"status": int(game.GameStatus_Published)}); err != nil {
+			return err
+		}
+
+		// 3. lock its package names and move the game's online pointer
+		if err := claimPackageNames(tx, gameID, version, constdef.PackageClaimLocked); err != nil {
+			return err
+		}
+		return putVersionOnline(tx, gameRecord, versionID)
+	})
+}
+
+// ListGameReviewLogs returns every review decision made on a game version, most recent first.
+func (d *gameDAO) ListGameReviewLogs(ctx context.Context, gameID, versionID uint64) ([]*ddl.GpGameReviewLog, error) {
+	// 1. make sure the version exists, so that an unknown version is not reported as an empty history
+	var version ddl.GpGameVersion
+	if err := dal.DB.WithContext(ctx).Select("id").Where("id = ? AND game_id = ?", versionID, gameID).First(&version).Error; err != nil {
+		return nil, err
+	}
+
+	// 2. load the log
+	var reviewLogs []*ddl.GpGameReviewLog
+	err := dal.DB.WithContext(ctx).
+		Where("game_id = ? AND game_version_id = ?", gameID, versionID).
+		Order("review_time DESC").Order("id DESC").
+		Find(&reviewLogs).Error
+	if err != nil {
+		return nil, err
+	}
+	return reviewLogs, nil
+}
+
+// SubmitGameVersion moves the newest draft of a game into review in place, without copying it.
+// It is rejected with ErrRevisionConflict unless the draft still has expectedRevision, so that what goes to
+// review is exactly the content the caller validated. A game released before needs release notes, see
+// ErrReleaseNotesRequired. The package names of the draft are reserved, and apkManifest, the inspection of
+// the APK submitted with it, is stored unless empty.
+func (d *gameDAO) SubmitGameVersion(ctx context.Context, gameID, versionID uint64, expectedRevision int64, apkManifest string) error {
+	return dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		_, version, err := lockVersionForTransition(tx, gameID, versionID, game.GameStatus_Reviewing)
+		if err != nil {
+			return err
+		}
+		if version.Revision != expectedRevision {
+			return ErrRevisionConflict
+		}
+		released, err := gameEverReleased(tx, gameID)
+		if err != nil {
+			return err
+		}
+		if err := checkReleaseNotes(released, version); err != nil {
+			return err
+		}
+		if err := claimPackageNames(tx, gameID, version, constdef.PackageClaimReserved); err != nil {
+			return err
+		}
+		columns := map[string]interface{}{"status": int(game.GameStatus_Reviewing)}
+		if apkManifest != "" {
+			columns["apk_manifest"] = apkManifest
+		}
+		return updateLockedVersion(tx, version, columns)
+	})
+}
+
+// WithdrawGameVersion takes a version that is under review back to Draft and gives up the package names
+// it reserved.
+func (d *gameDAO) WithdrawGameVersion(ctx context.Context, gameID, versionID uint64) error {
+	return dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		_, version, err := lockVersionForTransition(tx, gameID, versionID, game.GameStatus_Draft)
+		if err != nil {
+			return err
+		}
+		if err := updateLockedVersion(tx, version, map[string]interface{}{"status": int(game.GameStatus_Draft)}); err != nil {
+			return err
+		}
+		return releaseUnusedReservations(tx, gameID)
+	})
+}
+
+// GetGameVersion retrieves a single version of a game.
+func (d *gameDAO) GetGameVersion(ctx context.Context, gameID, versionID uint64) (*ddl.GpGameVersion, error) {
+	var version ddl.GpGameVersion
+	if err := dal.DB.WithContext(ctx).Where("id = ? AND game_id = ?", versionID, gameID).First(&version).Error; err != nil {
+		return nil, err
+	}
+	return &version, nil
+}
+
+var (
+	ErrGameNotOnline    = errors.New("the game has no online version")
+	ErrGameNotTakenDown = errors.New("the game is not taken down")
+)
+
+// TakedownGame pulls a live game: its online version becomes Offline and the online pointer is cleared.
+// A newest version waiting for a scheduled publish is sent back to review, since it was approved before the takedown.
+func (d *gameDAO) TakedownGame(ctx context.Context, gameID uint64, operationLog *ddl.GpGameOperationLog) error {
+	return dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. lock the game and its online version
+		gameRecord, err := lockGame(tx, gameID)
+		if err != nil {
+			return err
+		}
+		if gameRecord.OnlineGameVersionId == 0 {
+			return ErrGameNotOnline
+		}
+		onlineVersion, err := lockVersion(tx, gameID, gameRecord.OnlineGameVersionId)
+		if err != nil {
+			return err
+		}
+
+		// 2. take the online version offline
+		if err := CheckTransition(game.GameStatus(onlineVersion.Status), game.GameStatus_Offline); err != nil {
+			return err
+		}
+		if err := updateLockedVersion(tx, onlineVersion, map[string]interface{}{"status": int(game.GameStatus_Offline)}); err != nil {
+			return err
+		}
+
+		// 3. a newer version that is only waiting for its publish time has to be reviewed again
+		if gameRecord.NewestGameVersionId != 0 && gameRecord.NewestGameVersionId != onlineVersion.Id {
+			newestVersion, err := lockVersion(tx, gameID, gameRecord.NewestGameVersionId)
+			if err != nil {
+				return err
+			}
+			if newestVersion.Status == int(game.GameStatus_Scheduled) {
+				if err := CheckTransition(game.GameStatus_Scheduled, game.GameStatus_Reviewing); err != nil {
+					return err
+				}
+				updateData := map[string]interface{}{
+					"status":     int(game.GameStatus_Reviewing),
+					"publish_at": 0,
+				}
+				if err := updateLockedVersion(tx, newestVersion, updateData); err != nil {
+					return err
+				}
+			}
+		}
+
+		// 4. clear the online pointer and remember what was taken down; a rollout in progress ends with it
+		updateData := map[string]interface{}{
+			"online_game_version_id": 0,
+			"takedown_version_id":    onlineVersion.Id,
+		}
+		clearRollout(updateData)
+		if err := tx.Model(gameRecord).Updates(updateData).Error; err != nil {
+			return err
+		}
+
+		// 5. record who took the game down and why
+		operationLog.GameId = gameID
+		operationLog.FromVersionId = onlineVersion.Id
+		operationLog.ToVersionId = 0
+		return tx.Create(operationLog).Error
+	})
+}
+
+// RestoreGame lifts a takedown. The taken-down version goes back to review instead of going online directly,
+// so it is only republished once a reviewer passes it again.
+// If the CP has started a newer version since the takedown, that version has to be submitted instead.
+func (d *gameDAO) RestoreGame(ctx context.Context, gameID uint64, operationLog *ddl.GpGameOperationLog) error {
+	return dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. lock the game and the taken-down version
+		gameRecord, err := lockGame(tx, gameID)
+		if err != nil {
+			return err
+		}
+		if gameRecord.TakedownVersionId == 0 {
+			return ErrGameNotTakenDown
+		}
+		if gameRecord.NewestGameVersionId != gameRecord.TakedownVersionId {
+			return fmt.Errorf("%w: a newer version exists, submit it for review instead", ErrIllegalStatusTransition)
+		}
+		version, err := lockVersion(tx, gameID, gameRecord.TakedownVersionId)
+		if err != nil {
+			return err
+		}
+
+		// 2. send the version back to review, reserving its package names again
+		if err := CheckTransition(game.GameStatus(version.Status), game.GameStatus_Reviewing); err != nil {
+			return err
+		}
+		if err := claimPackageNames(tx, gameID, version, constdef.PackageClaimReserved); err != nil {
+			return err
+		}
+		if err := updateLockedVersion(tx, version, map[string]interface{}{"status": int(game.GameStatus_Reviewing)}); err != nil {
+			return err
+		}
+		if err := tx.Model(gameRecord).Update("takedown_version_id", 0).Error; err != nil {
+			return err
+		}
+
+		// 3. record who restored the game and why
+		operationLog.GameId = gameID
+		operationLog.FromVersionId = version.Id
+		operationLog.ToVersionId = 0
+		return tx.Create(operationLog).Error
+	})
+}
+
+// GetLatestGameOperationLog returns the most recent operation of the given type on a game.
+func (d *gameDAO) GetLatestGameOperationLog(ctx context.Context, gameID uint64, operationType int) (*ddl.GpGameOperationLog, error) {
+	var operationLog ddl.GpGameOperationLog
+	err := dal.DB.WithContext(ctx).
+		Where("game_id = ? AND operation_type = ?", gameID, operationType).
+		Order("create_ts DESC").Order("id DESC").
+		First(&operationLog).Error
+	if err != nil {
+		return nil, err
+	}
+	return &operationLog, nil
+}
@@ -0,0 +1,30 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCheckRollbackTarget_AfterTakedownAndRestore tests that a restored game cannot skip its fresh review by
+// rolling back to an older published version
+func TestCheckRollbackTarget_AfterTakedownAndRestore(t *testing.T) {
+	older := &ddl.GpGameVersion{Id: 200, GameId: 101, Status: int(game.GameStatus_Published)}
+	gameRecord := &ddl.GpGame{Id: 101, OnlineGameVersionId: 201, NewestGameVersionId: 201}
+	assert.NoError(t, checkRollbackTarget(gameRecord, older))
+
+	// what TakedownGame writes: the online version goes offline and the pointers move
+	gameRecord.OnlineGameVersionId, gameRecord.TakedownVersionId = 0, 201
+	assert.True(t, errors.Is(checkRollbackTarget(gameRecord, older), ErrGameTakenDown))
+
+	// what RestoreGame writes: the taken-down version is back in review and the game has no online version
+	gameRecord.TakedownVersionId = 0
+	assert.True(t, errors.Is(checkRollbackTarget(gameRecord, older), ErrGameNotOnline))
+
+	// once the reviewer passes the restored version, rolling back to an older version is allowed again
+	gameRecord.OnlineGameVersionId = 201
+	assert.NoError(t, checkRollbackTarget(gameRecord, older))
+}
@@ -0,0 +1,133 @@
+package dao
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"gorm.io/gorm"
+)
+
+// SummaryVersionID returns the version whose content gp_game mirrors for game lists: the online version,
+// else the taken-down version, which is still what players last saw, else the newest version of a game
+// that has never been online. It is 0 for a game whose only draft was deleted.
+func SummaryVersionID(gameRecord *ddl.GpGame) uint64 {
+	if gameRecord.OnlineGameVersionId != 0 {
+		return gameRecord.OnlineGameVersionId
+	}
+	if gameRecord.TakedownVersionId != 0 {
+		return gameRecord.TakedownVersionId
+	}
+	return gameRecord.NewestGameVersionId
+}
+
+// gameSummaryColumns returns the gp_game columns mirroring a version. A version described by per-platform
+// builds may leave platform, package_name and download_url empty; they are then taken from its builds,
+// the package and download of the first app build.
+func gameSummaryColumns(version *ddl.GpGameVersion) (map[string]interface{}, error) {
+	platform := version.Platform
+	packageName := version.PackageName
+	downloadURL := version.DownloadUrl
+	builds, err := ParseBuilds(version)
+	if err != nil {
+		return nil, err
+	}
+	if len(builds) > 0 {
+		if platform == "" {
+			platforms := make([]int, 0, len(builds))
+			for _, build := range builds {
+				platforms = append(platforms, build.Platform)
+			}
+			data, err := json.Marshal(platforms)
+			if err != nil {
+				return nil, err
+			}
+			platform = string(data)
+		}
+		for _, build := range builds {
+			if isAppPlatform(build.Platform) {
+				if packageName == "" {
+					packageName = build.Identifier
+				}
+				if downloadURL == "" {
+					downloadURL = build.DownloadURL
+				}
+				break
+			}
+		}
+	}
+
+	return map[string]interface{}{
+		"game_name":                version.GameName,
+		"game_icon":                version.GameIcon,
+		"header_image":             version.HeaderImage,
+		"game_introduction":        version.GameIntroduction,
+		"game_introduction_images": version.GameIntroductionImages,
+		"platform":                 platform,
+		"package_name":             packageName,
+		"download_url":             downloadURL,
+	}, nil
+}
+
+// GameSummaryDrift returns the summary columns of a game that differ from the version it mirrors, sorted.
+func GameSummaryDrift(gameRecord *ddl.GpGame, version *ddl.GpGameVersion) ([]string, error) {
+	want, err := gameSummaryColumns(version)
+	if err != nil {
+		return nil, err
+	}
+	have := map[string]interface{}{
+		"game_name":                gameRecord.GameName,
+		"game_icon":                gameRecord.GameIcon,
+		"header_image":             gameRecord.HeaderImage,
+		"game_introduction":        gameRecord.GameIntroduction,
+		"game_introduction_images": gameRecord.GameIntroductionImages,
+		"platform":                 gameRecord.Platform,
+		"package_name":             gameRecord.PackageName,
+		"download_url":             gameRecord.DownloadUrl,
+	}
+	var drifted []string
+	for column, value := range want {
+		if have[column] != value {
+			drifted = append(drifted, column)
+		}
+	}
+	sort.Strings(drifted)
+	return drifted, nil
+}
+
+// syncGameSummary copies the content of the version a locked game mirrors into its gp_game row, in the
+// transaction that changed which version that is. gameRecord must already hold the version pointers written
+// by the transaction. It returns the columns that changed.
+func syncGameSummary(tx *gorm.DB, gameRecord *ddl.GpGame) ([]string, error) {
+	versionID := SummaryVersionID(gameRecord)
+	if versionID == 0 {
+		return nil, nil
+	}
+	var version ddl.GpGameVersion
+	if err := tx.Where("id = ? AND game_id = ?", versionID, gameRecord.Id).First(&version).Error; err != nil {
+		return nil, fmt.Errorf("failed to load version %d mirrored by game %d: %w", versionID, gameRecord.Id, err)
+	}
+	drifted, err := GameSummaryDrift(gameRecord, &version)
+	if err != nil || len(drifted) == 0 {
+		return nil, err
+	}
+	columns, err := gameSummaryColumns(&version)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Model(gameRecord).Updates(columns).Error; err != nil {
+		return nil, err
+	}
+	return drifted, nil
+}
+
+// syncDraftSummary calls syncGameSummary after a draft edit. Only a game that has neither an online nor a
+// taken-down version mirrors its newest version, so any other game is left alone without reading its versions.
+func syncDraftSummary(tx *gorm.DB, gameRecord *ddl.GpGame) error {
+	if gameRecord.OnlineGameVersionId != 0 || gameRecord.TakedownVersionId != 0 {
+		return nil
+	}
+	_, err := syncGameSummary(tx, gameRecord)
+	return err
+}
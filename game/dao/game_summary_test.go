@@ -0,0 +1,67 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSummaryVersionID tests which version the summary columns of a game mirror
+func TestSummaryVersionID(t *testing.T) {
+	assert.Equal(t, uint64(3), SummaryVersionID(&ddl.GpGame{OnlineGameVersionId: 3, TakedownVersionId: 2, NewestGameVersionId: 4}))
+	assert.Equal(t, uint64(2), SummaryVersionID(&ddl.GpGame{TakedownVersionId: 2, NewestGameVersionId: 4}))
+	assert.Equal(t, uint64(4), SummaryVersionID(&ddl.GpGame{NewestGameVersionId: 4}))
+	assert.Equal(t, uint64(0), SummaryVersionID(&ddl.GpGame{}))
+}
+
+// TestGameSummaryDrift_InSync tests that a game copied from its version has no drift
+func TestGameSummaryDrift_InSync(t *testing.T) {
+	version := &ddl.GpGameVersion{
+		GameName: "Star Quest", GameIcon: "icon.png", HeaderImage: "header.png", GameIntroduction: "A space adventure",
+		GameIntroductionImages: `["shot.png"]`, Platform: "[1]", PackageName: "com.example.star", DownloadUrl: "https://cdn.example.com/star.apk",
+	}
+	gameRecord := &ddl.GpGame{
+		GameName: "Star Quest", GameIcon: "icon.png", HeaderImage: "header.png", GameIntroduction: "A space adventure",
+		GameIntroductionImages: `["shot.png"]`, Platform: "[1]", PackageName: "com.example.star", DownloadUrl: "https://cdn.example.com/star.apk",
+	}
+
+	drifted, err := GameSummaryDrift(gameRecord, version)
+	assert.NoError(t, err)
+	assert.Empty(t, drifted)
+}
+
+// TestGameSummaryDrift_Stale tests that a game created before its version was renamed and published reports every stale column
+func TestGameSummaryDrift_Stale(t *testing.T) {
+	version := &ddl.GpGameVersion{
+		GameName: "Star Quest II", GameIcon: "icon.png", HeaderImage: "header.png", GameIntroduction: "A space adventure",
+		Platform: "[1]", PackageName: "com.example.star",
+	}
+	gameRecord := &ddl.GpGame{GameName: "Star Quest", GameIcon: "icon.png", HeaderImage: "header.png"}
+
+	drifted, err := GameSummaryDrift(gameRecord, version)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"game_introduction", "game_name", "package_name", "platform"}, drifted)
+}
+
+// TestGameSummaryColumns_FromBuilds tests that the platforms, package and download of a version described by builds come from its first app build
+func TestGameSummaryColumns_FromBuilds(t *testing.T) {
+	version := &ddl.GpGameVersion{
+		GameName: "Star Quest",
+		Builds: `[{"platform":3,"download_url":"https://play.example.com/star"},` +
+			`{"platform":1,"identifier":"com.example.star","download_url":"https://cdn.example.com/star.apk"},` +
+			`{"platform":2,"identifier":"com.example.star.ios"}]`,
+	}
+
+	columns, err := gameSummaryColumns(version)
+	assert.NoError(t, err)
+	assert.Equal(t, "[3,1,2]", columns["platform"])
+	assert.Equal(t, "com.example.star", columns["package_name"])
+	assert.Equal(t, "https://cdn.example.com/star.apk", columns["download_url"])
+}
+
+// TestGameSummaryColumns_InvalidBuilds tests that unparsable builds are reported instead of mirrored
+func TestGameSummaryColumns_InvalidBuilds(t *testing.T) {
+	_, err := gameSummaryColumns(&ddl.GpGameVersion{Id: 7, Builds: "not json"})
+	assert.Error(t, err)
+}
@@ -10,6 +10,7 @@ import (
 
 	dao "github.com/GameLaunchPad/game_management_project/game/dao"
 	ddl "github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	game "github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -36,6 +37,64 @@ func (m *MockIGameDAO) EXPECT() *MockIGameDAOMockRecorder {
 	return m.recorder
 }
 
+// BatchGetGameDetails mocks base method.
+func (m *MockIGameDAO) BatchGetGameDetails(ctx context.Context, gameIDs []uint64) (map[uint64]*dao.GameDetailRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetGameDetails", ctx, gameIDs)
+	ret0, _ := ret[0].(map[uint64]*dao.GameDetailRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetGameDetails indicates an expected call of BatchGetGameDetails.
+func (mr *MockIGameDAOMockRecorder) BatchGetGameDetails(ctx, gameIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetGameDetails", reflect.TypeOf((*MockIGameDAO)(nil).BatchGetGameDetails), ctx, gameIDs)
+}
+
+// CancelScheduledPublish mocks base method.
+func (m *MockIGameDAO) CancelScheduledPublish(ctx context.Context, gameID, versionID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduledPublish", ctx, gameID, versionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelScheduledPublish indicates an expected call of CancelScheduledPublish.
+func (mr *MockIGameDAOMockRecorder) CancelScheduledPublish(ctx, gameID, versionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledPublish", reflect.TypeOf((*MockIGameDAO)(nil).CancelScheduledPublish), ctx, gameID, versionID)
+}
+
+// CountGames mocks base method.
+func (m *MockIGameDAO) CountGames(ctx context.Context, opts *dao.GameListOptions) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountGames", ctx, opts)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountGames indicates an expected call of CountGames.
+func (mr *MockIGameDAOMockRecorder) CountGames(ctx, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountGames", reflect.TypeOf((*MockIGameDAO)(nil).CountGames), ctx, opts)
+}
+
+// CreateCategory mocks base method.
+func (m *MockIGameDAO) CreateCategory(ctx context.Context, category *ddl.GpCategory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockIGameDAOMockRecorder) CreateCategory(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockIGameDAO)(nil).CreateCategory), ctx, category)
+}
+
 // CreateGame mocks base method.
 func (m *MockIGameDAO) CreateGame(ctx context.Context, game *ddl.GpGame, version *ddl.GpGameVersion) error {
 	m.ctrl.T.Helper()
@@ -50,20 +109,78 @@ func (mr *MockIGameDAOMockRecorder) CreateGame(ctx, game, version interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGame", reflect.TypeOf((*MockIGameDAO)(nil).CreateGame), ctx, game, version)
 }
 
-// DeleteGameDraft mocks base method.
-func (m *MockIGameDAO) DeleteGameDraft(ctx context.Context, gameID uint64) error {
+// CreateTag mocks base method.
+func (m *MockIGameDAO) CreateTag(ctx context.Context, tag *ddl.GpTag) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteGameDraft", ctx, gameID)
+	ret := m.ctrl.Call(m, "CreateTag", ctx, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTag indicates an expected call of CreateTag.
+func (mr *MockIGameDAOMockRecorder) CreateTag(ctx, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTag", reflect.TypeOf((*MockIGameDAO)(nil).CreateTag), ctx, tag)
+}
+
+// DeleteCategory mocks base method.
+func (m *MockIGameDAO) DeleteCategory(ctx context.Context, categoryID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, categoryID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockIGameDAOMockRecorder) DeleteCategory(ctx, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockIGameDAO)(nil).DeleteCategory), ctx, categoryID)
+}
+
+// DeleteGameDraft mocks base method.
+func (m *MockIGameDAO) DeleteGameDraft(ctx context.Context, gameID uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGameDraft", ctx, gameID)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // DeleteGameDraft indicates an expected call of DeleteGameDraft.
 func (mr *MockIGameDAOMockRecorder) DeleteGameDraft(ctx, gameID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGameDraft", reflect.TypeOf((*MockIGameDAO)(nil).DeleteGameDraft), ctx, gameID)
 }
 
+// DeleteTag mocks base method.
+func (m *MockIGameDAO) DeleteTag(ctx context.Context, tagID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTag", ctx, tagID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockIGameDAOMockRecorder) DeleteTag(ctx, tagID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockIGameDAO)(nil).DeleteTag), ctx, tagID)
+}
+
+// GetGame mocks base method.
+func (m *MockIGameDAO) GetGame(ctx context.Context, gameID uint64) (*ddl.GpGame, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGame", ctx, gameID)
+	ret0, _ := ret[0].(*ddl.GpGame)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGame indicates an expected call of GetGame.
+func (mr *MockIGameDAOMockRecorder) GetGame(ctx, gameID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGame", reflect.TypeOf((*MockIGameDAO)(nil).GetGame), ctx, gameID)
+}
+
 // GetGameDetail mocks base method.
 func (m *MockIGameDAO) GetGameDetail(ctx context.Context, gameID uint64) (*ddl.GpGame, *ddl.GpGameVersion, *ddl.GpGameVersion, error) {
 	m.ctrl.T.Helper()
@@ -82,9 +199,9 @@ func (mr *MockIGameDAOMockRecorder) GetGameDetail(ctx, gameID interface{}) *gomo
 }
 
 // GetGameList mocks base method.
-func (m *MockIGameDAO) GetGameList(ctx context.Context, filterText *string, pageNum, pageSize int) ([]*dao.GameWithVersionStatus, int64, error) {
+func (m *MockIGameDAO) GetGameList(ctx context.Context, opts *dao.GameListOptions, pageNum, pageSize int) ([]*dao.GameWithVersionStatus, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGameList", ctx, filterText, pageNum, pageSize)
+	ret := m.ctrl.Call(m, "GetGameList", ctx, opts, pageNum, pageSize)
 	ret0, _ := ret[0].([]*dao.GameWithVersionStatus)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -92,35 +209,431 @@ func (m *MockIGameDAO) GetGameList(ctx context.Context, filterText *string, page
 }
 
 // GetGameList indicates an expected call of GetGameList.
-func (mr *MockIGameDAOMockRecorder) GetGameList(ctx, filterText, pageNum, pageSize interface{}) *gomock.Call {
+func (mr *MockIGameDAOMockRecorder) GetGameList(ctx, opts, pageNum, pageSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameList", reflect.TypeOf((*MockIGameDAO)(nil).GetGameList), ctx, opts, pageNum, pageSize)
+}
+
+// GetGameListAfter mocks base method.
+func (m *MockIGameDAO) GetGameListAfter(ctx context.Context, opts *dao.GameListOptions, after *dao.GameListCursor, limit int) ([]*dao.GameWithVersionStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameListAfter", ctx, opts, after, limit)
+	ret0, _ := ret[0].([]*dao.GameWithVersionStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameListAfter indicates an expected call of GetGameListAfter.
+func (mr *MockIGameDAOMockRecorder) GetGameListAfter(ctx, opts, after, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameListAfter", reflect.TypeOf((*MockIGameDAO)(nil).GetGameListAfter), ctx, opts, after, limit)
+}
+
+// GetGameVersion mocks base method.
+func (m *MockIGameDAO) GetGameVersion(ctx context.Context, gameID, versionID uint64) (*ddl.GpGameVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameVersion", ctx, gameID, versionID)
+	ret0, _ := ret[0].(*ddl.GpGameVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameVersion indicates an expected call of GetGameVersion.
+func (mr *MockIGameDAOMockRecorder) GetGameVersion(ctx, gameID, versionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameVersion", reflect.TypeOf((*MockIGameDAO)(nil).GetGameVersion), ctx, gameID, versionID)
+}
+
+// GetLatestGameOperationLog mocks base method.
+func (m *MockIGameDAO) GetLatestGameOperationLog(ctx context.Context, gameID uint64, operationType int) (*ddl.GpGameOperationLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestGameOperationLog", ctx, gameID, operationType)
+	ret0, _ := ret[0].(*ddl.GpGameOperationLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestGameOperationLog indicates an expected call of GetLatestGameOperationLog.
+func (mr *MockIGameDAOMockRecorder) GetLatestGameOperationLog(ctx, gameID, operationType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestGameOperationLog", reflect.TypeOf((*MockIGameDAO)(nil).GetLatestGameOperationLog), ctx, gameID, operationType)
+}
+
+// GetLatestGameOperationLogs mocks base method.
+func (m *MockIGameDAO) GetLatestGameOperationLogs(ctx context.Context, gameIDs []uint64, operationType int) (map[uint64]*ddl.GpGameOperationLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestGameOperationLogs", ctx, gameIDs, operationType)
+	ret0, _ := ret[0].(map[uint64]*ddl.GpGameOperationLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestGameOperationLogs indicates an expected call of GetLatestGameOperationLogs.
+func (mr *MockIGameDAOMockRecorder) GetLatestGameOperationLogs(ctx, gameIDs, operationType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestGameOperationLogs", reflect.TypeOf((*MockIGameDAO)(nil).GetLatestGameOperationLogs), ctx, gameIDs, operationType)
+}
+
+// ListCategories mocks base method.
+func (m *MockIGameDAO) ListCategories(ctx context.Context) ([]*ddl.GpCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategories", ctx)
+	ret0, _ := ret[0].([]*ddl.GpCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategories indicates an expected call of ListCategories.
+func (mr *MockIGameDAOMockRecorder) ListCategories(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockIGameDAO)(nil).ListCategories), ctx)
+}
+
+// ListDeletedGameDrafts mocks base method.
+func (m *MockIGameDAO) ListDeletedGameDrafts(ctx context.Context, gameID uint64, deletedAfter int64) ([]*ddl.GpGameVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletedGameDrafts", ctx, gameID, deletedAfter)
+	ret0, _ := ret[0].([]*ddl.GpGameVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeletedGameDrafts indicates an expected call of ListDeletedGameDrafts.
+func (mr *MockIGameDAOMockRecorder) ListDeletedGameDrafts(ctx, gameID, deletedAfter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedGameDrafts", reflect.TypeOf((*MockIGameDAO)(nil).ListDeletedGameDrafts), ctx, gameID, deletedAfter)
+}
+
+// ListDueScheduledVersions mocks base method.
+func (m *MockIGameDAO) ListDueScheduledVersions(ctx context.Context, now int64) ([]*ddl.GpGameVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueScheduledVersions", ctx, now)
+	ret0, _ := ret[0].([]*ddl.GpGameVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueScheduledVersions indicates an expected call of ListDueScheduledVersions.
+func (mr *MockIGameDAOMockRecorder) ListDueScheduledVersions(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueScheduledVersions", reflect.TypeOf((*MockIGameDAO)(nil).ListDueScheduledVersions), ctx, now)
+}
+
+// ListGameReviewLogs mocks base method.
+func (m *MockIGameDAO) ListGameReviewLogs(ctx context.Context, gameID, versionID uint64) ([]*ddl.GpGameReviewLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGameReviewLogs", ctx, gameID, versionID)
+	ret0, _ := ret[0].([]*ddl.GpGameReviewLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGameReviewLogs indicates an expected call of ListGameReviewLogs.
+func (mr *MockIGameDAOMockRecorder) ListGameReviewLogs(ctx, gameID, versionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGameReviewLogs", reflect.TypeOf((*MockIGameDAO)(nil).ListGameReviewLogs), ctx, gameID, versionID)
+}
+
+// ListGameRolloutLogs mocks base method.
+func (m *MockIGameDAO) ListGameRolloutLogs(ctx context.Context, gameID uint64) ([]*ddl.GpGameRolloutLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGameRolloutLogs", ctx, gameID)
+	ret0, _ := ret[0].([]*ddl.GpGameRolloutLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGameRolloutLogs indicates an expected call of ListGameRolloutLogs.
+func (mr *MockIGameDAOMockRecorder) ListGameRolloutLogs(ctx, gameID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGameRolloutLogs", reflect.TypeOf((*MockIGameDAO)(nil).ListGameRolloutLogs), ctx, gameID)
+}
+
+// ListGameVersions mocks base method.
+func (m *MockIGameDAO) ListGameVersions(ctx context.Context, gameID uint64, statuses []int, pageNum, pageSize int) ([]*ddl.GpGameVersion, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGameVersions", ctx, gameID, statuses, pageNum, pageSize)
+	ret0, _ := ret[0].([]*ddl.GpGameVersion)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListGameVersions indicates an expected call of ListGameVersions.
+func (mr *MockIGameDAOMockRecorder) ListGameVersions(ctx, gameID, statuses, pageNum, pageSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGameVersions", reflect.TypeOf((*MockIGameDAO)(nil).ListGameVersions), ctx, gameID, statuses, pageNum, pageSize)
+}
+
+// ListTags mocks base method.
+func (m *MockIGameDAO) ListTags(ctx context.Context) ([]*ddl.GpTag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTags", ctx)
+	ret0, _ := ret[0].([]*ddl.GpTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockIGameDAOMockRecorder) ListTags(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockIGameDAO)(nil).ListTags), ctx)
+}
+
+// PublishScheduledVersion mocks base method.
+func (m *MockIGameDAO) PublishScheduledVersion(ctx context.Context, gameID, versionID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishScheduledVersion", ctx, gameID, versionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishScheduledVersion indicates an expected call of PublishScheduledVersion.
+func (mr *MockIGameDAOMockRecorder) PublishScheduledVersion(ctx, gameID, versionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishScheduledVersion", reflect.TypeOf((*MockIGameDAO)(nil).PublishScheduledVersion), ctx, gameID, versionID)
+}
+
+// ReleasePackageName mocks base method.
+func (m *MockIGameDAO) ReleasePackageName(ctx context.Context, platform int, packageName string, transferToGameID uint64, operationLog *ddl.GpGameOperationLog) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleasePackageName", ctx, platform, packageName, transferToGameID, operationLog)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleasePackageName indicates an expected call of ReleasePackageName.
+func (mr *MockIGameDAOMockRecorder) ReleasePackageName(ctx, platform, packageName, transferToGameID, operationLog interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasePackageName", reflect.TypeOf((*MockIGameDAO)(nil).ReleasePackageName), ctx, platform, packageName, transferToGameID, operationLog)
+}
+
+// RepairGameSummary mocks base method.
+func (m *MockIGameDAO) RepairGameSummary(ctx context.Context, gameID uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepairGameSummary", ctx, gameID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RepairGameSummary indicates an expected call of RepairGameSummary.
+func (mr *MockIGameDAOMockRecorder) RepairGameSummary(ctx, gameID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepairGameSummary", reflect.TypeOf((*MockIGameDAO)(nil).RepairGameSummary), ctx, gameID)
+}
+
+// RestoreGame mocks base method.
+func (m *MockIGameDAO) RestoreGame(ctx context.Context, gameID uint64, operationLog *ddl.GpGameOperationLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreGame", ctx, gameID, operationLog)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreGame indicates an expected call of RestoreGame.
+func (mr *MockIGameDAOMockRecorder) RestoreGame(ctx, gameID, operationLog interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreGame", reflect.TypeOf((*MockIGameDAO)(nil).RestoreGame), ctx, gameID, operationLog)
+}
+
+// RestoreGameDraft mocks base method.
+func (m *MockIGameDAO) RestoreGameDraft(ctx context.Context, gameID, versionID uint64, deletedAfter int64) (*ddl.GpGameVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreGameDraft", ctx, gameID, versionID, deletedAfter)
+	ret0, _ := ret[0].(*ddl.GpGameVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreGameDraft indicates an expected call of RestoreGameDraft.
+func (mr *MockIGameDAOMockRecorder) RestoreGameDraft(ctx, gameID, versionID, deletedAfter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameList", reflect.TypeOf((*MockIGameDAO)(nil).GetGameList), ctx, filterText, pageNum, pageSize)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreGameDraft", reflect.TypeOf((*MockIGameDAO)(nil).RestoreGameDraft), ctx, gameID, versionID, deletedAfter)
 }
 
 // ReviewGameVersion mocks base method.
-func (m *MockIGameDAO) ReviewGameVersion(ctx context.Context, gameID, versionID uint64, newStatus int, reviewComment string) error {
+func (m *MockIGameDAO) ReviewGameVersion(ctx context.Context, gameID, versionID uint64, newStatus int, reviewLog *ddl.GpGameReviewLog) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReviewGameVersion", ctx, gameID, versionID, newStatus, reviewComment)
+	ret := m.ctrl.Call(m, "ReviewGameVersion", ctx, gameID, versionID, newStatus, reviewLog)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ReviewGameVersion indicates an expected call of ReviewGameVersion.
-func (mr *MockIGameDAOMockRecorder) ReviewGameVersion(ctx, gameID, versionID, newStatus, reviewComment interface{}) *gomock.Call {
+func (mr *MockIGameDAOMockRecorder) ReviewGameVersion(ctx, gameID, versionID, newStatus, reviewLog interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewGameVersion", reflect.TypeOf((*MockIGameDAO)(nil).ReviewGameVersion), ctx, gameID, versionID, newStatus, reviewLog)
+}
+
+// RollbackGameVersion mocks base method.
+func (m *MockIGameDAO) RollbackGameVersion(ctx context.Context, gameID, versionID uint64, operationLog *ddl.GpGameOperationLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackGameVersion", ctx, gameID, versionID, operationLog)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackGameVersion indicates an expected call of RollbackGameVersion.
+func (mr *MockIGameDAOMockRecorder) RollbackGameVersion(ctx, gameID, versionID, operationLog interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackGameVersion", reflect.TypeOf((*MockIGameDAO)(nil).RollbackGameVersion), ctx, gameID, versionID, operationLog)
+}
+
+// ScanGameSummaries mocks base method.
+func (m *MockIGameDAO) ScanGameSummaries(ctx context.Context, afterID uint64, limit int) ([]*dao.GameWithSummaryVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanGameSummaries", ctx, afterID, limit)
+	ret0, _ := ret[0].([]*dao.GameWithSummaryVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScanGameSummaries indicates an expected call of ScanGameSummaries.
+func (mr *MockIGameDAOMockRecorder) ScanGameSummaries(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanGameSummaries", reflect.TypeOf((*MockIGameDAO)(nil).ScanGameSummaries), ctx, afterID, limit)
+}
+
+// ScanGamesWithNewestVersion mocks base method.
+func (m *MockIGameDAO) ScanGamesWithNewestVersion(ctx context.Context, afterID uint64, limit int) ([]*dao.GameWithNewestVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanGamesWithNewestVersion", ctx, afterID, limit)
+	ret0, _ := ret[0].([]*dao.GameWithNewestVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScanGamesWithNewestVersion indicates an expected call of ScanGamesWithNewestVersion.
+func (mr *MockIGameDAOMockRecorder) ScanGamesWithNewestVersion(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanGamesWithNewestVersion", reflect.TypeOf((*MockIGameDAO)(nil).ScanGamesWithNewestVersion), ctx, afterID, limit)
+}
+
+// ScheduleGameVersion mocks base method.
+func (m *MockIGameDAO) ScheduleGameVersion(ctx context.Context, gameID, versionID uint64, publishAt int64, reviewLog *ddl.GpGameReviewLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleGameVersion", ctx, gameID, versionID, publishAt, reviewLog)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScheduleGameVersion indicates an expected call of ScheduleGameVersion.
+func (mr *MockIGameDAOMockRecorder) ScheduleGameVersion(ctx, gameID, versionID, publishAt, reviewLog interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleGameVersion", reflect.TypeOf((*MockIGameDAO)(nil).ScheduleGameVersion), ctx, gameID, versionID, publishAt, reviewLog)
+}
+
+// StartGameRollout mocks base method.
+func (m *MockIGameDAO) StartGameRollout(ctx context.Context, gameID, versionID uint64, percentage int, reviewLog *ddl.GpGameReviewLog, rolloutLog *ddl.GpGameRolloutLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartGameRollout", ctx, gameID, versionID, percentage, reviewLog, rolloutLog)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartGameRollout indicates an expected call of StartGameRollout.
+func (mr *MockIGameDAOMockRecorder) StartGameRollout(ctx, gameID, versionID, percentage, reviewLog, rolloutLog interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartGameRollout", reflect.TypeOf((*MockIGameDAO)(nil).StartGameRollout), ctx, gameID, versionID, percentage, reviewLog, rolloutLog)
+}
+
+// SubmitGameVersion mocks base method.
+func (m *MockIGameDAO) SubmitGameVersion(ctx context.Context, gameID, versionID uint64, expectedRevision int64, apkManifest string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitGameVersion", ctx, gameID, versionID, expectedRevision, apkManifest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubmitGameVersion indicates an expected call of SubmitGameVersion.
+func (mr *MockIGameDAOMockRecorder) SubmitGameVersion(ctx, gameID, versionID, expectedRevision, apkManifest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitGameVersion", reflect.TypeOf((*MockIGameDAO)(nil).SubmitGameVersion), ctx, gameID, versionID, expectedRevision, apkManifest)
+}
+
+// TakedownGame mocks base method.
+func (m *MockIGameDAO) TakedownGame(ctx context.Context, gameID uint64, operationLog *ddl.GpGameOperationLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakedownGame", ctx, gameID, operationLog)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TakedownGame indicates an expected call of TakedownGame.
+func (mr *MockIGameDAOMockRecorder) TakedownGame(ctx, gameID, operationLog interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewGameVersion", reflect.TypeOf((*MockIGameDAO)(nil).ReviewGameVersion), ctx, gameID, versionID, newStatus, reviewComment)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakedownGame", reflect.TypeOf((*MockIGameDAO)(nil).TakedownGame), ctx, gameID, operationLog)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockIGameDAO) UpdateCategory(ctx context.Context, category *ddl.GpCategory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockIGameDAOMockRecorder) UpdateCategory(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockIGameDAO)(nil).UpdateCategory), ctx, category)
 }
 
 // UpdateGameDraft mocks base method.
-func (m *MockIGameDAO) UpdateGameDraft(ctx context.Context, gameID uint64, version *ddl.GpGameVersion) error {
+func (m *MockIGameDAO) UpdateGameDraft(ctx context.Context, gameID uint64, version *ddl.GpGameVersion, expectedRevision *int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateGameDraft", ctx, gameID, version)
+	ret := m.ctrl.Call(m, "UpdateGameDraft", ctx, gameID, version, expectedRevision)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateGameDraft indicates an expected call of UpdateGameDraft.
-func (mr *MockIGameDAOMockRecorder) UpdateGameDraft(ctx, gameID, version interface{}) *gomock.Call {
+func (mr *MockIGameDAOMockRecorder) UpdateGameDraft(ctx, gameID, version, expectedRevision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameDraft", reflect.TypeOf((*MockIGameDAO)(nil).UpdateGameDraft), ctx, gameID, version, expectedRevision)
+}
+
+// UpdateGameRollout mocks base method.
+func (m *MockIGameDAO) UpdateGameRollout(ctx context.Context, gameID, versionID uint64, action game.RolloutAction, percentage int, rolloutLog *ddl.GpGameRolloutLog) (*ddl.GpGame, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGameRollout", ctx, gameID, versionID, action, percentage, rolloutLog)
+	ret0, _ := ret[0].(*ddl.GpGame)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGameRollout indicates an expected call of UpdateGameRollout.
+func (mr *MockIGameDAOMockRecorder) UpdateGameRollout(ctx, gameID, versionID, action, percentage, rolloutLog interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameRollout", reflect.TypeOf((*MockIGameDAO)(nil).UpdateGameRollout), ctx, gameID, versionID, action, percentage, rolloutLog)
+}
+
+// UpdateTag mocks base method.
+func (m *MockIGameDAO) UpdateTag(ctx context.Context, tagID uint64, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTag", ctx, tagID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTag indicates an expected call of UpdateTag.
+func (mr *MockIGameDAOMockRecorder) UpdateTag(ctx, tagID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTag", reflect.TypeOf((*MockIGameDAO)(nil).UpdateTag), ctx, tagID, name)
+}
+
+// WithdrawGameVersion mocks base method.
+func (m *MockIGameDAO) WithdrawGameVersion(ctx context.Context, gameID, versionID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawGameVersion", ctx, gameID, versionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithdrawGameVersion indicates an expected call of WithdrawGameVersion.
+func (mr *MockIGameDAOMockRecorder) WithdrawGameVersion(ctx, gameID, versionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameDraft", reflect.TypeOf((*MockIGameDAO)(nil).UpdateGameDraft), ctx, gameID, version)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawGameVersion", reflect.TypeOf((*MockIGameDAO)(nil).WithdrawGameVersion), ctx, gameID, versionID)
 }
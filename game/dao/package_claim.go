@@ -0,0 +1,243 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/GameLaunchPad/game_management_project/game/constdef"
+	"github.com/GameLaunchPad/game_management_project/game/dal"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// mysqlErrDuplicateEntry is the MySQL error number of a unique key violation.
+const mysqlErrDuplicateEntry = 1062
+
+var ErrPackageNameConflict = errors.New("the package name is already used by another game")
+
+// packageClaimKey is a package name on one platform, the unit of uniqueness of gp_package_claim.
+type packageClaimKey struct {
+	Platform    int
+	PackageName string
+}
+
+// packageClaimKeys returns the package names a version needs: the identifier of each of its app builds,
+// or for a version without builds its package name on each app platform it is distributed on. Web games
+// have no package, and a version without a package name claims nothing.
+func packageClaimKeys(version *ddl.GpGameVersion) ([]packageClaimKey, error) {
+	builds, err := ParseBuilds(version)
+	if err != nil {
+		return nil, err
+	}
+	if len(builds) > 0 {
+		var keys []packageClaimKey
+		seen := make(map[int]bool, len(builds))
+		for _, build := range builds {
+			if build.Identifier == "" || seen[build.Platform] || !isAppPlatform(build.Platform) {
+				continue
+			}
+			seen[build.Platform] = true
+			keys = append(keys, packageClaimKey{Platform: build.Platform, PackageName: build.Identifier})
+		}
+		return keys, nil
+	}
+
+	if version.PackageName == "" || version.Platform == "" {
+		return nil, nil
+	}
+	var platforms []int
+	if err := json.Unmarshal([]byte(version.Platform), &platforms); err != nil {
+		return nil, fmt.Errorf("failed to parse platforms of version %d: %w", version.Id, err)
+	}
+	var keys []packageClaimKey
+	seen := make(map[int]bool, len(platforms))
+	for _, platform := range platforms {
+		if seen[platform] || !isAppPlatform(platform) {
+			continue
+		}
+		seen[platform] = true
+		keys = append(keys, packageClaimKey{Platform: platform, PackageName: version.PackageName})
+	}
+	return keys, nil
+}
+
+// isAppPlatform reports whether games are distributed on platform as a package with a unique name.
+func isAppPlatform(platform int) bool {
+	return platform == int(game.GamePlatform_Android) || platform == int(game.GamePlatform_IOS)
+}
+
+// packageNameConflict builds the error for a package name held by another game, without naming that
+// game, which may belong to another CP.
+func packageNameConflict(key packageClaimKey) error {
+	return fmt.Errorf("%w: %s on %s", ErrPackageNameConflict, key.PackageName, game.GamePlatform(key.Platform))
+}
+
+// checkPackageClaims returns ErrPackageNameConflict when another game holds one of the package names of
+// version. It claims nothing, which is what saving a draft needs.
+func checkPackageClaims(tx *gorm.DB, gameID uint64, version *ddl.GpGameVersion) error {
+	keys, err := packageClaimKeys(version)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		var claim ddl.GpPackageClaim
+		err := tx.Where("platform = ? AND package_name = ?", key.Platform, key.PackageName).First(&claim).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if claim.GameId != gameID {
+			return packageNameConflict(key)
+		}
+	}
+	return nil
+}
+
+// claimPackageNames reserves or locks the package names of version for a game, failing with
+// ErrPackageNameConflict when another game holds one of them. A claim never goes back from locked to
+// reserved. Locked claims on package names the game no longer uses are kept until an admin releases them;
+// reserved ones are dropped by releaseUnusedReservations.
+func claimPackageNames(tx *gorm.DB, gameID uint64, version *ddl.GpGameVersion, state int) error {
+	keys, err := packageClaimKeys(version)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		var claim ddl.GpPackageClaim
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("platform = ? AND package_name = ?", key.Platform, key.PackageName).
+			First(&claim).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			claim = ddl.GpPackageClaim{Platform: key.Platform, PackageName: key.PackageName, GameId: gameID, State: state}
+			if err := tx.Create(&claim).Error; err != nil {
+				// another game claimed the name between our read and our insert
+				if isDuplicateEntry(err) {
+					return packageNameConflict(key)
+				}
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if claim.GameId != gameID {
+			return packageNameConflict(key)
+		}
+		if claim.State < state {
+			if err := tx.Model(&claim).Update("state", state).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// claimingStatuses are the statuses of the versions whose package names a game keeps.
+var claimingStatuses = []int{int(game.GameStatus_Reviewing), int(game.GameStatus_Scheduled), int(game.GameStatus_Published)}
+
+// releaseUnusedReservations drops the reserved claims of a game on package names that none of its
+// Reviewing, Scheduled or Published versions uses any more, so that a withdrawn or rejected submission
+// does not keep its names from other games. Callers hold the lock on the game row.
+func releaseUnusedReservations(tx *gorm.DB, gameID uint64) error {
+	var versions []*ddl.GpGameVersion
+	if err := tx.Where("game_id = ? AND status IN ?", gameID, claimingStatuses).Find(&versions).Error; err != nil {
+		return err
+	}
+	var claims []*ddl.GpPackageClaim
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("game_id = ? AND state = ?", gameID, constdef.PackageClaimReserved).
+		Find(&claims).Error; err != nil {
+		return err
+	}
+
+	unused, err := unusedClaims(claims, versions)
+	if err != nil || len(unused) == 0 {
+		return err
+	}
+	ids := make([]uint64, 0, len(unused))
+	for _, claim := range unused {
+		ids = append(ids, claim.Id)
+	}
+	return tx.Delete(&ddl.GpPackageClaim{}, ids).Error
+}
+
+// unusedClaims returns the claims on package names that none of versions uses.
+func unusedClaims(claims []*ddl.GpPackageClaim, versions []*ddl.GpGameVersion) ([]*ddl.GpPackageClaim, error) {
+	used := make(map[packageClaimKey]bool)
+	for _, version := range versions {
+		keys, err := packageClaimKeys(version)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			used[key] = true
+		}
+	}
+	var unused []*ddl.GpPackageClaim
+	for _, claim := range claims {
+		if !used[packageClaimKey{Platform: claim.Platform, PackageName: claim.PackageName}] {
+			unused = append(unused, claim)
+		}
+	}
+	return unused, nil
+}
+
+var ErrPackageNameNotClaimed = errors.New("the package name is not claimed by any game")
+
+// ReleasePackageName drops the claim on a package name, or hands it over as a reservation to
+// transferToGameID when that is not 0. The operation is logged on the game that held the claim, which is
+// returned.
+func (d *gameDAO) ReleasePackageName(ctx context.Context, platform int, packageName string, transferToGameID uint64, operationLog *ddl.GpGameOperationLog) (uint64, error) {
+	var previousGameID uint64
+	err := dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. lock the game receiving the claim first, games are always locked before claims
+		if transferToGameID != 0 {
+			if _, err := lockGame(tx, transferToGameID); err != nil {
+				return err
+			}
+		}
+
+		// 2. lock the claim
+		var claim ddl.GpPackageClaim
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("platform = ? AND package_name = ?", platform, packageName).
+			First(&claim).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrPackageNameNotClaimed
+		}
+		if err != nil {
+			return err
+		}
+		previousGameID = claim.GameId
+
+		// 3. release or transfer it
+		if transferToGameID == 0 {
+			operationLog.OperationType = constdef.GameOperationReleasePackage
+			err = tx.Delete(&claim).Error
+		} else {
+			operationLog.OperationType = constdef.GameOperationTransferPackage
+			err = tx.Model(&claim).Updates(map[string]interface{}{
+				"game_id": transferToGameID,
+				"state":   constdef.PackageClaimReserved,
+			}).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		// 4. record who released the name and why
+		operationLog.GameId = previousGameID
+		return tx.Create(operationLog).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return previousGameID, nil
+}
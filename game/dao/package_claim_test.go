@@ -0,0 +1,78 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPackageClaimKeys tests that only app platforms claim the package name, once each
+func TestPackageClaimKeys(t *testing.T) {
+	keys, err := packageClaimKeys(&ddl.GpGameVersion{PackageName: "com.happy.elimination", Platform: "[1,2,3,1]"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []packageClaimKey{
+		{Platform: int(game.GamePlatform_Android), PackageName: "com.happy.elimination"},
+		{Platform: int(game.GamePlatform_IOS), PackageName: "com.happy.elimination"},
+	}, keys)
+
+	keys, err = packageClaimKeys(&ddl.GpGameVersion{Platform: "[1]"})
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+
+	_, err = packageClaimKeys(&ddl.GpGameVersion{PackageName: "com.happy.elimination", Platform: "android"})
+	assert.Error(t, err)
+}
+
+// TestPackageClaimKeys_Builds tests that a version with builds claims the identifiers of its app builds
+func TestPackageClaimKeys_Builds(t *testing.T) {
+	version := &ddl.GpGameVersion{
+		PackageName: "com.legacy.name",
+		Platform:    "[1,2,3]",
+		Builds:      `[{"platform":1,"identifier":"com.happy.elimination"},{"platform":2,"identifier":"com.happy-games.elimination"},{"platform":3}]`,
+	}
+
+	keys, err := packageClaimKeys(version)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []packageClaimKey{
+		{Platform: int(game.GamePlatform_Android), PackageName: "com.happy.elimination"},
+		{Platform: int(game.GamePlatform_IOS), PackageName: "com.happy-games.elimination"},
+	}, keys)
+
+	_, err = packageClaimKeys(&ddl.GpGameVersion{Builds: "{"})
+	assert.Error(t, err)
+}
+
+// TestPackageNameConflict tests that the conflict error is ErrPackageNameConflict and names the package
+func TestPackageNameConflict(t *testing.T) {
+	err := packageNameConflict(packageClaimKey{Platform: int(game.GamePlatform_Android), PackageName: "com.happy.elimination"})
+
+	assert.True(t, errors.Is(err, ErrPackageNameConflict))
+	assert.Contains(t, err.Error(), "com.happy.elimination on Android")
+}
+
+// TestUnusedClaims tests that only claims on package names no remaining version uses are dropped
+func TestUnusedClaims(t *testing.T) {
+	claims := []*ddl.GpPackageClaim{
+		{Id: 1, Platform: int(game.GamePlatform_Android), PackageName: "com.happy.elimination"},
+		{Id: 2, Platform: int(game.GamePlatform_IOS), PackageName: "com.happy.elimination"},
+		{Id: 3, Platform: int(game.GamePlatform_Android), PackageName: "com.happy.withdrawn"},
+	}
+	versions := []*ddl.GpGameVersion{
+		{Builds: `[{"platform":1,"identifier":"com.happy.elimination"}]`},
+		{PackageName: "com.happy.elimination", Platform: "[2]"},
+	}
+
+	unused, err := unusedClaims(claims, versions)
+	assert.NoError(t, err)
+	assert.Equal(t, []*ddl.GpPackageClaim{claims[2]}, unused)
+
+	// once the last submission is gone, every reservation goes
+	unused, err = unusedClaims(claims, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, claims, unused)
+}
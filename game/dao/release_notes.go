@@ -0,0 +1,44 @@
+package dao
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/GameLaunchPad/game_management_project/game/constdef"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"gorm.io/gorm"
+)
+
+var ErrReleaseNotesRequired = errors.New("release notes are required once the game has been released")
+
+// checkReleaseNotes rejects a version about to go to review without release notes in its default locale
+// when the game has been released before, so that reviewers and players learn what changed since the
+// version they know. The first version of a game needs none. Other locales fall back to the default one.
+func checkReleaseNotes(released bool, version *ddl.GpGameVersion) error {
+	if !released {
+		return nil
+	}
+	if strings.TrimSpace(version.ReleaseNotes) == "" {
+		return ErrReleaseNotesRequired
+	}
+	return nil
+}
+
+// gameEverReleased reports whether players have seen a version of the game, one that is online or was taken
+// offline. The pointers on the game record are not enough: restoring a takedown clears takedown_version_id
+// and sends the version back to review, so the takedown log is what remembers such a game.
+func gameEverReleased(tx *gorm.DB, gameID uint64) (bool, error) {
+	var versions int64
+	err := tx.Model(&ddl.GpGameVersion{}).
+		Where("game_id = ? AND status IN ?", gameID, []int{int(game.GameStatus_Published), int(game.GameStatus_Offline)}).
+		Count(&versions).Error
+	if err != nil || versions > 0 {
+		return versions > 0, err
+	}
+	var takedowns int64
+	err = tx.Model(&ddl.GpGameOperationLog{}).
+		Where("game_id = ? AND operation_type = ?", gameID, constdef.GameOperationTakedown).
+		Count(&takedowns).Error
+	return takedowns > 0, err
+}
@@ -0,0 +1,16 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCheckReleaseNotes tests that release notes are only required once the game has been released
+func TestCheckReleaseNotes(t *testing.T) {
+	assert.NoError(t, checkReleaseNotes(false, &ddl.GpGameVersion{}))
+	assert.ErrorIs(t, checkReleaseNotes(true, &ddl.GpGameVersion{}), ErrReleaseNotesRequired)
+	assert.ErrorIs(t, checkReleaseNotes(true, &ddl.GpGameVersion{ReleaseNotes: " \n\t"}), ErrReleaseNotesRequired)
+	assert.NoError(t, checkReleaseNotes(true, &ddl.GpGameVersion{ReleaseNotes: "<p>New levels</p>"}))
+}
@@ -0,0 +1,194 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GameLaunchPad/game_management_project/game/constdef"
+	"github.com/GameLaunchPad/game_management_project/game/dal"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrNoActiveRollout      = errors.New("the version is not being rolled out")
+	ErrIllegalRolloutChange = errors.New("illegal rollout change")
+)
+
+// StartGameRollout approves a version under review and releases it to percentage% of the players. The version
+// is published, but the online version stays the one served to everyone else until the rollout completes.
+// A rollout needs an online version to fall back on, so it returns ErrGameNotOnline for a game that has none.
+// A rollout of an older version still in progress is replaced.
+func (d *gameDAO) StartGameRollout(ctx context.Context, gameID, versionID uint64, percentage int, reviewLog *ddl.GpGameReviewLog, rolloutLog *ddl.GpGameRolloutLog) error {
+	return dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. lock the game and the version and check that the version may be published
+		gameRecord, version, err := lockVersionForTransition(tx, gameID, versionID, game.GameStatus_Published)
+		if err != nil {
+			return err
+		}
+		if gameRecord.OnlineGameVersionId == 0 {
+			return ErrGameNotOnline
+		}
+
+		// 2. publish the version and lock its package names, without moving the online pointer
+		reviewTime := time.Now().Unix()
+		updateData := map[string]interface{}{
+			"status":         int(game.GameStatus_Published),
+			"review_comment": reviewLog.Remark,
+			"operator":       reviewLog.Operator,
+			"review_time":    reviewTime,
+		}
+		if err := updateLockedVersion(tx, version, updateData); err != nil {
+			return err
+		}
+		if err := claimPackageNames(tx, gameID, version, constdef.PackageClaimLocked); err != nil {
+			return err
+		}
+
+		// 3. start the rollout
+		rolloutData := map[string]interface{}{
+			"rollout_version_id": versionID,
+			"rollout_percentage": percentage,
+			"rollout_state":      int(game.RolloutState_Active),
+		}
+		if err := tx.Model(gameRecord).Updates(rolloutData).Error; err != nil {
+			return err
+		}
+
+		// 4. append the decision to the review log and the rollout log
+		if err := appendReviewLog(tx, gameID, versionID, int(game.GameStatus_Published), 0, reviewTime, reviewLog); err != nil {
+			return err
+		}
+		return appendRolloutLog(tx, gameID, versionID, game.RolloutAction_Start, 0, percentage, rolloutLog)
+	})
+}
+
+// UpdateGameRollout changes the rollout of a version and returns the game as it is afterwards.
+// Raising the percentage to 100 completes the rollout: the version becomes the online version. Rollback ends
+// the rollout, leaves every player on the online version and takes the version offline, so that
+// RollbackGameVersion cannot put it back online; the CP resubmits it or a fixed version for review.
+// Percentages only go up, and only an active rollout can be raised.
+func (d *gameDAO) UpdateGameRollout(ctx context.Context, gameID, versionID uint64, action game.RolloutAction, percentage int, rolloutLog *ddl.GpGameRolloutLog) (*ddl.GpGame, error) {
+	var gameRecord *ddl.GpGame
+	err := dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. lock the game and make sure the version is the one being rolled out
+		var err error
+		gameRecord, err = lockGame(tx, gameID)
+		if err != nil {
+			return err
+		}
+		if gameRecord.RolloutVersionId == 0 || gameRecord.RolloutVersionId != versionID {
+			return ErrNoActiveRollout
+		}
+
+		// 2. work out the new rollout state
+		fromPercentage := gameRecord.RolloutPercentage
+		loggedAction, toPercentage, updateData, err := planRolloutChange(gameRecord, action, percentage)
+		if err != nil {
+			return err
+		}
+
+		// 3. apply it and record who changed the rollout and why; a completed rollout moves the summary too,
+		// a rolled back one takes the version offline
+		if err := tx.Model(gameRecord).Updates(updateData).Error; err != nil {
+			return err
+		}
+		switch loggedAction {
+		case game.RolloutAction_Complete:
+			if _, err := syncGameSummary(tx, gameRecord); err != nil {
+				return err
+			}
+		case game.RolloutAction_Rollback:
+			version, err := lockVersion(tx, gameID, versionID)
+			if err != nil {
+				return err
+			}
+			if err := CheckTransition(game.GameStatus(version.Status), game.GameStatus_Offline); err != nil {
+				return err
+			}
+			if err := updateLockedVersion(tx, version, map[string]interface{}{"status": int(game.GameStatus_Offline)}); err != nil {
+				return err
+			}
+		}
+		return appendRolloutLog(tx, gameID, versionID, loggedAction, fromPercentage, toPercentage, rolloutLog)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gameRecord, nil
+}
+
+// planRolloutChange checks an action against the rollout of a game and returns the action to record, which
+// is Complete for a raise to 100, the percentage after it and the gp_game columns to update.
+func planRolloutChange(gameRecord *ddl.GpGame, action game.RolloutAction, percentage int) (game.RolloutAction, int, map[string]interface{}, error) {
+	fromPercentage := gameRecord.RolloutPercentage
+	state := game.RolloutState(gameRecord.RolloutState)
+	updateData := map[string]interface{}{}
+	switch action {
+	case game.RolloutAction_Raise:
+		if state != game.RolloutState_Active {
+			return action, 0, nil, fmt.Errorf("%w: resume the rollout before raising it", ErrIllegalRolloutChange)
+		}
+		if percentage <= fromPercentage {
+			return action, 0, nil, fmt.Errorf("%w: the percentage can only go up from %d", ErrIllegalRolloutChange, fromPercentage)
+		}
+		if percentage >= 100 {
+			// the version goes online for everyone
+			updateData["online_game_version_id"] = gameRecord.RolloutVersionId
+			clearRollout(updateData)
+			return game.RolloutAction_Complete, 100, updateData, nil
+		}
+		updateData["rollout_percentage"] = percentage
+		return action, percentage, updateData, nil
+	case game.RolloutAction_Pause:
+		if state != game.RolloutState_Active {
+			return action, 0, nil, fmt.Errorf("%w: the rollout is not active", ErrIllegalRolloutChange)
+		}
+		updateData["rollout_state"] = int(game.RolloutState_Paused)
+		return action, fromPercentage, updateData, nil
+	case game.RolloutAction_Resume:
+		if state != game.RolloutState_Paused {
+			return action, 0, nil, fmt.Errorf("%w: the rollout is not paused", ErrIllegalRolloutChange)
+		}
+		updateData["rollout_state"] = int(game.RolloutState_Active)
+		return action, fromPercentage, updateData, nil
+	case game.RolloutAction_Rollback:
+		clearRollout(updateData)
+		return action, 0, updateData, nil
+	}
+	return action, 0, nil, fmt.Errorf("%w: unsupported action %s", ErrIllegalRolloutChange, action)
+}
+
+// ListGameRolloutLogs returns every rollout operation on a game, most recent first.
+func (d *gameDAO) ListGameRolloutLogs(ctx context.Context, gameID uint64) ([]*ddl.GpGameRolloutLog, error) {
+	var rolloutLogs []*ddl.GpGameRolloutLog
+	err := dal.DB.WithContext(ctx).
+		Where("game_id = ?", gameID).
+		Order("create_ts DESC").Order("id DESC").
+		Find(&rolloutLogs).Error
+	if err != nil {
+		return nil, err
+	}
+	return rolloutLogs, nil
+}
+
+// clearRollout adds the gp_game columns that end a rollout to updateData. Anything that moves the online
+// pointer ends the rollout of the game, since its players were sampled against the old online version.
+func clearRollout(updateData map[string]interface{}) {
+	updateData["rollout_version_id"] = 0
+	updateData["rollout_percentage"] = 0
+	updateData["rollout_state"] = int(game.RolloutState_Unset)
+}
+
+// appendRolloutLog fills in the identifying fields of a rollout log record and inserts it.
+func appendRolloutLog(tx *gorm.DB, gameID, versionID uint64, action game.RolloutAction, fromPercentage, toPercentage int, rolloutLog *ddl.GpGameRolloutLog) error {
+	rolloutLog.GameId = gameID
+	rolloutLog.GameVersionId = versionID
+	rolloutLog.Action = int(action)
+	rolloutLog.FromPercentage = fromPercentage
+	rolloutLog.ToPercentage = toPercentage
+	return tx.Create(rolloutLog).Error
+}
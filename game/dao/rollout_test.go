@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPlanRolloutChange tests which rollout changes are allowed and what each of them writes and records
+func TestPlanRolloutChange(t *testing.T) {
+	active := &ddl.GpGame{Id: 101, OnlineGameVersionId: 200, RolloutVersionId: 201, RolloutPercentage: 20, RolloutState: int(game.RolloutState_Active)}
+	paused := &ddl.GpGame{Id: 101, OnlineGameVersionId: 200, RolloutVersionId: 201, RolloutPercentage: 20, RolloutState: int(game.RolloutState_Paused)}
+	cleared := map[string]interface{}{"rollout_version_id": 0, "rollout_percentage": 0, "rollout_state": int(game.RolloutState_Unset)}
+	tests := []struct {
+		name           string
+		gameRecord     *ddl.GpGame
+		action         game.RolloutAction
+		percentage     int
+		wantAction     game.RolloutAction
+		wantPercentage int
+		wantUpdate     map[string]interface{}
+	}{
+		{"raise", active, game.RolloutAction_Raise, 50, game.RolloutAction_Raise, 50, map[string]interface{}{"rollout_percentage": 50}},
+		{"raise to 100 completes", active, game.RolloutAction_Raise, 100, game.RolloutAction_Complete, 100, map[string]interface{}{
+			"online_game_version_id": uint64(201), "rollout_version_id": 0, "rollout_percentage": 0, "rollout_state": int(game.RolloutState_Unset),
+		}},
+		{"lower", active, game.RolloutAction_Raise, 10, 0, 0, nil},
+		{"raise to the same percentage", active, game.RolloutAction_Raise, 20, 0, 0, nil},
+		{"raise while paused", paused, game.RolloutAction_Raise, 50, 0, 0, nil},
+		{"pause", active, game.RolloutAction_Pause, 0, game.RolloutAction_Pause, 20, map[string]interface{}{"rollout_state": int(game.RolloutState_Paused)}},
+		{"pause twice", paused, game.RolloutAction_Pause, 0, 0, 0, nil},
+		{"resume", paused, game.RolloutAction_Resume, 0, game.RolloutAction_Resume, 20, map[string]interface{}{"rollout_state": int(game.RolloutState_Active)}},
+		{"resume an active rollout", active, game.RolloutAction_Resume, 0, 0, 0, nil},
+		{"roll back an active rollout", active, game.RolloutAction_Rollback, 0, game.RolloutAction_Rollback, 0, cleared},
+		{"roll back a paused rollout", paused, game.RolloutAction_Rollback, 0, game.RolloutAction_Rollback, 0, cleared},
+		{"start", active, game.RolloutAction_Start, 30, 0, 0, nil},
+	}
+
+	for _, tt := range tests {
+		action, percentage, updateData, err := planRolloutChange(tt.gameRecord, tt.action, tt.percentage)
+		if tt.wantUpdate == nil {
+			assert.True(t, errors.Is(err, ErrIllegalRolloutChange), tt.name)
+			continue
+		}
+		assert.NoError(t, err, tt.name)
+		assert.Equal(t, tt.wantAction, action, tt.name)
+		assert.Equal(t, tt.wantPercentage, percentage, tt.name)
+		assert.Equal(t, tt.wantUpdate, updateData, tt.name)
+	}
+}
+
+// TestCheckRollbackTarget_AfterRolloutRollback tests that a version whose rollout was rolled back cannot be
+// put online by RollbackGameVersion
+func TestCheckRollbackTarget_AfterRolloutRollback(t *testing.T) {
+	gameRecord := &ddl.GpGame{Id: 101, OnlineGameVersionId: 200, NewestGameVersionId: 201, RolloutVersionId: 201, RolloutPercentage: 20, RolloutState: int(game.RolloutState_Active)}
+	version := &ddl.GpGameVersion{Id: 201, GameId: 101, Status: int(game.GameStatus_Published)}
+	assert.NoError(t, checkRollbackTarget(gameRecord, version))
+
+	// what UpdateGameRollout writes for a rollback: the rollout ends and the version goes offline
+	_, _, _, err := planRolloutChange(gameRecord, game.RolloutAction_Rollback, 0)
+	assert.NoError(t, err)
+	gameRecord.RolloutVersionId, gameRecord.RolloutPercentage, gameRecord.RolloutState = 0, 0, int(game.RolloutState_Unset)
+	assert.NoError(t, CheckTransition(game.GameStatus(version.Status), game.GameStatus_Offline))
+	version.Status = int(game.GameStatus_Offline)
+
+	assert.True(t, errors.Is(checkRollbackTarget(gameRecord, version), ErrVersionOffline))
+
+	// the version before the rollout is still a valid target
+	previous := &ddl.GpGameVersion{Id: 199, GameId: 101, Status: int(game.GameStatus_Published)}
+	assert.NoError(t, checkRollbackTarget(gameRecord, previous))
+	gameRecord.OnlineGameVersionId = 199
+	assert.True(t, errors.Is(checkRollbackTarget(gameRecord, previous), ErrVersionAlreadyOnline))
+}
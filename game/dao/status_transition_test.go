@@ -0,0 +1,53 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCanTransition tests the legal and illegal moves of the version status transition table
+func TestCanTransition(t *testing.T) {
+	cases := []struct {
+		from, to game.GameStatus
+		allowed  bool
+	}{
+		{game.GameStatus_Unset, game.GameStatus_Draft, true},
+		{game.GameStatus_Unset, game.GameStatus_Reviewing, true},
+		{game.GameStatus_Unset, game.GameStatus_Published, false},
+		{game.GameStatus_Draft, game.GameStatus_Draft, true},
+		{game.GameStatus_Draft, game.GameStatus_Reviewing, true},
+		{game.GameStatus_Draft, game.GameStatus_Published, false},
+		{game.GameStatus_Draft, game.GameStatus_Deleted, true},
+		{game.GameStatus_Draft, game.GameStatus_Rejected, false},
+		{game.GameStatus_Reviewing, game.GameStatus_Published, true},
+		{game.GameStatus_Reviewing, game.GameStatus_Rejected, true},
+		{game.GameStatus_Reviewing, game.GameStatus_Scheduled, true},
+		{game.GameStatus_Reviewing, game.GameStatus_Draft, true},
+		{game.GameStatus_Scheduled, game.GameStatus_Published, true},
+		{game.GameStatus_Scheduled, game.GameStatus_Reviewing, true},
+		{game.GameStatus_Rejected, game.GameStatus_Published, false},
+		{game.GameStatus_Published, game.GameStatus_Rejected, false},
+		{game.GameStatus_Published, game.GameStatus_Offline, true},
+		{game.GameStatus_Offline, game.GameStatus_Published, false},
+		{game.GameStatus_Offline, game.GameStatus_Reviewing, true},
+		{game.GameStatus_Deleted, game.GameStatus_Draft, true},
+		{game.GameStatus_Deleted, game.GameStatus_Reviewing, false},
+		{game.GameStatus_Published, game.GameStatus_Deleted, false},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.allowed, CanTransition(c.from, c.to), "%s -> %s", c.from, c.to)
+	}
+}
+
+// TestCheckTransition tests that an illegal move is reported as ErrIllegalStatusTransition
+func TestCheckTransition(t *testing.T) {
+	assert.NoError(t, CheckTransition(game.GameStatus_Reviewing, game.GameStatus_Published))
+
+	err := CheckTransition(game.GameStatus_Published, game.GameStatus_Reviewing)
+	assert.True(t, errors.Is(err, ErrIllegalStatusTransition))
+	assert.Contains(t, err.Error(), "Published -> Reviewing")
+}
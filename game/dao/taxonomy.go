@@ -0,0 +1,292 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/GameLaunchPad/game_management_project/game/dal"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MaxCategoryDepth is the number of levels the category tree may have.
+const MaxCategoryDepth = 3
+
+var (
+	ErrCategoryNotFound      = errors.New("the category does not exist")
+	ErrTagNotFound           = errors.New("the tag does not exist")
+	ErrTaxonomyNameConflict  = errors.New("the name is already used")
+	ErrInvalidCategoryParent = errors.New("the category cannot be placed under this parent")
+	ErrTaxonomyInUse         = errors.New("the category or tag is still in use")
+)
+
+// ParseTagIDs parses the tag_ids column of a version. An empty column holds no tags.
+func ParseTagIDs(version *ddl.GpGameVersion) ([]uint64, error) {
+	if version.TagIds == "" {
+		return nil, nil
+	}
+	var tagIDs []uint64
+	if err := json.Unmarshal([]byte(version.TagIds), &tagIDs); err != nil {
+		return nil, fmt.Errorf("failed to parse tags of version %d: %w", version.Id, err)
+	}
+	return tagIDs, nil
+}
+
+// isDuplicateEntry reports whether err is a MySQL unique key violation.
+func isDuplicateEntry(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
+
+// checkTaxonomyReferences fails with ErrCategoryNotFound or ErrTagNotFound when version points at a category
+// or tag that does not exist. The rows are share-locked, so they cannot be deleted before the transaction
+// writing the version commits.
+func checkTaxonomyReferences(tx *gorm.DB, version *ddl.GpGameVersion) error {
+	if version.CategoryId != 0 {
+		var count int64
+		err := tx.Model(&ddl.GpCategory{}).Clauses(clause.Locking{Strength: "SHARE"}).
+			Where("id = ?", version.CategoryId).Count(&count).Error
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return fmt.Errorf("%w: %d", ErrCategoryNotFound, version.CategoryId)
+		}
+	}
+
+	tagIDs, err := ParseTagIDs(version)
+	if err != nil || len(tagIDs) == 0 {
+		return err
+	}
+	var found []uint64
+	err = tx.Model(&ddl.GpTag{}).Clauses(clause.Locking{Strength: "SHARE"}).
+		Where("id IN ?", tagIDs).Pluck("id", &found).Error
+	if err != nil {
+		return err
+	}
+	exists := make(map[uint64]bool, len(found))
+	for _, id := range found {
+		exists[id] = true
+	}
+	for _, id := range tagIDs {
+		if !exists[id] {
+			return fmt.Errorf("%w: %d", ErrTagNotFound, id)
+		}
+	}
+	return nil
+}
+
+// loadCategories returns every category by ID. The whole tree is locked, so that two concurrent moves
+// cannot build a cycle; it is small and rarely written.
+func loadCategories(tx *gorm.DB) (map[uint64]*ddl.GpCategory, error) {
+	var categories []*ddl.GpCategory
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Find(&categories).Error; err != nil {
+		return nil, err
+	}
+	byID := make(map[uint64]*ddl.GpCategory, len(categories))
+	for _, category := range categories {
+		byID[category.Id] = category
+	}
+	return byID, nil
+}
+
+// checkCategoryParent fails with ErrInvalidCategoryParent unless the category can hang under parentID:
+// the parent must exist, must not be the category itself or one of its descendants, and the tree must
+// stay within MaxCategoryDepth levels. categoryID is 0 for a category that is being created.
+func checkCategoryParent(tx *gorm.DB, categoryID, parentID uint64) error {
+	if parentID == 0 && categoryID == 0 {
+		return nil
+	}
+	categories, err := loadCategories(tx)
+	if err != nil {
+		return err
+	}
+	return validateCategoryParent(categories, categoryID, parentID)
+}
+
+// validateCategoryParent is the check of checkCategoryParent on a loaded tree.
+func validateCategoryParent(categories map[uint64]*ddl.GpCategory, categoryID, parentID uint64) error {
+	// walk up from the new parent to the root, counting the levels above the category
+	parentDepth := 0
+	for id := parentID; id != 0; id = categories[id].ParentId {
+		if id == categoryID {
+			return fmt.Errorf("%w: a category cannot be moved under itself", ErrInvalidCategoryParent)
+		}
+		if _, ok := categories[id]; !ok || parentDepth >= len(categories) {
+			return fmt.Errorf("%w: parent %d does not exist", ErrInvalidCategoryParent, id)
+		}
+		parentDepth++
+	}
+
+	height := 1
+	if categoryID != 0 {
+		height = categorySubtreeHeight(categories, categoryID)
+	}
+	if parentDepth+height > MaxCategoryDepth {
+		return fmt.Errorf("%w: categories may be at most %d levels deep", ErrInvalidCategoryParent, MaxCategoryDepth)
+	}
+	return nil
+}
+
+// categorySubtreeHeight returns the number of levels of the subtree rooted at categoryID, 1 for a leaf.
+func categorySubtreeHeight(categories map[uint64]*ddl.GpCategory, categoryID uint64) int {
+	height := 1
+	for _, category := range categories {
+		if category.ParentId == categoryID && category.Id != categoryID {
+			if h := categorySubtreeHeight(categories, category.Id) + 1; h > height {
+				height = h
+			}
+		}
+	}
+	return height
+}
+
+// CreateCategory creates a category under category.ParentId, which is 0 for a top-level category.
+func (d *gameDAO) CreateCategory(ctx context.Context, category *ddl.GpCategory) error {
+	return dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkCategoryParent(tx, 0, category.ParentId); err != nil {
+			return err
+		}
+		if err := tx.Create(category).Error; err != nil {
+			if isDuplicateEntry(err) {
+				return fmt.Errorf("%w: category %q", ErrTaxonomyNameConflict, category.Name)
+			}
+			return err
+		}
+		return nil
+	})
+}
+
+// UpdateCategory renames, reorders or moves a category.
+func (d *gameDAO) UpdateCategory(ctx context.Context, category *ddl.GpCategory) error {
+	return dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current ddl.GpCategory
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", category.Id).First(&current).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrCategoryNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if category.ParentId != current.ParentId {
+			if err := checkCategoryParent(tx, category.Id, category.ParentId); err != nil {
+				return err
+			}
+		}
+		err = tx.Model(&current).Updates(map[string]interface{}{
+			"name":       category.Name,
+			"parent_id":  category.ParentId,
+			"sort_order": category.SortOrder,
+		}).Error
+		if isDuplicateEntry(err) {
+			return fmt.Errorf("%w: category %q", ErrTaxonomyNameConflict, category.Name)
+		}
+		return err
+	})
+}
+
+// DeleteCategory deletes a category that has no subcategories and that no version, current or past,
+// belongs to, so that rolling back to an old version never brings back a missing category.
+func (d *gameDAO) DeleteCategory(ctx context.Context, categoryID uint64) error {
+	return dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var category ddl.GpCategory
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", categoryID).First(&category).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrCategoryNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		var children int64
+		if err := tx.Model(&ddl.GpCategory{}).Where("parent_id = ?", categoryID).Count(&children).Error; err != nil {
+			return err
+		}
+		if children > 0 {
+			return fmt.Errorf("%w: the category has subcategories", ErrTaxonomyInUse)
+		}
+		var versions int64
+		if err := tx.Model(&ddl.GpGameVersion{}).Where("category_id = ?", categoryID).Count(&versions).Error; err != nil {
+			return err
+		}
+		if versions > 0 {
+			return fmt.Errorf("%w: %d game versions belong to the category", ErrTaxonomyInUse, versions)
+		}
+		return tx.Delete(&category).Error
+	})
+}
+
+// ListCategories returns every category, ordered by parent, sort order and ID.
+func (d *gameDAO) ListCategories(ctx context.Context) ([]*ddl.GpCategory, error) {
+	var categories []*ddl.GpCategory
+	err := dal.DB.WithContext(ctx).Order("parent_id ASC, sort_order ASC, id ASC").Find(&categories).Error
+	if err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+// CreateTag creates a tag.
+func (d *gameDAO) CreateTag(ctx context.Context, tag *ddl.GpTag) error {
+	err := dal.DB.WithContext(ctx).Create(tag).Error
+	if isDuplicateEntry(err) {
+		return fmt.Errorf("%w: tag %q", ErrTaxonomyNameConflict, tag.Name)
+	}
+	return err
+}
+
+// UpdateTag renames a tag. Versions refer to tags by ID, so they all show the new name.
+func (d *gameDAO) UpdateTag(ctx context.Context, tagID uint64, name string) error {
+	return dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var tag ddl.GpTag
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", tagID).First(&tag).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrTagNotFound
+		}
+		if err != nil {
+			return err
+		}
+		err = tx.Model(&tag).Update("name", name).Error
+		if isDuplicateEntry(err) {
+			return fmt.Errorf("%w: tag %q", ErrTaxonomyNameConflict, name)
+		}
+		return err
+	})
+}
+
+// DeleteTag deletes a tag that no version, current or past, carries.
+func (d *gameDAO) DeleteTag(ctx context.Context, tagID uint64) error {
+	return dal.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var tag ddl.GpTag
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", tagID).First(&tag).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrTagNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		var versions int64
+		err = tx.Model(&ddl.GpGameVersion{}).Where("JSON_CONTAINS(tag_ids, ?)", fmt.Sprint(tagID)).Count(&versions).Error
+		if err != nil {
+			return err
+		}
+		if versions > 0 {
+			return fmt.Errorf("%w: %d game versions carry the tag", ErrTaxonomyInUse, versions)
+		}
+		return tx.Delete(&tag).Error
+	})
+}
+
+// ListTags returns every tag, ordered by name.
+func (d *gameDAO) ListTags(ctx context.Context) ([]*ddl.GpTag, error) {
+	var tags []*ddl.GpTag
+	if err := dal.DB.WithContext(ctx).Order("name ASC, id ASC").Find(&tags).Error; err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
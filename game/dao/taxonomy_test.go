@@ -0,0 +1,60 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestValidateCategoryParent tests where a category may be created or moved in a three-level tree
+func TestValidateCategoryParent(t *testing.T) {
+	// 11 > 12 > 13, and 14 at the top level
+	categories := map[uint64]*ddl.GpCategory{
+		11: {Id: 11},
+		12: {Id: 12, ParentId: 11},
+		13: {Id: 13, ParentId: 12},
+		14: {Id: 14},
+	}
+	tests := []struct {
+		name       string
+		categoryID uint64
+		parentID   uint64
+		valid      bool
+	}{
+		{"new top-level category", 0, 0, true},
+		{"new category on the second level", 0, 11, true},
+		{"new category below the last level", 0, 13, false},
+		{"missing parent", 0, 99, false},
+		{"move a subtree to the top", 12, 0, true},
+		{"move a subtree under another top-level category", 12, 14, true},
+		{"move a subtree too deep", 11, 14, false},
+		{"move a category under itself", 12, 12, false},
+		{"move a category under its descendant", 11, 13, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCategoryParent(categories, tt.categoryID, tt.parentID)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, ErrInvalidCategoryParent), "%v", err)
+			}
+		})
+	}
+}
+
+// TestParseTagIDs tests reading the tag_ids column, which is empty for versions written before tags existed
+func TestParseTagIDs(t *testing.T) {
+	tagIDs, err := ParseTagIDs(&ddl.GpGameVersion{TagIds: "[21,22]"})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{21, 22}, tagIDs)
+
+	tagIDs, err = ParseTagIDs(&ddl.GpGameVersion{})
+	assert.NoError(t, err)
+	assert.Empty(t, tagIDs)
+
+	_, err = ParseTagIDs(&ddl.GpGameVersion{TagIds: "21"})
+	assert.Error(t, err)
+}
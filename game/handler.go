@@ -39,3 +39,133 @@ func (s *GameServiceImpl) DeleteGameDraft(ctx context.Context, req *game.DeleteG
 func (s *GameServiceImpl) UpdateGameDraft(ctx context.Context, req *game.UpdateGameDraftRequest) (resp *game.UpdateGameDraftResponse, err error) {
 	return handler.UpdateGameDraft(ctx, req)
 }
+
+// ListGameVersions implements the GameServiceImpl interface.
+func (s *GameServiceImpl) ListGameVersions(ctx context.Context, req *game.ListGameVersionsRequest) (resp *game.ListGameVersionsResponse, err error) {
+	return handler.ListGameVersions(ctx, req)
+}
+
+// RollbackGameVersion implements the GameServiceImpl interface.
+func (s *GameServiceImpl) RollbackGameVersion(ctx context.Context, req *game.RollbackGameVersionRequest) (resp *game.RollbackGameVersionResponse, err error) {
+	return handler.RollbackGameVersion(ctx, req)
+}
+
+// CancelScheduledPublish implements the GameServiceImpl interface.
+func (s *GameServiceImpl) CancelScheduledPublish(ctx context.Context, req *game.CancelScheduledPublishRequest) (resp *game.CancelScheduledPublishResponse, err error) {
+	return handler.CancelScheduledPublish(ctx, req)
+}
+
+// GetGameReviewLogs implements the GameServiceImpl interface.
+func (s *GameServiceImpl) GetGameReviewLogs(ctx context.Context, req *game.GetGameReviewLogsRequest) (resp *game.GetGameReviewLogsResponse, err error) {
+	return handler.GetGameReviewLogs(ctx, req)
+}
+
+// SubmitGameVersion implements the GameServiceImpl interface.
+func (s *GameServiceImpl) SubmitGameVersion(ctx context.Context, req *game.SubmitGameVersionRequest) (resp *game.SubmitGameVersionResponse, err error) {
+	return handler.SubmitGameVersion(ctx, req)
+}
+
+// WithdrawGameVersion implements the GameServiceImpl interface.
+func (s *GameServiceImpl) WithdrawGameVersion(ctx context.Context, req *game.WithdrawGameVersionRequest) (resp *game.WithdrawGameVersionResponse, err error) {
+	return handler.WithdrawGameVersion(ctx, req)
+}
+
+// DiffGameVersions implements the GameServiceImpl interface.
+func (s *GameServiceImpl) DiffGameVersions(ctx context.Context, req *game.DiffGameVersionsRequest) (resp *game.DiffGameVersionsResponse, err error) {
+	return handler.DiffGameVersions(ctx, req)
+}
+
+// TakedownGame implements the GameServiceImpl interface.
+func (s *GameServiceImpl) TakedownGame(ctx context.Context, req *game.TakedownGameRequest) (resp *game.TakedownGameResponse, err error) {
+	return handler.TakedownGame(ctx, req)
+}
+
+// RestoreGame implements the GameServiceImpl interface.
+func (s *GameServiceImpl) RestoreGame(ctx context.Context, req *game.RestoreGameRequest) (resp *game.RestoreGameResponse, err error) {
+	return handler.RestoreGame(ctx, req)
+}
+
+// ListDeletedGameDrafts implements the GameServiceImpl interface.
+func (s *GameServiceImpl) ListDeletedGameDrafts(ctx context.Context, req *game.ListDeletedGameDraftsRequest) (resp *game.ListDeletedGameDraftsResponse, err error) {
+	return handler.ListDeletedGameDrafts(ctx, req)
+}
+
+// RestoreGameDraft implements the GameServiceImpl interface.
+func (s *GameServiceImpl) RestoreGameDraft(ctx context.Context, req *game.RestoreGameDraftRequest) (resp *game.RestoreGameDraftResponse, err error) {
+	return handler.RestoreGameDraft(ctx, req)
+}
+
+// SearchGames implements the GameServiceImpl interface.
+func (s *GameServiceImpl) SearchGames(ctx context.Context, req *game.SearchGamesRequest) (resp *game.SearchGamesResponse, err error) {
+	return handler.SearchGames(ctx, req)
+}
+
+// BatchGetGameDetails implements the GameServiceImpl interface.
+func (s *GameServiceImpl) BatchGetGameDetails(ctx context.Context, req *game.BatchGetGameDetailsRequest) (resp *game.BatchGetGameDetailsResponse, err error) {
+	return handler.BatchGetGameDetails(ctx, req)
+}
+
+// ReleasePackageName implements the GameServiceImpl interface.
+func (s *GameServiceImpl) ReleasePackageName(ctx context.Context, req *game.ReleasePackageNameRequest) (resp *game.ReleasePackageNameResponse, err error) {
+	return handler.ReleasePackageName(ctx, req)
+}
+
+// CreateCategory implements the GameServiceImpl interface.
+func (s *GameServiceImpl) CreateCategory(ctx context.Context, req *game.CreateCategoryRequest) (resp *game.CreateCategoryResponse, err error) {
+	return handler.CreateCategory(ctx, req)
+}
+
+// UpdateCategory implements the GameServiceImpl interface.
+func (s *GameServiceImpl) UpdateCategory(ctx context.Context, req *game.UpdateCategoryRequest) (resp *game.UpdateCategoryResponse, err error) {
+	return handler.UpdateCategory(ctx, req)
+}
+
+// DeleteCategory implements the GameServiceImpl interface.
+func (s *GameServiceImpl) DeleteCategory(ctx context.Context, req *game.DeleteCategoryRequest) (resp *game.DeleteCategoryResponse, err error) {
+	return handler.DeleteCategory(ctx, req)
+}
+
+// ListCategories implements the GameServiceImpl interface.
+func (s *GameServiceImpl) ListCategories(ctx context.Context, req *game.ListCategoriesRequest) (resp *game.ListCategoriesResponse, err error) {
+	return handler.ListCategories(ctx, req)
+}
+
+// CreateTag implements the GameServiceImpl interface.
+func (s *GameServiceImpl) CreateTag(ctx context.Context, req *game.CreateTagRequest) (resp *game.CreateTagResponse, err error) {
+	return handler.CreateTag(ctx, req)
+}
+
+// UpdateTag implements the GameServiceImpl interface.
+func (s *GameServiceImpl) UpdateTag(ctx context.Context, req *game.UpdateTagRequest) (resp *game.UpdateTagResponse, err error) {
+	return handler.UpdateTag(ctx, req)
+}
+
+// DeleteTag implements the GameServiceImpl interface.
+func (s *GameServiceImpl) DeleteTag(ctx context.Context, req *game.DeleteTagRequest) (resp *game.DeleteTagResponse, err error) {
+	return handler.DeleteTag(ctx, req)
+}
+
+// ListTags implements the GameServiceImpl interface.
+func (s *GameServiceImpl) ListTags(ctx context.Context, req *game.ListTagsRequest) (resp *game.ListTagsResponse, err error) {
+	return handler.ListTags(ctx, req)
+}
+
+// UpdateGameRollout implements the GameServiceImpl interface.
+func (s *GameServiceImpl) UpdateGameRollout(ctx context.Context, req *game.UpdateGameRolloutRequest) (resp *game.UpdateGameRolloutResponse, err error) {
+	return handler.UpdateGameRollout(ctx, req)
+}
+
+// GetGameRollout implements the GameServiceImpl interface.
+func (s *GameServiceImpl) GetGameRollout(ctx context.Context, req *game.GetGameRolloutRequest) (resp *game.GetGameRolloutResponse, err error) {
+	return handler.GetGameRollout(ctx, req)
+}
+
+// ResolveServedVersion implements the GameServiceImpl interface.
+func (s *GameServiceImpl) ResolveServedVersion(ctx context.Context, req *game.ResolveServedVersionRequest) (resp *game.ResolveServedVersionResponse, err error) {
+	return handler.ResolveServedVersion(ctx, req)
+}
+
+// GetGameChangelog implements the GameServiceImpl interface.
+func (s *GameServiceImpl) GetGameChangelog(ctx context.Context, req *game.GetGameChangelogRequest) (resp *game.GetGameChangelogResponse, err error) {
+	return handler.GetGameChangelog(ctx, req)
+}
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/GameLaunchPad/game_management_project/game/apk"
+	"github.com/GameLaunchPad/game_management_project/game/config"
+	"github.com/GameLaunchPad/game_management_project/game/constdef"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/service"
+	"github.com/GameLaunchPad/game_management_project/game/validation"
+)
+
+// inspectSubmittedApk inspects the APK sent with a submit request and checks that it is the Android
+// package of version. It returns the apk_manifest column to store, which is empty when no APK was sent.
+func inspectSubmittedApk(req *game.SubmitGameVersionRequest, version *game.GameVersion) (string, []*game.FieldError, *common.BaseResp) {
+	if !req.IsSetApkFile() && !req.IsSetApkPath() {
+		return "", nil, nil
+	}
+	if req.IsSetApkFile() && req.IsSetApkPath() {
+		return "", nil, &common.BaseResp{Code: "400", Msg: "ApkFile and ApkPath cannot both be set"}
+	}
+	field, packageName, ok := androidPackageOf(version)
+	if !ok {
+		return "", nil, &common.BaseResp{Code: "400", Msg: "The game version is not distributed on Android"}
+	}
+
+	var manifest *apk.Manifest
+	var err error
+	if req.IsSetApkFile() {
+		if len(req.ApkFile) > constdef.MaxApkFileSize {
+			return "", nil, &common.BaseResp{Code: "400", Msg: fmt.Sprintf("ApkFile is larger than %d bytes", constdef.MaxApkFileSize)}
+		}
+		manifest, err = apk.InspectBytes(req.ApkFile)
+	} else {
+		var path string
+		path, err = resolveApkPath(req.GetApkPath())
+		if err != nil {
+			return "", nil, &common.BaseResp{Code: "400", Msg: "Invalid ApkPath: " + err.Error()}
+		}
+		manifest, err = apk.InspectFile(path)
+	}
+	if err != nil {
+		if errors.Is(err, apk.ErrInvalidAPK) || errors.Is(err, os.ErrNotExist) {
+			return "", nil, &common.BaseResp{Code: "400", Msg: "Invalid APK: " + err.Error()}
+		}
+		return "", nil, &common.BaseResp{Code: "500", Msg: "Failed to read APK: " + err.Error()}
+	}
+
+	if manifest.PackageName != packageName {
+		fieldErrors := []*game.FieldError{{
+			Field:   field,
+			Code:    validation.CodeInvalidValue,
+			Message: fmt.Sprintf("the APK is package %s, not %s", manifest.PackageName, packageName),
+		}}
+		return "", fieldErrors, &common.BaseResp{Code: "400", Msg: "APK does not match the game version"}
+	}
+
+	apkManifest, err := service.ConvertApkManifestToDdl(manifest, time.Now())
+	if err != nil {
+		return "", nil, &common.BaseResp{Code: "500", Msg: err.Error()}
+	}
+	return apkManifest, nil, nil
+}
+
+// androidPackageOf returns the Android package name of a version and the field holding it: the identifier
+// of its Android build, or package_name for a version without builds. ok is false when the version is
+// not distributed on Android.
+func androidPackageOf(version *game.GameVersion) (field, packageName string, ok bool) {
+	for i, build := range version.Builds {
+		if build.Platform == game.GamePlatform_Android {
+			return fmt.Sprintf("builds[%d].identifier", i), build.Identifier, true
+		}
+	}
+	if len(version.Builds) > 0 {
+		return "", "", false
+	}
+	for _, platform := range version.GamePlatforms {
+		if platform == game.GamePlatform_Android {
+			return "package_name", version.PackageName, true
+		}
+	}
+	return "", "", false
+}
+
+// resolveApkPath returns the real path of an APK submitted by path, which must be inside apk.upload_dir so
+// that callers cannot make the service read arbitrary files.
+func resolveApkPath(path string) (string, error) {
+	uploadDir := config.ApkUploadDir()
+	if uploadDir == "" {
+		return "", errors.New("apk.upload_dir is not configured")
+	}
+	dir, err := filepath.EvalSymlinks(uploadDir)
+	if err != nil {
+		return "", err
+	}
+	dir, err = filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", err
+	}
+	resolved, err = filepath.Abs(resolved)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(dir, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s is outside apk.upload_dir", path)
+	}
+	return resolved, nil
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/GameLaunchPad/game_management_project/game/auth"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"gorm.io/gorm"
+)
+
+// actingCpID returns the CP the gateway says is calling. A non-zero CpID in the request body must
+// name that same CP; the body is never trusted on its own.
+func actingCpID(ctx context.Context, bodyCpID int64) (uint64, *common.BaseResp) {
+	cpID, ok := auth.CpIDFromContext(ctx)
+	if !ok {
+		return 0, &common.BaseResp{Code: "10015", Msg: "Permission denied: the calling CP is unknown"}
+	}
+	if bodyCpID != 0 && uint64(bodyCpID) != cpID {
+		return 0, &common.BaseResp{Code: "10015", Msg: fmt.Sprintf("Permission denied: CpID %d is not the calling CP %d", bodyCpID, cpID)}
+	}
+	return cpID, nil
+}
+
+// authorizeAdmin checks that a platform admin is calling, for operations that cross CP boundaries. It
+// returns the response to send back when the caller may not go on, and nil otherwise.
+func authorizeAdmin(ctx context.Context) *common.BaseResp {
+	if _, ok := auth.AdminFromContext(ctx); !ok {
+		return &common.BaseResp{Code: "10015", Msg: "Permission denied: only a platform admin may do this"}
+	}
+	return nil
+}
+
+// authorizeGameOwner checks that the calling CP owns the game before it is changed. It returns the
+// response to send back when the caller may not go on, and nil otherwise.
+func authorizeGameOwner(ctx context.Context, gameID uint64, bodyCpID int64) *common.BaseResp {
+	cpID, baseResp := actingCpID(ctx, bodyCpID)
+	if baseResp != nil {
+		return baseResp
+	}
+
+	gameDdl, err := GameDao.GetGame(ctx, gameID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &common.BaseResp{Code: "10001", Msg: "Game not found"}
+		}
+		return &common.BaseResp{Code: "500", Msg: "Failed to get game: " + err.Error()}
+	}
+	if gameDdl.CpId != cpID {
+		return &common.BaseResp{Code: "10015", Msg: fmt.Sprintf("Permission denied: game %d does not belong to CP %d", gameID, cpID)}
+	}
+	return nil
+}
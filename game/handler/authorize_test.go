@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/auth"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// testCpID is the CP that owns the games of the handler tests.
+const testCpID = uint64(1001)
+
+// ownerContext returns a context in which the gateway says testCpID is calling.
+func ownerContext() context.Context {
+	return auth.WithCpID(context.Background(), testCpID)
+}
+
+// adminContext returns a context in which the gateway says a platform admin is calling.
+func adminContext() context.Context {
+	return auth.WithAdmin(context.Background(), "ops_alice")
+}
+
+// expectGameOwner lets the ownership check find the game owned by testCpID.
+func expectGameOwner(mockGameDAO *mock.MockIGameDAO) {
+	mockGameDAO.EXPECT().
+		GetGame(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, gameID uint64) (*ddl.GpGame, error) {
+			return &ddl.GpGame{Id: gameID, CpId: testCpID}, nil
+		}).
+		Times(1)
+}
+
+// TestAuthorizeGameOwner_UnknownCaller tests that a mutation without a CP identity is rejected before touching the game
+func TestAuthorizeGameOwner_UnknownCaller(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	resp, err := SubmitGameVersion(context.Background(), &game.SubmitGameVersionRequest{GameID: 101, GameVersionID: 201})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "10015", resp.BaseResp.Code)
+}
+
+// TestAuthorizeGameOwner_OtherCp tests that a CP cannot change a game of another CP
+func TestAuthorizeGameOwner_OtherCp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		GetGame(gomock.Any(), uint64(101)).
+		Return(&ddl.GpGame{Id: 101, CpId: 2}, nil).
+		Times(1)
+
+	resp, err := DeleteGameDraft(ownerContext(), &game.DeleteGameDraftRequest{GameID: 101})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "10015", resp.BaseResp.Code)
+}
+
+// TestAuthorizeGameOwner_BodyCpMismatch tests that a CpID in the body naming another CP is rejected
+func TestAuthorizeGameOwner_BodyCpMismatch(t *testing.T) {
+	req := &game.UpdateGameDraftRequest{
+		GameDetail: &game.GameDetailWrite{GameID: 101, CpID: 2, GameVersion: &game.GameVersion{GameName: "Game"}},
+	}
+
+	resp, err := UpdateGameDraft(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "10015", resp.BaseResp.Code)
+}
+
+// TestAuthorizeGameOwner_GameNotFound tests the ownership check of a game that does not exist
+func TestAuthorizeGameOwner_GameNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().GetGame(gomock.Any(), uint64(101)).Return(nil, gorm.ErrRecordNotFound).Times(1)
+
+	resp, err := WithdrawGameVersion(ownerContext(), &game.WithdrawGameVersionRequest{GameID: 101, GameVersionID: 201})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "10001", resp.BaseResp.Code)
+}
+
+// TestAuthorizeGameOwner_DBError tests a failure loading the game for the ownership check
+func TestAuthorizeGameOwner_DBError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().GetGame(gomock.Any(), uint64(101)).Return(nil, errors.New("database connection error")).Times(1)
+
+	resp, err := CancelScheduledPublish(ownerContext(), &game.CancelScheduledPublishRequest{GameID: 101, GameVersionID: 201})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "500", resp.BaseResp.Code)
+}
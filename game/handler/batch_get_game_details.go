@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GameLaunchPad/game_management_project/game/constdef"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/service"
+)
+
+// BatchGetGameDetails loads the details of several games at once. The number of queries does not
+// depend on how many games are asked for: games and versions take two, takedown info one more.
+func BatchGetGameDetails(ctx context.Context, req *game.BatchGetGameDetailsRequest) (*game.BatchGetGameDetailsResponse, error) {
+	// parameter validation
+	if len(req.GameIDs) == 0 {
+		return &game.BatchGetGameDetailsResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "GameIDs is required"},
+		}, nil
+	}
+
+	// keep the request order and drop duplicates
+	gameIDs := make([]uint64, 0, len(req.GameIDs))
+	seen := make(map[int64]bool, len(req.GameIDs))
+	for _, id := range req.GameIDs {
+		if id <= 0 {
+			return &game.BatchGetGameDetailsResponse{
+				BaseResp: &common.BaseResp{Code: "400", Msg: fmt.Sprintf("Invalid GameID %d", id)},
+			}, nil
+		}
+		if !seen[id] {
+			seen[id] = true
+			gameIDs = append(gameIDs, uint64(id))
+		}
+	}
+	if len(gameIDs) > constdef.MaxBatchGetGameDetails {
+		return &game.BatchGetGameDetailsResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: fmt.Sprintf("At most %d games can be fetched at once", constdef.MaxBatchGetGameDetails)},
+		}, nil
+	}
+
+	// get game details from DAO
+	details, err := GameDao.BatchGetGameDetails(ctx, gameIDs)
+	if err != nil {
+		return &game.BatchGetGameDetailsResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to get game details: " + err.Error()},
+		}, nil
+	}
+
+	// taken-down games carry the reason and operator of their latest takedown, like GetGameDetail
+	var takenDownIDs []uint64
+	for _, id := range gameIDs {
+		if detail, ok := details[id]; ok && detail.Game.TakedownVersionId != 0 {
+			takenDownIDs = append(takenDownIDs, id)
+		}
+	}
+	var takedownLogs map[uint64]*ddl.GpGameOperationLog
+	if len(takenDownIDs) > 0 {
+		takedownLogs, err = GameDao.GetLatestGameOperationLogs(ctx, takenDownIDs, constdef.GameOperationTakedown)
+		if err != nil {
+			return &game.BatchGetGameDetailsResponse{
+				BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to get game takedown info: " + err.Error()},
+			}, nil
+		}
+	}
+
+	// transform to response format
+	resp := &game.BatchGetGameDetailsResponse{
+		GameDetails:    make([]*game.GameDetail, 0, len(details)),
+		MissingGameIDs: make([]int64, 0),
+		BaseResp:       &common.BaseResp{Code: "200", Msg: "Success"},
+	}
+	for _, id := range gameIDs {
+		detail, ok := details[id]
+		if !ok {
+			resp.MissingGameIDs = append(resp.MissingGameIDs, int64(id))
+			continue
+		}
+		gameDetail, err := service.ConvertDdlToDetailGame(detail.Game, detail.NewestVersion, detail.OnlineVersion)
+		if err != nil {
+			return &game.BatchGetGameDetailsResponse{
+				BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to convert game data: " + err.Error()},
+			}, nil
+		}
+		if detail.Game.TakedownVersionId != 0 {
+			gameDetail.Takedown = service.ConvertDdlToGameTakedown(detail.Game.TakedownVersionId, takedownLogs[id])
+		}
+		resp.GameDetails = append(resp.GameDetails, gameDetail)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/GameLaunchPad/game_management_project/game/constdef"
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBatchGetGameDetails_Success tests that details come back in request order and missing games are reported
+func TestBatchGetGameDetails_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	now := time.Now()
+	details := map[uint64]*dao.GameDetailRecord{
+		101: {
+			Game:          &ddl.GpGame{Id: 101, CpId: 1, OnlineGameVersionId: 200, NewestGameVersionId: 201, CreateTs: now, ModifyTs: now},
+			NewestVersion: &ddl.GpGameVersion{Id: 201, GameId: 101, GameName: "Version 1.1", Platform: "[]", GameIntroductionImages: "[]"},
+			OnlineVersion: &ddl.GpGameVersion{Id: 200, GameId: 101, GameName: "Version 1.0", Platform: "[]", GameIntroductionImages: "[]"},
+		},
+		102: {
+			Game:          &ddl.GpGame{Id: 102, CpId: 1, NewestGameVersionId: 300, TakedownVersionId: 299, CreateTs: now, ModifyTs: now},
+			NewestVersion: &ddl.GpGameVersion{Id: 300, GameId: 102, GameName: "Draft", Platform: "[]", GameIntroductionImages: "[]"},
+		},
+	}
+	mockGameDAO.EXPECT().
+		BatchGetGameDetails(gomock.Any(), []uint64{102, 999, 101}).
+		Return(details, nil).
+		Times(1)
+	mockGameDAO.EXPECT().
+		GetLatestGameOperationLogs(gomock.Any(), []uint64{102}, constdef.GameOperationTakedown).
+		Return(map[uint64]*ddl.GpGameOperationLog{102: {GameId: 102, Reason: "copyright complaint", Operator: "ops_bob", CreateTs: now}}, nil).
+		Times(1)
+
+	// duplicates are fetched and returned once
+	req := &game.BatchGetGameDetailsRequest{GameIDs: []int64{102, 999, 101, 102}}
+
+	resp, err := BatchGetGameDetails(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Len(t, resp.GameDetails, 2)
+	assert.Equal(t, int64(102), resp.GameDetails[0].GameID)
+	assert.Equal(t, "copyright complaint", resp.GameDetails[0].Takedown.Reason)
+	assert.Nil(t, resp.GameDetails[0].OnlineGameVersion)
+	assert.Equal(t, int64(101), resp.GameDetails[1].GameID)
+	assert.Equal(t, "Version 1.1", resp.GameDetails[1].NewestGameVersion_.GameName)
+	assert.Equal(t, "Version 1.0", resp.GameDetails[1].OnlineGameVersion.GameName)
+	assert.Nil(t, resp.GameDetails[1].Takedown)
+	assert.Equal(t, []int64{999}, resp.MissingGameIDs)
+}
+
+// TestBatchGetGameDetails_InvalidRequest tests empty, invalid and oversized ID lists
+func TestBatchGetGameDetails_InvalidRequest(t *testing.T) {
+	tooMany := make([]int64, constdef.MaxBatchGetGameDetails+1)
+	for i := range tooMany {
+		tooMany[i] = int64(i + 1)
+	}
+
+	for _, ids := range [][]int64{nil, {101, 0}, tooMany} {
+		resp, err := BatchGetGameDetails(context.Background(), &game.BatchGetGameDetailsRequest{GameIDs: ids})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "400", resp.BaseResp.Code, fmt.Sprint(len(ids)))
+	}
+}
+
+// TestBatchGetGameDetails_VersionMissing tests that a dangling version reference is reported instead of dropped
+func TestBatchGetGameDetails_VersionMissing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		BatchGetGameDetails(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("%w: game 101, version 201", dao.ErrGameVersionMissing)).
+		Times(1)
+
+	resp, err := BatchGetGameDetails(context.Background(), &game.BatchGetGameDetailsRequest{GameIDs: []int64{101}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "500", resp.BaseResp.Code)
+	assert.Contains(t, resp.BaseResp.Msg, "game 101, version 201")
+}
+
+// TestBatchGetGameDetails_TakedownLogError tests a failure loading takedown info
+func TestBatchGetGameDetails_TakedownLogError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	details := map[uint64]*dao.GameDetailRecord{
+		101: {Game: &ddl.GpGame{Id: 101, TakedownVersionId: 200}},
+	}
+	mockGameDAO.EXPECT().BatchGetGameDetails(gomock.Any(), gomock.Any()).Return(details, nil).Times(1)
+	mockGameDAO.EXPECT().
+		GetLatestGameOperationLogs(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("database connection error")).
+		Times(1)
+
+	resp, err := BatchGetGameDetails(context.Background(), &game.BatchGetGameDetailsRequest{GameIDs: []int64{101}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "500", resp.BaseResp.Code)
+}
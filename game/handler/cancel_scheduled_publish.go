@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"gorm.io/gorm"
+)
+
+// CancelScheduledPublish cancels a pending scheduled publish; the version goes back to Reviewing.
+func CancelScheduledPublish(ctx context.Context, req *game.CancelScheduledPublishRequest) (*game.CancelScheduledPublishResponse, error) {
+	// --- 1. 参数校验 ---
+	if req.GameID <= 0 || req.GameVersionID <= 0 {
+		return &game.CancelScheduledPublishResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid GameID or GameVersionID"},
+		}, nil
+	}
+
+	// 只有游戏所属的 CP 可以操作该游戏
+	if baseResp := authorizeGameOwner(ctx, uint64(req.GameID), 0); baseResp != nil {
+		return &game.CancelScheduledPublishResponse{BaseResp: baseResp}, nil
+	}
+
+	// --- 2. 调用 DAO 层取消定时发布 ---
+	err := GameDao.CancelScheduledPublish(ctx, uint64(req.GameID), uint64(req.GameVersionID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &game.CancelScheduledPublishResponse{
+				BaseResp: &common.BaseResp{Code: "10002", Msg: "Game or Version not found"},
+			}, nil
+		}
+		if errors.Is(err, dao.ErrVersionNotScheduled) {
+			return &game.CancelScheduledPublishResponse{
+				BaseResp: &common.BaseResp{Code: "10006", Msg: err.Error()},
+			}, nil
+		}
+		return &game.CancelScheduledPublishResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to cancel scheduled publish: " + err.Error()},
+		}, nil
+	}
+
+	RefreshSearchIndex(ctx, uint64(req.GameID))
+
+	// --- 3. 构建并返回成功的响应 ---
+	return &game.CancelScheduledPublishResponse{
+		BaseResp: &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
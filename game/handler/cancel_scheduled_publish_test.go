@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// TestCancelScheduledPublish_Success tests the successful cancellation of a scheduled publish
+func TestCancelScheduledPublish_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		CancelScheduledPublish(gomock.Any(), uint64(101), uint64(201)).
+		Return(nil).
+		Times(1)
+
+	req := &game.CancelScheduledPublishRequest{
+		GameID:        101,
+		GameVersionID: 201,
+	}
+
+	resp, err := CancelScheduledPublish(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+}
+
+// TestCancelScheduledPublish_InvalidIDs tests the failure case when GameID or GameVersionID is invalid
+func TestCancelScheduledPublish_InvalidIDs(t *testing.T) {
+	req := &game.CancelScheduledPublishRequest{
+		GameID:        0,
+		GameVersionID: 201,
+	}
+
+	resp, err := CancelScheduledPublish(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+}
+
+// TestCancelScheduledPublish_NotScheduled tests cancelling a version that is not waiting for a scheduled publish
+func TestCancelScheduledPublish_NotScheduled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		CancelScheduledPublish(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(dao.ErrVersionNotScheduled).
+		Times(1)
+
+	req := &game.CancelScheduledPublishRequest{
+		GameID:        101,
+		GameVersionID: 201,
+	}
+
+	resp, err := CancelScheduledPublish(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10006", resp.BaseResp.Code)
+	assert.Equal(t, dao.ErrVersionNotScheduled.Error(), resp.BaseResp.Msg)
+}
+
+// TestCancelScheduledPublish_NotFound tests the scenario where the game or version is not found
+func TestCancelScheduledPublish_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		CancelScheduledPublish(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(gorm.ErrRecordNotFound).
+		Times(1)
+
+	req := &game.CancelScheduledPublishRequest{
+		GameID:        999,
+		GameVersionID: 9999,
+	}
+
+	resp, err := CancelScheduledPublish(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10002", resp.BaseResp.Code)
+}
+
+// TestCancelScheduledPublish_DaoError tests the scenario where the DAO returns a general error
+func TestCancelScheduledPublish_DaoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		CancelScheduledPublish(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errors.New("database connection error")).
+		Times(1)
+
+	req := &game.CancelScheduledPublishRequest{
+		GameID:        101,
+		GameVersionID: 201,
+	}
+
+	resp, err := CancelScheduledPublish(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "500", resp.BaseResp.Code)
+}
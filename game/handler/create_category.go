@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/yitter/idgenerator-go/idgen"
+)
+
+// CreateCategory adds a category to the taxonomy, at the top level or under ParentID.
+func CreateCategory(ctx context.Context, req *game.CreateCategoryRequest) (*game.CreateCategoryResponse, error) {
+	// --- 1. 参数校验 ---
+	name, baseResp := normalizeTaxonomyName(req.Name)
+	if baseResp != nil {
+		return &game.CreateCategoryResponse{BaseResp: baseResp}, nil
+	}
+	if req.ParentID < 0 {
+		return &game.CreateCategoryResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid ParentID"},
+		}, nil
+	}
+
+	// --- 2. 调用 DAO 层创建分类 ---
+	category := &ddl.GpCategory{
+		Id:        uint64(idgen.NextId()),
+		ParentId:  uint64(req.ParentID),
+		Name:      name,
+		SortOrder: int(req.SortOrder),
+	}
+	if err := GameDao.CreateCategory(ctx, category); err != nil {
+		return &game.CreateCategoryResponse{BaseResp: taxonomyErrorResp(err, "create category")}, nil
+	}
+
+	// --- 3. 构建并返回成功的响应 ---
+	return &game.CreateCategoryResponse{
+		CategoryID: int64(category.Id),
+		BaseResp:   &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
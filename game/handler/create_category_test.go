@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCreateCategory_Success tests that a category is created under its parent with a trimmed name
+func TestCreateCategory_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		CreateCategory(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, category *ddl.GpCategory) error {
+			assert.NotZero(t, category.Id)
+			assert.Equal(t, uint64(11), category.ParentId)
+			assert.Equal(t, "Puzzle", category.Name)
+			assert.Equal(t, 2, category.SortOrder)
+			return nil
+		}).
+		Times(1)
+
+	resp, err := CreateCategory(context.Background(), &game.CreateCategoryRequest{Name: " Puzzle ", ParentID: 11, SortOrder: 2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.NotZero(t, resp.CategoryID)
+}
+
+// TestCreateCategory_InvalidName tests that blank and overlong names are rejected
+func TestCreateCategory_InvalidName(t *testing.T) {
+	for _, name := range []string{" ", strings.Repeat("类", maxTaxonomyNameLength+1)} {
+		resp, err := CreateCategory(context.Background(), &game.CreateCategoryRequest{Name: name})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "400", resp.BaseResp.Code)
+	}
+}
+
+// TestCreateCategory_Errors tests how the errors of the DAO are reported
+func TestCreateCategory_Errors(t *testing.T) {
+	tests := []struct {
+		err      error
+		wantCode string
+	}{
+		{fmt.Errorf("%w: category %q", dao.ErrTaxonomyNameConflict, "Puzzle"), "10019"},
+		{fmt.Errorf("%w: categories may be at most 3 levels deep", dao.ErrInvalidCategoryParent), "10020"},
+		{fmt.Errorf("database connection error"), "500"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.wantCode, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockGameDAO := mock.NewMockIGameDAO(ctrl)
+			GameDao = mockGameDAO
+
+			mockGameDAO.EXPECT().CreateCategory(gomock.Any(), gomock.Any()).Return(tt.err).Times(1)
+
+			resp, err := CreateCategory(context.Background(), &game.CreateCategoryRequest{Name: "Puzzle", ParentID: 11})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantCode, resp.BaseResp.Code)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"errors"
 
 	"github.com/GameLaunchPad/game_management_project/game/dao"
 	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
@@ -32,6 +33,17 @@ func CreateGameDetail(ctx context.Context, req *game.CreateGameDetailRequest) (*
 		}, nil
 	}
 
+	// drafts only have to fit the storage, content submitted for review must be complete
+	if fieldErrors, baseResp := validateGameVersion(req.GameDetail.GameVersion, validationModeOf(req.SubmitMode)); baseResp != nil {
+		return &game.CreateGameDetailResponse{FieldErrors: fieldErrors, BaseResp: baseResp}, nil
+	}
+
+	// the game belongs to the calling CP; CpID in the body may only repeat it
+	cpID, baseResp := actingCpID(ctx, req.GameDetail.CpID)
+	if baseResp != nil {
+		return &game.CreateGameDetailResponse{BaseResp: baseResp}, nil
+	}
+
 	// generate new IDs
 	gameID := uint64(idgen.NextId())
 	versionID := uint64(idgen.NextId())
@@ -46,9 +58,12 @@ func CreateGameDetail(ctx context.Context, req *game.CreateGameDetailRequest) (*
 	gameVersionDdl.Id = versionID
 	gameVersionDdl.GameId = gameID
 
+	// the initial status is decided by SubmitMode, never by the status sent by the client
+	gameVersionDdl.Status = int(initialStatusOf(req.SubmitMode))
+
 	gameDdl := &ddl.GpGame{
 		Id:                  gameID,
-		CpId:                uint64(req.GameDetail.CpID),
+		CpId:                cpID,
 		GameName:            gameVersionDdl.GameName,
 		GameIcon:            gameVersionDdl.GameIcon,
 		HeaderImage:         gameVersionDdl.HeaderImage,
@@ -56,13 +71,36 @@ func CreateGameDetail(ctx context.Context, req *game.CreateGameDetailRequest) (*
 	}
 
 	if err := GameDao.CreateGame(ctx, gameDdl, gameVersionDdl); err != nil {
+		// another game already uses one of the package names
+		if errors.Is(err, dao.ErrPackageNameConflict) {
+			return &game.CreateGameDetailResponse{
+				BaseResp: &common.BaseResp{Code: "10016", Msg: err.Error()},
+			}, nil
+		}
+		// the category or a tag does not exist (any more)
+		if fieldErrors := contentFieldErrors(err); fieldErrors != nil {
+			return &game.CreateGameDetailResponse{
+				FieldErrors: fieldErrors,
+				BaseResp:    &common.BaseResp{Code: "400", Msg: "Invalid game version content"},
+			}, nil
+		}
 		return &game.CreateGameDetailResponse{
 			BaseResp: &common.BaseResp{Code: "500", Msg: "Internal Server Error: " + err.Error()},
 		}, nil
 	}
 
+	RefreshSearchIndex(ctx, gameID)
+
 	return &game.CreateGameDetailResponse{
 		GameID:   int64(gameID),
 		BaseResp: &common.BaseResp{Code: "200", Msg: "Success"},
 	}, nil
 }
+
+// initialStatusOf maps the SubmitMode of a create or update request to the status of the version it writes.
+func initialStatusOf(mode game.SubmitMode) game.GameStatus {
+	if mode == game.SubmitMode_SubmitReview {
+		return game.GameStatus_Reviewing
+	}
+	return game.GameStatus_Draft
+}
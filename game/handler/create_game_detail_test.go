@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
 	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
 	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
 	"github.com/golang/mock/gomock"
@@ -20,8 +21,13 @@ func TestCreateGameDetail_Success(t *testing.T) {
 	mockGameDAO := mock.NewMockIGameDAO(ctrl)
 	GameDao = mockGameDAO
 
-	// expect CreateGame to be called once with any parameters and return nil error
-	mockGameDAO.EXPECT().CreateGame(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	// expect CreateGame to be called once with a draft version and return nil error
+	mockGameDAO.EXPECT().CreateGame(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ *ddl.GpGame, version *ddl.GpGameVersion) error {
+			assert.Equal(t, int(game.GameStatus_Draft), version.Status)
+			return nil
+		}).
+		Times(1)
 
 	req := &game.CreateGameDetailRequest{
 		GameDetail: &game.GameDetailWrite{
@@ -33,7 +39,7 @@ func TestCreateGameDetail_Success(t *testing.T) {
 		},
 	}
 
-	resp, err := CreateGameDetail(context.Background(), req)
+	resp, err := CreateGameDetail(ownerContext(), req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
@@ -41,6 +47,43 @@ func TestCreateGameDetail_Success(t *testing.T) {
 	assert.NotEqual(t, int64(0), resp.GameID)
 }
 
+// TestCreateGameDetail_SubmitReview tests that SubmitReview puts the new version straight into review,
+// whatever status the client sends
+func TestCreateGameDetail_SubmitReview(t *testing.T) {
+	setupIDGenerator()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().CreateGame(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ *ddl.GpGame, version *ddl.GpGameVersion) error {
+			assert.Equal(t, int(game.GameStatus_Reviewing), version.Status)
+			return nil
+		}).
+		Times(1)
+
+	req := &game.CreateGameDetailRequest{
+		GameDetail: &game.GameDetailWrite{
+			GameID: 0,
+			CpID:   1001,
+			GameVersion: func() *game.GameVersion {
+				version := completeGameVersion("My First Game")
+				version.GameStatus = game.GameStatus_Published
+				return version
+			}(),
+		},
+		SubmitMode: game.SubmitMode_SubmitReview,
+	}
+
+	resp, err := CreateGameDetail(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+}
+
 // TestCreateGameDetail_FailWithNonZeroGameID tests the failure case when a non-zero GameID is provided
 func TestCreateGameDetail_FailWithNonZeroGameID(t *testing.T) {
 	req := &game.CreateGameDetailRequest{
@@ -83,7 +126,7 @@ func TestCreateGameDetail_DaoError(t *testing.T) {
 		},
 	}
 
-	resp, err := CreateGameDetail(context.Background(), req)
+	resp, err := CreateGameDetail(ownerContext(), req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
@@ -121,3 +164,33 @@ func TestCreateGameDetail_NilGameVersion(t *testing.T) {
 	assert.Equal(t, "400", resp.BaseResp.Code)
 	assert.Contains(t, resp.BaseResp.Msg, "GameDetail or GameVersion is missing")
 }
+
+// TestCreateGameDetail_CpFromContext tests that a new game belongs to the calling CP when the body leaves CpID unset
+func TestCreateGameDetail_CpFromContext(t *testing.T) {
+	setupIDGenerator()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().CreateGame(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, gameDdl *ddl.GpGame, _ *ddl.GpGameVersion) error {
+			assert.Equal(t, testCpID, gameDdl.CpId)
+			return nil
+		}).
+		Times(1)
+
+	req := &game.CreateGameDetailRequest{
+		GameDetail: &game.GameDetailWrite{
+			GameVersion: &game.GameVersion{
+				GameName: "My First Game",
+			},
+		},
+	}
+
+	resp, err := CreateGameDetail(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/yitter/idgenerator-go/idgen"
+)
+
+// CreateTag adds a tag to the taxonomy.
+func CreateTag(ctx context.Context, req *game.CreateTagRequest) (*game.CreateTagResponse, error) {
+	// --- 1. 参数校验 ---
+	name, baseResp := normalizeTaxonomyName(req.Name)
+	if baseResp != nil {
+		return &game.CreateTagResponse{BaseResp: baseResp}, nil
+	}
+
+	// --- 2. 调用 DAO 层创建标签 ---
+	tag := &ddl.GpTag{
+		Id:   uint64(idgen.NextId()),
+		Name: name,
+	}
+	if err := GameDao.CreateTag(ctx, tag); err != nil {
+		return &game.CreateTagResponse{BaseResp: taxonomyErrorResp(err, "create tag")}, nil
+	}
+
+	// --- 3. 构建并返回成功的响应 ---
+	return &game.CreateTagResponse{
+		TagID:    int64(tag.Id),
+		BaseResp: &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCreateTag_Success tests that a tag is created
+func TestCreateTag_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		CreateTag(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, tag *ddl.GpTag) error {
+			assert.NotZero(t, tag.Id)
+			assert.Equal(t, "Multiplayer", tag.Name)
+			return nil
+		}).
+		Times(1)
+
+	resp, err := CreateTag(context.Background(), &game.CreateTagRequest{Name: "Multiplayer"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.NotZero(t, resp.TagID)
+}
+
+// TestCreateTag_NameConflict tests creating a tag whose name is taken
+func TestCreateTag_NameConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		CreateTag(gomock.Any(), gomock.Any()).
+		Return(fmt.Errorf("%w: tag %q", dao.ErrTaxonomyNameConflict, "Multiplayer")).
+		Times(1)
+
+	resp, err := CreateTag(context.Background(), &game.CreateTagRequest{Name: "Multiplayer"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "10019", resp.BaseResp.Code)
+}
+
+// TestCreateTag_BlankName tests that a blank name is rejected
+func TestCreateTag_BlankName(t *testing.T) {
+	resp, err := CreateTag(context.Background(), &game.CreateTagRequest{Name: ""})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+}
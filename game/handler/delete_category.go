@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+)
+
+// DeleteCategory removes a category that is no longer used by any subcategory or game version.
+func DeleteCategory(ctx context.Context, req *game.DeleteCategoryRequest) (*game.DeleteCategoryResponse, error) {
+	// --- 1. 参数校验 ---
+	if req.CategoryID <= 0 {
+		return &game.DeleteCategoryResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid CategoryID"},
+		}, nil
+	}
+
+	// --- 2. 调用 DAO 层删除分类 ---
+	if err := GameDao.DeleteCategory(ctx, uint64(req.CategoryID)); err != nil {
+		return &game.DeleteCategoryResponse{BaseResp: taxonomyErrorResp(err, "delete category")}, nil
+	}
+
+	// --- 3. 构建并返回成功的响应 ---
+	return &game.DeleteCategoryResponse{
+		BaseResp: &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDeleteCategory_Success tests that an unused category is deleted
+func TestDeleteCategory_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().DeleteCategory(gomock.Any(), uint64(12)).Return(nil).Times(1)
+
+	resp, err := DeleteCategory(context.Background(), &game.DeleteCategoryRequest{CategoryID: 12})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+}
+
+// TestDeleteCategory_InUse tests that a category still used by game versions is kept
+func TestDeleteCategory_InUse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		DeleteCategory(gomock.Any(), uint64(12)).
+		Return(fmt.Errorf("%w: 3 game versions belong to the category", dao.ErrTaxonomyInUse)).
+		Times(1)
+
+	resp, err := DeleteCategory(context.Background(), &game.DeleteCategoryRequest{CategoryID: 12})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "10021", resp.BaseResp.Code)
+}
+
+// TestDeleteCategory_InvalidID tests the failure case when CategoryID is invalid
+func TestDeleteCategory_InvalidID(t *testing.T) {
+	resp, err := DeleteCategory(context.Background(), &game.DeleteCategoryRequest{CategoryID: -1})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+}
@@ -18,8 +18,13 @@ func DeleteGameDraft(ctx context.Context, req *game.DeleteGameDraftRequest) (*ga
 		}, nil
 	}
 
-	// --- 2. 调用 DAO 层执行软删除 ---
-	err := GameDao.DeleteGameDraft(ctx, uint64(req.GameID))
+	// 只有游戏所属的 CP 可以操作该游戏
+	if baseResp := authorizeGameOwner(ctx, uint64(req.GameID), 0); baseResp != nil {
+		return &game.DeleteGameDraftResponse{BaseResp: baseResp}, nil
+	}
+
+	// --- 2. 调用 DAO 层将草稿移入回收站 ---
+	newestVersionID, err := GameDao.DeleteGameDraft(ctx, uint64(req.GameID))
 	if err != nil {
 		// 检查是否是“记录未找到”的特定错误
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -33,15 +38,23 @@ func DeleteGameDraft(ctx context.Context, req *game.DeleteGameDraftRequest) (*ga
 				BaseResp: &common.BaseResp{Code: "10003", Msg: err.Error()}, // 假设 10003 是业务错误码
 			}, nil
 		}
+		if errors.Is(err, dao.ErrIllegalStatusTransition) {
+			return &game.DeleteGameDraftResponse{
+				BaseResp: &common.BaseResp{Code: "10007", Msg: err.Error()},
+			}, nil
+		}
 		// 其他所有数据库错误都归为内部错误
 		return &game.DeleteGameDraftResponse{
 			BaseResp: &common.BaseResp{Code: "500", Msg: "Internal Server Error: " + err.Error()},
 		}, nil
 	}
 
+	RefreshSearchIndex(ctx, uint64(req.GameID))
+
 	// --- 3. 构建并返回成功的响应 ---
 	resp := &game.DeleteGameDraftResponse{
-		BaseResp: &common.BaseResp{Code: "200", Msg: "Success"},
+		NewestGameVersionID_: int64(newestVersionID),
+		BaseResp:             &common.BaseResp{Code: "200", Msg: "Success"},
 	}
 	return resp, nil
 }
@@ -2,7 +2,6 @@
 package handler
 
 import (
-	"context"
 	"testing"
 
 	"github.com/GameLaunchPad/game_management_project/game/dao"
@@ -20,24 +19,26 @@ func TestDeleteGameDraft_Success(t *testing.T) {
 
 	mockGameDAO := mock.NewMockIGameDAO(ctrl)
 	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
 
 	gameID := uint64(101)
 
 	// define expectation: DAO's DeleteGameDraft method is called with correct parameters and returns success
 	mockGameDAO.EXPECT().
 		DeleteGameDraft(gomock.Any(), gameID).
-		Return(nil).
+		Return(uint64(200), nil).
 		Times(1)
 
 	req := &game.DeleteGameDraftRequest{
 		GameID: int64(gameID),
 	}
 
-	resp, err := DeleteGameDraft(context.Background(), req)
+	resp, err := DeleteGameDraft(ownerContext(), req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
 	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Equal(t, int64(200), resp.NewestGameVersionID_)
 }
 
 // TestDeleteGameDraft_GameNotFound tests the scenario where the game to be deleted is not found
@@ -47,20 +48,21 @@ func TestDeleteGameDraft_GameNotFound(t *testing.T) {
 
 	mockGameDAO := mock.NewMockIGameDAO(ctrl)
 	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
 
 	gameID := uint64(999)
 
 	// define expectation: DAO returns gorm.ErrRecordNotFound
 	mockGameDAO.EXPECT().
 		DeleteGameDraft(gomock.Any(), gameID).
-		Return(gorm.ErrRecordNotFound).
+		Return(uint64(0), gorm.ErrRecordNotFound).
 		Times(1)
 
 	req := &game.DeleteGameDraftRequest{
 		GameID: int64(gameID),
 	}
 
-	resp, err := DeleteGameDraft(context.Background(), req)
+	resp, err := DeleteGameDraft(ownerContext(), req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
@@ -75,20 +77,21 @@ func TestDeleteGameDraft_NotDraft(t *testing.T) {
 
 	mockGameDAO := mock.NewMockIGameDAO(ctrl)
 	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
 
 	gameID := uint64(102)
 
 	// define expectation: DAO returns dao.ErrVersionIsNotDraft
 	mockGameDAO.EXPECT().
 		DeleteGameDraft(gomock.Any(), gameID).
-		Return(dao.ErrVersionIsNotDraft).
+		Return(uint64(0), dao.ErrVersionIsNotDraft).
 		Times(1)
 
 	req := &game.DeleteGameDraftRequest{
 		GameID: int64(gameID),
 	}
 
-	resp, err := DeleteGameDraft(context.Background(), req)
+	resp, err := DeleteGameDraft(ownerContext(), req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+)
+
+// DeleteTag removes a tag that no game version carries.
+func DeleteTag(ctx context.Context, req *game.DeleteTagRequest) (*game.DeleteTagResponse, error) {
+	// --- 1. 参数校验 ---
+	if req.TagID <= 0 {
+		return &game.DeleteTagResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid TagID"},
+		}, nil
+	}
+
+	// --- 2. 调用 DAO 层删除标签 ---
+	if err := GameDao.DeleteTag(ctx, uint64(req.TagID)); err != nil {
+		return &game.DeleteTagResponse{BaseResp: taxonomyErrorResp(err, "delete tag")}, nil
+	}
+
+	// --- 3. 构建并返回成功的响应 ---
+	return &game.DeleteTagResponse{
+		BaseResp: &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
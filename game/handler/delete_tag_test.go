@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDeleteTag_Success tests that an unused tag is deleted
+func TestDeleteTag_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().DeleteTag(gomock.Any(), uint64(21)).Return(nil).Times(1)
+
+	resp, err := DeleteTag(context.Background(), &game.DeleteTagRequest{TagID: 21})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+}
+
+// TestDeleteTag_InUse tests that a tag still carried by game versions is kept
+func TestDeleteTag_InUse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		DeleteTag(gomock.Any(), uint64(21)).
+		Return(fmt.Errorf("%w: 2 game versions carry the tag", dao.ErrTaxonomyInUse)).
+		Times(1)
+
+	resp, err := DeleteTag(context.Background(), &game.DeleteTagRequest{TagID: 21})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "10021", resp.BaseResp.Code)
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/service"
+	"gorm.io/gorm"
+)
+
+// DiffGameVersions returns a per-field diff between two versions of a game, by default from the online
+// version to the newest version.
+func DiffGameVersions(ctx context.Context, req *game.DiffGameVersionsRequest) (*game.DiffGameVersionsResponse, error) {
+	// --- 1. 参数校验 ---
+	if req.GameID <= 0 || (req.IsSetFromVersionID() && req.GetFromVersionID() <= 0) || (req.IsSetToVersionID() && req.GetToVersionID() <= 0) {
+		return &game.DiffGameVersionsResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid GameID or version ID"},
+		}, nil
+	}
+	gameID := uint64(req.GameID)
+
+	// --- 2. 确定对比的两个版本，未指定时使用线上版本和最新版本 ---
+	var fromVersionDdl, toVersionDdl *ddl.GpGameVersion
+	if !req.IsSetFromVersionID() || !req.IsSetToVersionID() {
+		_, newestVersionDdl, onlineVersionDdl, err := GameDao.GetGameDetail(ctx, gameID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return &game.DiffGameVersionsResponse{
+					BaseResp: &common.BaseResp{Code: "10001", Msg: "Game not found"},
+				}, nil
+			}
+			return &game.DiffGameVersionsResponse{
+				BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to get game detail: " + err.Error()},
+			}, nil
+		}
+		fromVersionDdl, toVersionDdl = onlineVersionDdl, newestVersionDdl
+	}
+
+	var err error
+	if req.IsSetFromVersionID() {
+		fromVersionDdl, err = GameDao.GetGameVersion(ctx, gameID, uint64(req.GetFromVersionID()))
+	}
+	if err == nil && req.IsSetToVersionID() {
+		toVersionDdl, err = GameDao.GetGameVersion(ctx, gameID, uint64(req.GetToVersionID()))
+	}
+	if err == nil && toVersionDdl == nil {
+		// the game has no version at all
+		err = gorm.ErrRecordNotFound
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &game.DiffGameVersionsResponse{
+				BaseResp: &common.BaseResp{Code: "10002", Msg: "Game or Version not found"},
+			}, nil
+		}
+		return &game.DiffGameVersionsResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to get game version: " + err.Error()},
+		}, nil
+	}
+
+	// --- 3. 以 ConvertDdlToGameVersion 的结果为准进行对比 ---
+	fromVersion, err := service.ConvertDdlToGameVersion(fromVersionDdl)
+	if err != nil {
+		return &game.DiffGameVersionsResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to convert game version data: " + err.Error()},
+		}, nil
+	}
+	toVersion, err := service.ConvertDdlToGameVersion(toVersionDdl)
+	if err != nil {
+		return &game.DiffGameVersionsResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to convert game version data: " + err.Error()},
+		}, nil
+	}
+
+	// --- 4. 构建并返回成功的响应 ---
+	resp := &game.DiffGameVersionsResponse{
+		ToVersionID: toVersion.GamVersionID,
+		Diffs:       service.DiffGameVersions(fromVersion, toVersion),
+		BaseResp:    &common.BaseResp{Code: "200", Msg: "Success"},
+	}
+	if fromVersion != nil {
+		resp.FromVersionID = fromVersion.GamVersionID
+	}
+	return resp, nil
+}
@@ -0,0 +1,245 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// TestDiffGameVersions_DefaultOnlineVsNewest tests that the diff defaults to the online and newest versions
+func TestDiffGameVersions_DefaultOnlineVsNewest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	gameID := uint64(101)
+	onlineVersion := &ddl.GpGameVersion{
+		Id: 200, GameId: gameID, GameName: "Star Farm", GameIcon: "icon_v1.png", PackageName: "com.star.farm",
+		Platform: "[1]", GameIntroductionImages: `["a.png","b.png"]`,
+	}
+	newestVersion := &ddl.GpGameVersion{
+		Id: 201, GameId: gameID, GameName: "Star Farm 2", GameIcon: "icon_v1.png", PackageName: "com.star.farm",
+		Platform: "[1,2]", GameIntroductionImages: `["b.png","c.png"]`,
+	}
+
+	mockGameDAO.EXPECT().
+		GetGameDetail(gomock.Any(), gameID).
+		Return(&ddl.GpGame{Id: gameID}, newestVersion, onlineVersion, nil).
+		Times(1)
+
+	resp, err := DiffGameVersions(context.Background(), &game.DiffGameVersionsRequest{GameID: int64(gameID)})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Equal(t, int64(200), resp.FromVersionID)
+	assert.Equal(t, int64(201), resp.ToVersionID)
+	assert.Len(t, resp.Diffs, 3)
+
+	assert.Equal(t, "game_name", resp.Diffs[0].Field)
+	assert.Equal(t, "Star Farm", resp.Diffs[0].FromValue)
+	assert.Equal(t, "Star Farm 2", resp.Diffs[0].ToValue)
+
+	assert.Equal(t, "game_introduction_images", resp.Diffs[1].Field)
+	assert.Equal(t, []string{"c.png"}, resp.Diffs[1].AddedItems)
+	assert.Equal(t, []string{"a.png"}, resp.Diffs[1].RemovedItems)
+
+	assert.Equal(t, "game_platforms", resp.Diffs[2].Field)
+	assert.Equal(t, `["Android"]`, resp.Diffs[2].FromValue)
+	assert.Equal(t, []string{"IOS"}, resp.Diffs[2].AddedItems)
+	assert.Empty(t, resp.Diffs[2].RemovedItems)
+}
+
+// TestDiffGameVersions_NoOnlineVersion tests that a game that was never published is diffed against an empty version
+func TestDiffGameVersions_NoOnlineVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	newestVersion := &ddl.GpGameVersion{Id: 301, GameId: 102, GameName: "Brand New"}
+	mockGameDAO.EXPECT().
+		GetGameDetail(gomock.Any(), uint64(102)).
+		Return(&ddl.GpGame{Id: 102}, newestVersion, nil, nil).
+		Times(1)
+
+	resp, err := DiffGameVersions(context.Background(), &game.DiffGameVersionsRequest{GameID: 102})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Equal(t, int64(0), resp.FromVersionID)
+	assert.Len(t, resp.Diffs, 1)
+	assert.Equal(t, "game_name", resp.Diffs[0].Field)
+	assert.Equal(t, "", resp.Diffs[0].FromValue)
+}
+
+// TestDiffGameVersions_ExplicitVersions tests that explicit version IDs are loaded without looking up the defaults
+func TestDiffGameVersions_ExplicitVersions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	fromID, toID := int64(401), int64(402)
+	mockGameDAO.EXPECT().GetGameDetail(gomock.Any(), gomock.Any()).Times(0)
+	mockGameDAO.EXPECT().
+		GetGameVersion(gomock.Any(), uint64(103), uint64(fromID)).
+		Return(&ddl.GpGameVersion{Id: 401, GameId: 103, DownloadUrl: "https://a"}, nil).
+		Times(1)
+	mockGameDAO.EXPECT().
+		GetGameVersion(gomock.Any(), uint64(103), uint64(toID)).
+		Return(&ddl.GpGameVersion{Id: 402, GameId: 103, DownloadUrl: "https://b"}, nil).
+		Times(1)
+
+	req := &game.DiffGameVersionsRequest{GameID: 103, FromVersionID: &fromID, ToVersionID: &toID}
+	resp, err := DiffGameVersions(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Len(t, resp.Diffs, 1)
+	assert.Equal(t, "download_url", resp.Diffs[0].Field)
+}
+
+// TestDiffGameVersions_Listings tests that the listings of other locales are diffed field by field
+func TestDiffGameVersions_Listings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	fromID, toID := int64(501), int64(502)
+	mockGameDAO.EXPECT().
+		GetGameVersion(gomock.Any(), uint64(104), uint64(fromID)).
+		Return(&ddl.GpGameVersion{Id: 501, GameId: 104, DefaultLocale: "zh-CN",
+			Listings: `{"en-US":{"game_name":"Star Farm"},"ja-JP":{"game_name":"スターファーム"}}`}, nil).
+		Times(1)
+	mockGameDAO.EXPECT().
+		GetGameVersion(gomock.Any(), uint64(104), uint64(toID)).
+		Return(&ddl.GpGameVersion{Id: 502, GameId: 104, DefaultLocale: "zh-CN",
+			Listings: `{"en-US":{"game_name":"Star Farm","game_introduction":"Grow stars."}}`}, nil).
+		Times(1)
+
+	req := &game.DiffGameVersionsRequest{GameID: 104, FromVersionID: &fromID, ToVersionID: &toID}
+	resp, err := DiffGameVersions(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Len(t, resp.Diffs, 2)
+	assert.Equal(t, "listings[en-US].game_introduction", resp.Diffs[0].Field)
+	assert.Equal(t, "Grow stars.", resp.Diffs[0].ToValue)
+	assert.Equal(t, "listings[ja-JP].game_name", resp.Diffs[1].Field)
+	assert.Equal(t, "", resp.Diffs[1].ToValue)
+}
+
+// TestDiffGameVersions_Media tests that a gallery with videos or captions is diffed item by item next to its screenshots
+func TestDiffGameVersions_Media(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	fromID, toID := int64(501), int64(502)
+	mockGameDAO.EXPECT().
+		GetGameVersion(gomock.Any(), uint64(104), uint64(fromID)).
+		Return(&ddl.GpGameVersion{Id: 501, GameId: 104, GameIntroductionImages: `["a.png"]`}, nil).
+		Times(1)
+	mockGameDAO.EXPECT().
+		GetGameVersion(gomock.Any(), uint64(104), uint64(toID)).
+		Return(&ddl.GpGameVersion{Id: 502, GameId: 104, GameIntroductionImages: `["a.png"]`,
+			Media: `[{"type":2,"uri":"trailer.mp4","thumbnail_uri":"poster.png","position":0},{"type":1,"uri":"a.png","caption":"Harvest","position":1}]`}, nil).
+		Times(1)
+
+	req := &game.DiffGameVersionsRequest{GameID: 104, FromVersionID: &fromID, ToVersionID: &toID}
+	resp, err := DiffGameVersions(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Len(t, resp.Diffs, 1)
+	assert.Equal(t, "media", resp.Diffs[0].Field)
+	assert.Equal(t, []string{"TrailerVideo trailer.mp4", `Screenshot a.png "Harvest"`}, resp.Diffs[0].AddedItems)
+	assert.Equal(t, []string{"Screenshot a.png"}, resp.Diffs[0].RemovedItems)
+}
+
+// TestDiffGameVersions_InvalidGameID tests the failure case when GameID is invalid
+func TestDiffGameVersions_InvalidGameID(t *testing.T) {
+	resp, err := DiffGameVersions(context.Background(), &game.DiffGameVersionsRequest{GameID: 0})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+}
+
+// TestDiffGameVersions_GameNotFound tests the scenario where the game does not exist
+func TestDiffGameVersions_GameNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		GetGameDetail(gomock.Any(), gomock.Any()).
+		Return(nil, nil, nil, gorm.ErrRecordNotFound).
+		Times(1)
+
+	resp, err := DiffGameVersions(context.Background(), &game.DiffGameVersionsRequest{GameID: 999})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "10001", resp.BaseResp.Code)
+}
+
+// TestDiffGameVersions_VersionNotFound tests the scenario where an explicit version does not belong to the game
+func TestDiffGameVersions_VersionNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	toID := int64(9999)
+	mockGameDAO.EXPECT().
+		GetGameDetail(gomock.Any(), uint64(104)).
+		Return(&ddl.GpGame{Id: 104}, nil, nil, nil).
+		Times(1)
+	mockGameDAO.EXPECT().
+		GetGameVersion(gomock.Any(), uint64(104), uint64(toID)).
+		Return(nil, gorm.ErrRecordNotFound).
+		Times(1)
+
+	resp, err := DiffGameVersions(context.Background(), &game.DiffGameVersionsRequest{GameID: 104, ToVersionID: &toID})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "10002", resp.BaseResp.Code)
+}
+
+// TestDiffGameVersions_DaoError tests the scenario where the DAO returns a general error
+func TestDiffGameVersions_DaoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		GetGameDetail(gomock.Any(), gomock.Any()).
+		Return(nil, nil, nil, errors.New("database connection error")).
+		Times(1)
+
+	resp, err := DiffGameVersions(context.Background(), &game.DiffGameVersionsRequest{GameID: 105})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "500", resp.BaseResp.Code)
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/service"
+	"gorm.io/gorm"
+)
+
+// changelogStatuses are the statuses of the versions that have been live and so appear in a changelog.
+var changelogStatuses = []int{int(game.GameStatus_Published), int(game.GameStatus_Offline)}
+
+// GetGameChangelog returns the release notes of the versions of a game that have been published, newest first.
+func GetGameChangelog(ctx context.Context, req *game.GetGameChangelogRequest) (*game.GetGameChangelogResponse, error) {
+	// --- 1. 参数校验 ---
+	if req.GameID <= 0 {
+		return &game.GetGameChangelogResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid GameID"},
+		}, nil
+	}
+
+	pageNum := int(req.PageNum)
+	if pageNum <= 0 {
+		pageNum = 1
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	// --- 2. 查询发布过的版本 ---
+	versionDdls, total, err := GameDao.ListGameVersions(ctx, uint64(req.GameID), changelogStatuses, pageNum, pageSize)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &game.GetGameChangelogResponse{
+				BaseResp: &common.BaseResp{Code: "10001", Msg: "Game not found"},
+			}, nil
+		}
+		return &game.GetGameChangelogResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to list game versions: " + err.Error()},
+		}, nil
+	}
+
+	// --- 3. 构建并返回成功的响应 ---
+	entries := make([]*game.ChangelogEntry, 0, len(versionDdls))
+	for _, versionDdl := range versionDdls {
+		entry, err := service.ConvertDdlToChangelogEntry(versionDdl)
+		if err != nil {
+			return &game.GetGameChangelogResponse{
+				BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to convert game version data: " + err.Error()},
+			}, nil
+		}
+		entries = append(entries, entry)
+	}
+
+	return &game.GetGameChangelogResponse{
+		Entries:    entries,
+		TotalCount: int32(total),
+		BaseResp:   &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
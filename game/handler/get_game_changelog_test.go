@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// TestGetGameChangelog_Success tests that the release notes of the published and taken-down versions are listed with their publish times
+func TestGetGameChangelog_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	gameID := uint64(123)
+	mockVersions := []*ddl.GpGameVersion{
+		{
+			Id: 202, GameId: gameID, Status: int(game.GameStatus_Published), ReviewTime: 1700000000, PublishAt: 1700086400,
+			ReleaseNotes: "<p>新增雨季。</p>", Listings: `{"en-US":{"game_name":"Star Farm","release_notes":"<p>Adds the rainy season.</p>"},"ja-JP":{"game_name":"スターファーム"}}`,
+		},
+		{Id: 200, GameId: gameID, Status: int(game.GameStatus_Offline), ReviewTime: 1690000000, DefaultLocale: "en-US"},
+	}
+
+	mockGameDAO.EXPECT().
+		ListGameVersions(gomock.Any(), gameID, []int{int(game.GameStatus_Published), int(game.GameStatus_Offline)}, 1, 10).
+		Return(mockVersions, int64(2), nil).
+		Times(1)
+
+	resp, err := GetGameChangelog(context.Background(), &game.GetGameChangelogRequest{GameID: int64(gameID)})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Equal(t, int32(2), resp.TotalCount)
+	assert.Len(t, resp.Entries, 2)
+	assert.Equal(t, &game.ChangelogEntry{
+		GameVersionID:         202,
+		GameStatus:            game.GameStatus_Published,
+		PublishTime:           1700086400,
+		DefaultLocale:         "zh-CN",
+		ReleaseNotes:          "<p>新增雨季。</p>",
+		LocalizedReleaseNotes: map[string]string{"en-US": "<p>Adds the rainy season.</p>"},
+	}, resp.Entries[0])
+	assert.Equal(t, int64(1690000000), resp.Entries[1].PublishTime)
+	assert.Equal(t, "en-US", resp.Entries[1].DefaultLocale)
+	assert.Equal(t, game.GameStatus_Offline, resp.Entries[1].GameStatus)
+}
+
+// TestGetGameChangelog_InvalidGameID tests that a non-positive game ID is rejected
+func TestGetGameChangelog_InvalidGameID(t *testing.T) {
+	resp, err := GetGameChangelog(context.Background(), &game.GetGameChangelogRequest{GameID: 0})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+}
+
+// TestGetGameChangelog_GameNotFound tests that an unknown game is reported as not found
+func TestGetGameChangelog_GameNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		ListGameVersions(gomock.Any(), uint64(404), gomock.Any(), 2, 5).
+		Return(nil, int64(0), gorm.ErrRecordNotFound).
+		Times(1)
+
+	resp, err := GetGameChangelog(context.Background(), &game.GetGameChangelogRequest{GameID: 404, PageNum: 2, PageSize: 5})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "10001", resp.BaseResp.Code)
+}
+
+// TestGetGameChangelog_DaoError tests that a DAO failure is reported as an internal error
+func TestGetGameChangelog_DaoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		ListGameVersions(gomock.Any(), uint64(123), gomock.Any(), 1, 10).
+		Return(nil, int64(0), errors.New("connection refused")).
+		Times(1)
+
+	resp, err := GetGameChangelog(context.Background(), &game.GetGameChangelogRequest{GameID: 123})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "500", resp.BaseResp.Code)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/GameLaunchPad/game_management_project/game/constdef"
 	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
 	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
 	"github.com/GameLaunchPad/game_management_project/game/service"
@@ -39,6 +40,17 @@ func GetGameDetail(ctx context.Context, req *game.GetGameDetailRequest) (*game.G
 		}, nil
 	}
 
+	// a taken-down game carries the reason and operator of its latest takedown
+	if gameDdl.TakedownVersionId != 0 {
+		takedownLog, err := GameDao.GetLatestGameOperationLog(ctx, gameDdl.Id, constdef.GameOperationTakedown)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return &game.GetGameDetailResponse{
+				BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to get game takedown info: " + err.Error()},
+			}, nil
+		}
+		gameDetail.Takedown = service.ConvertDdlToGameTakedown(gameDdl.TakedownVersionId, takedownLog)
+	}
+
 	// construct response
 	resp := &game.GetGameDetailResponse{
 		GameDetail: gameDetail,
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/GameLaunchPad/game_management_project/game/constdef"
 	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
 	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
 	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
@@ -52,6 +53,42 @@ func TestGetGameDetail_Success(t *testing.T) {
 	assert.Equal(t, "Version 1.0", resp.GameDetail.OnlineGameVersion.GameName)
 }
 
+// TestGetGameDetail_TakenDown tests that a taken-down game carries its takedown info.
+func TestGetGameDetail_TakenDown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	gameID := uint64(123)
+	takedownTime := time.Unix(1700000000, 0)
+	mockGame := &ddl.GpGame{Id: gameID, CpId: 1001, GameName: "My Detail Test Game", TakedownVersionId: 200, CreateTs: time.Now(), ModifyTs: time.Now()}
+	mockNewestVersion := &ddl.GpGameVersion{Id: 200, GameId: gameID, GameName: "Version 1.0", Status: int(game.GameStatus_Offline), Platform: "[]", GameIntroductionImages: "[]"}
+	mockTakedownLog := &ddl.GpGameOperationLog{Id: 1, GameId: gameID, OperationType: constdef.GameOperationTakedown, FromVersionId: 200, Operator: "ops_alice", Reason: "copyright complaint", CreateTs: takedownTime}
+
+	mockGameDAO.EXPECT().
+		GetGameDetail(gomock.Any(), gameID).
+		Return(mockGame, mockNewestVersion, nil, nil).
+		Times(1)
+	mockGameDAO.EXPECT().
+		GetLatestGameOperationLog(gomock.Any(), gameID, constdef.GameOperationTakedown).
+		Return(mockTakedownLog, nil).
+		Times(1)
+
+	resp, err := GetGameDetail(context.Background(), &game.GetGameDetailRequest{GameID: int64(gameID)})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Nil(t, resp.GameDetail.OnlineGameVersion)
+	assert.NotNil(t, resp.GameDetail.Takedown)
+	assert.Equal(t, int64(200), resp.GameDetail.Takedown.GameVersionID)
+	assert.Equal(t, "copyright complaint", resp.GameDetail.Takedown.Reason)
+	assert.Equal(t, "ops_alice", resp.GameDetail.Takedown.Operator)
+	assert.Equal(t, takedownTime.Unix(), resp.GameDetail.Takedown.TakedownTime)
+}
+
 // TestGetGameDetail_GameNotFound tests the scenario where the requested game does not exist.
 func TestGetGameDetail_GameNotFound(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -2,7 +2,11 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/GameLaunchPad/game_management_project/game/dao"
 	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
 	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
 	"github.com/GameLaunchPad/game_management_project/game/service"
@@ -11,9 +15,27 @@ import (
 // GetGameList handles the business logic for getting a list of games.
 func GetGameList(ctx context.Context, req *game.GetGameListRequest) (*game.GetGameListResponse, error) {
 	// parse parameters
-	var filterText *string
-	if req.IsSetFilter() && req.Filter.IsSetFilterText() {
-		filterText = req.Filter.FilterText
+	opts, err := buildGameListOptions(req)
+	if err != nil {
+		return &game.GetGameListResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: err.Error()},
+		}, nil
+	}
+
+	// a category filter also matches its subcategories
+	if req.IsSetFilter() && req.Filter.IsSetCategoryID() {
+		categories, err := GameDao.ListCategories(ctx)
+		if err != nil {
+			return &game.GetGameListResponse{
+				BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to get categories: " + err.Error()},
+			}, nil
+		}
+		opts.CategoryIDs = service.CategorySubtreeIDs(categories, uint64(req.Filter.GetCategoryID()))
+		if opts.CategoryIDs == nil {
+			return &game.GetGameListResponse{
+				BaseResp: &common.BaseResp{Code: "10018", Msg: "Category not found"},
+			}, nil
+		}
 	}
 
 	pageNum := int(req.PageNum)
@@ -26,8 +48,12 @@ func GetGameList(ctx context.Context, req *game.GetGameListRequest) (*game.GetGa
 		pageSize = 10
 	}
 
+	if req.IsSetCursor() {
+		return getGameListByCursor(ctx, req, opts, pageSize)
+	}
+
 	// get game list from DAO
-	gamesDdl, total, err := GameDao.GetGameList(ctx, filterText, pageNum, pageSize)
+	gamesDdl, total, err := GameDao.GetGameList(ctx, opts, pageNum, pageSize)
 	if err != nil {
 		return &game.GetGameListResponse{
 			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to get game list: " + err.Error()},
@@ -35,23 +61,171 @@ func GetGameList(ctx context.Context, req *game.GetGameListRequest) (*game.GetGa
 	}
 
 	// transform to response format
+	briefGames, err := convertBriefGameList(gamesDdl)
+	if err != nil {
+		return &game.GetGameListResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to convert game data: " + err.Error()},
+		}, nil
+	}
+
+	// construct response
+	totalCount := int32(total)
+	resp := &game.GetGameListResponse{
+		GameList:   briefGames,
+		TotalCount: &totalCount,
+		HasMore:    int64((pageNum-1)*pageSize+len(gamesDdl)) < total,
+		BaseResp:   &common.BaseResp{Code: "200", Msg: "Success"},
+	}
+
+	return resp, nil
+}
+
+// getGameListByCursor serves the cursor mode of GetGameList: it seeks past the cursor instead of
+// skipping rows, and only counts the matching games when the caller asks for it.
+func getGameListByCursor(ctx context.Context, req *game.GetGameListRequest, opts *dao.GameListOptions, pageSize int) (*game.GetGameListResponse, error) {
+	after, err := service.DecodeGameListCursor(req.GetCursor(), opts)
+	if err != nil {
+		return &game.GetGameListResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: err.Error()},
+		}, nil
+	}
+
+	// fetch one extra game to learn whether there is a next page
+	gamesDdl, err := GameDao.GetGameListAfter(ctx, opts, after, pageSize+1)
+	if err != nil {
+		return &game.GetGameListResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to get game list: " + err.Error()},
+		}, nil
+	}
+	hasMore := len(gamesDdl) > pageSize
+	if hasMore {
+		gamesDdl = gamesDdl[:pageSize]
+	}
+
+	briefGames, err := convertBriefGameList(gamesDdl)
+	if err != nil {
+		return &game.GetGameListResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to convert game data: " + err.Error()},
+		}, nil
+	}
+
+	resp := &game.GetGameListResponse{
+		GameList: briefGames,
+		HasMore:  hasMore,
+		BaseResp: &common.BaseResp{Code: "200", Msg: "Success"},
+	}
+	if hasMore {
+		resp.NextCursor = service.EncodeGameListCursor(opts, gamesDdl[len(gamesDdl)-1])
+	}
+
+	if req.GetNeedTotal() {
+		total, err := GameDao.CountGames(ctx, opts)
+		if err != nil {
+			return &game.GetGameListResponse{
+				BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to count games: " + err.Error()},
+			}, nil
+		}
+		totalCount := int32(total)
+		resp.TotalCount = &totalCount
+	}
+
+	return resp, nil
+}
+
+// convertBriefGameList converts the games of a page, keeping their order.
+func convertBriefGameList(gamesDdl []*dao.GameWithVersionStatus) ([]*game.BriefGame, error) {
 	briefGames := make([]*game.BriefGame, 0, len(gamesDdl))
 	for _, gameDdl := range gamesDdl {
 		briefGame, err := service.ConvertDdlToBriefGame(gameDdl)
 		if err != nil {
-			return &game.GetGameListResponse{
-				BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to convert game data: " + err.Error()},
-			}, nil
+			return nil, err
 		}
 		briefGames = append(briefGames, briefGame)
 	}
+	return briefGames, nil
+}
 
-	// construct response
-	resp := &game.GetGameListResponse{
-		GameList:   briefGames,
-		TotalCount: int32(total),
-		BaseResp:   &common.BaseResp{Code: "200", Msg: "Success"},
+// buildGameListOptions turns the filter and sorter of a GetGameList request into DAO options.
+func buildGameListOptions(req *game.GetGameListRequest) (*dao.GameListOptions, error) {
+	opts := &dao.GameListOptions{}
+
+	if req.IsSetFilter() {
+		filter := req.Filter
+		opts.FilterText = filter.FilterText
+		if filter.IsSetCpID() {
+			if filter.GetCpID() <= 0 {
+				return nil, errors.New("Invalid CpID")
+			}
+			cpID := uint64(filter.GetCpID())
+			opts.CpID = &cpID
+		}
+		for _, status := range filter.NewestStatus_ {
+			if status == game.GameStatus_Unset {
+				return nil, fmt.Errorf("Invalid newest status filter: %s", status)
+			}
+		}
+		opts.NewestStatuses = filter.NewestStatus_
+		if filter.IsSetOnlineStatus() {
+			if filter.GetOnlineStatus() == game.OnlineStatus_Unset {
+				return nil, fmt.Errorf("Invalid online status filter: %s", filter.GetOnlineStatus())
+			}
+			opts.OnlineStatus = filter.OnlineStatus
+		}
+		for _, platform := range filter.Platforms {
+			if platform == game.GamePlatform_Unset {
+				return nil, fmt.Errorf("Invalid platform filter: %s", platform)
+			}
+		}
+		opts.Platforms = filter.Platforms
+		if filter.IsSetCategoryID() && filter.GetCategoryID() <= 0 {
+			return nil, errors.New("Invalid CategoryID")
+		}
+		for _, tagID := range filter.TagIDs {
+			if tagID <= 0 {
+				return nil, fmt.Errorf("Invalid tag filter: %d", tagID)
+			}
+			opts.TagIDs = append(opts.TagIDs, uint64(tagID))
+		}
+
+		var err error
+		opts.CreateTimeStart, opts.CreateTimeEnd, err = parseTimeRange("create time", filter.CreateTimeStart, filter.CreateTimeEnd)
+		if err != nil {
+			return nil, err
+		}
+		opts.UpdateTimeStart, opts.UpdateTimeEnd, err = parseTimeRange("update time", filter.UpdateTimeStart, filter.UpdateTimeEnd)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	return resp, nil
+	if req.IsSetSorter() {
+		sorter := req.Sorter
+		// the legacy UpdateTime field only ever meant "sort by update time"
+		if sorter.IsSetUpdateTime() {
+			opts.SortField = game.GameListSortField_UpdateTime
+		}
+		if sorter.IsSetSortField() {
+			opts.SortField = sorter.GetSortField()
+		}
+		opts.SortOrder = sorter.GetSortOrder()
+	}
+
+	return opts, nil
+}
+
+// parseTimeRange converts an optional closed range of unix seconds, rejecting a range that ends before it starts.
+func parseTimeRange(name string, start, end *int64) (*time.Time, *time.Time, error) {
+	if start != nil && end != nil && *start > *end {
+		return nil, nil, fmt.Errorf("Invalid %s range: start %d is after end %d", name, *start, *end)
+	}
+	var startTime, endTime *time.Time
+	if start != nil {
+		t := time.Unix(*start, 0)
+		startTime = &t
+	}
+	if end != nil {
+		t := time.Unix(*end, 0)
+		endTime = &t
+	}
+	return startTime, endTime, nil
 }
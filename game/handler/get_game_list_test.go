@@ -11,6 +11,7 @@ import (
 	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
 	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
 	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/service"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -50,7 +51,7 @@ func TestGetGameList_Success(t *testing.T) {
 
 	// 2. 定义期望：GetGameList 方法被调用1次，并返回我们准备好的数据
 	mockGameDAO.EXPECT().
-		GetGameList(gomock.Any(), nil, 1, 10). // 期望在没有过滤器、第一页、每页10条的情况下被调用
+		GetGameList(gomock.Any(), &dao.GameListOptions{}, 1, 10). // 期望在没有过滤器、第一页、每页10条的情况下被调用
 		Return(mockedGameList, mockTotal, nil).
 		Times(1)
 
@@ -67,7 +68,7 @@ func TestGetGameList_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
 	assert.Equal(t, "200", resp.BaseResp.Code)
-	assert.Equal(t, int32(mockTotal), resp.TotalCount)
+	assert.Equal(t, int32(mockTotal), resp.GetTotalCount())
 	assert.Len(t, resp.GameList, 2)
 	assert.Equal(t, "Test Game 1", resp.GameList[0].GameName)
 	assert.Equal(t, game.GameStatus_Draft, resp.GameList[1].GameStatus) // 验证 Status 也被正确转换
@@ -90,9 +91,9 @@ func TestGetGameList_WithFilter(t *testing.T) {
 	}
 	var mockTotal int64 = 1
 
-	// 定义期望：这次我们期望 GetGameList 的第二个参数（filterText）不再是 nil
+	// 定义期望：这次我们期望 GetGameList 的第二个参数带上 filterText
 	mockGameDAO.EXPECT().
-		GetGameList(gomock.Any(), &filterText, 1, 10).
+		GetGameList(gomock.Any(), &dao.GameListOptions{FilterText: &filterText}, 1, 10).
 		Return(mockedGameList, mockTotal, nil).
 		Times(1)
 
@@ -109,11 +110,48 @@ func TestGetGameList_WithFilter(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
 	assert.Equal(t, "200", resp.BaseResp.Code)
-	assert.Equal(t, int32(1), resp.TotalCount)
+	assert.Equal(t, int32(1), resp.GetTotalCount())
 	assert.Len(t, resp.GameList, 1)
 	assert.Equal(t, "Filtered Game", resp.GameList[0].GameName)
 }
 
+// TestGetGameList_SummaryFields 测试列表项带出 gp_game 上同步自线上版本的简介、平台、包名和下载链接
+func TestGetGameList_SummaryFields(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockedGameList := []*dao.GameWithVersionStatus{
+		{
+			GpGame: ddl.GpGame{
+				Id:               5,
+				GameName:         "Star Quest",
+				GameIntroduction: "A space adventure",
+				Platform:         "[1,2]",
+				PackageName:      "com.example.star",
+				DownloadUrl:      "https://cdn.example.com/star.apk",
+			},
+			Status: int(game.GameStatus_Published),
+		},
+	}
+	mockGameDAO.EXPECT().
+		GetGameList(gomock.Any(), gomock.Any(), 1, 10).
+		Return(mockedGameList, int64(1), nil).
+		Times(1)
+
+	resp, err := GetGameList(context.Background(), &game.GetGameListRequest{PageNum: 1, PageSize: 10})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Len(t, resp.GameList, 1)
+	assert.Equal(t, "A space adventure", resp.GameList[0].GameIntroduction)
+	assert.Equal(t, []game.GamePlatform{game.GamePlatform_Android, game.GamePlatform_IOS}, resp.GameList[0].GamePlatforms)
+	assert.Equal(t, "com.example.star", resp.GameList[0].PackageName)
+	assert.Equal(t, "https://cdn.example.com/star.apk", resp.GameList[0].DownloadURL)
+}
+
 // TestGetGameList_DaoError 测试 DAO 层返回错误的场景
 func TestGetGameList_DaoError(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -242,7 +280,7 @@ func TestGetGameList_NegativePageSize(t *testing.T) {
 	assert.Equal(t, "200", resp.BaseResp.Code)
 }
 
-// TestGetGameList_NoFilter 测试没有 Filter 的情况（应该使用 nil）
+// TestGetGameList_NoFilter 测试没有 Filter 的情况（应该使用空的查询条件）
 func TestGetGameList_NoFilter(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -250,9 +288,9 @@ func TestGetGameList_NoFilter(t *testing.T) {
 	mockGameDAO := mock.NewMockIGameDAO(ctrl)
 	GameDao = mockGameDAO
 
-	// 期望 filterText 为 nil
+	// 期望查询条件为空
 	mockGameDAO.EXPECT().
-		GetGameList(gomock.Any(), nil, 1, 10).
+		GetGameList(gomock.Any(), &dao.GameListOptions{}, 1, 10).
 		Return([]*dao.GameWithVersionStatus{}, int64(0), nil).
 		Times(1)
 
@@ -268,3 +306,275 @@ func TestGetGameList_NoFilter(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Equal(t, "200", resp.BaseResp.Code)
 }
+
+// TestGetGameList_RichFilterAndSorter 测试所有过滤条件和排序方式都被传递给 DAO
+func TestGetGameList_RichFilterAndSorter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	cpID := int64(1001)
+	onlineStatus := game.OnlineStatus_TakenDown
+	createStart, createEnd := int64(1700000000), int64(1700086400)
+	updateStart := int64(1700003600)
+	sortField := game.GameListSortField_GameName
+	sortOrder := game.SortOrder_Asc
+
+	mockGameDAO.EXPECT().
+		GetGameList(gomock.Any(), gomock.Any(), 1, 10).
+		DoAndReturn(func(_ context.Context, opts *dao.GameListOptions, _, _ int) ([]*dao.GameWithVersionStatus, int64, error) {
+			assert.Equal(t, uint64(cpID), *opts.CpID)
+			assert.Equal(t, []game.GameStatus{game.GameStatus_Offline, game.GameStatus_Draft}, opts.NewestStatuses)
+			assert.Equal(t, game.OnlineStatus_TakenDown, *opts.OnlineStatus)
+			assert.Equal(t, []game.GamePlatform{game.GamePlatform_Android}, opts.Platforms)
+			assert.Equal(t, createStart, opts.CreateTimeStart.Unix())
+			assert.Equal(t, createEnd, opts.CreateTimeEnd.Unix())
+			assert.Equal(t, updateStart, opts.UpdateTimeStart.Unix())
+			assert.Nil(t, opts.UpdateTimeEnd)
+			assert.Equal(t, game.GameListSortField_GameName, opts.SortField)
+			assert.Equal(t, game.SortOrder_Asc, opts.SortOrder)
+			return []*dao.GameWithVersionStatus{}, 0, nil
+		}).
+		Times(1)
+
+	req := &game.GetGameListRequest{
+		Filter: &game.GameListFilter{
+			CpID:            &cpID,
+			NewestStatus_:   []game.GameStatus{game.GameStatus_Offline, game.GameStatus_Draft},
+			OnlineStatus:    &onlineStatus,
+			Platforms:       []game.GamePlatform{game.GamePlatform_Android},
+			CreateTimeStart: &createStart,
+			CreateTimeEnd:   &createEnd,
+			UpdateTimeStart: &updateStart,
+		},
+		Sorter: &game.GameListSorter{
+			SortField: &sortField,
+			SortOrder: &sortOrder,
+		},
+		PageNum:  1,
+		PageSize: 10,
+	}
+
+	resp, err := GetGameList(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+}
+
+// TestGetGameList_LegacyUpdateTimeSorter 测试旧的 UpdateTime 排序字段等同于按更新时间倒序
+func TestGetGameList_LegacyUpdateTimeSorter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		GetGameList(gomock.Any(), &dao.GameListOptions{SortField: game.GameListSortField_UpdateTime}, 1, 10).
+		Return([]*dao.GameWithVersionStatus{}, int64(0), nil).
+		Times(1)
+
+	updateTime := int64(1)
+	req := &game.GetGameListRequest{
+		Sorter:   &game.GameListSorter{UpdateTime: &updateTime},
+		PageNum:  1,
+		PageSize: 10,
+	}
+
+	resp, err := GetGameList(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+}
+
+// TestGetGameList_InvalidFilter 测试非法的过滤条件直接返回 400，不会访问 DAO
+func TestGetGameList_InvalidFilter(t *testing.T) {
+	start, end := int64(1700086400), int64(1700000000)
+	cpID := int64(0)
+	unsetOnline := game.OnlineStatus_Unset
+
+	filters := []*game.GameListFilter{
+		{CreateTimeStart: &start, CreateTimeEnd: &end},
+		{UpdateTimeStart: &start, UpdateTimeEnd: &end},
+		{CpID: &cpID},
+		{NewestStatus_: []game.GameStatus{game.GameStatus_Unset}},
+		{OnlineStatus: &unsetOnline},
+		{Platforms: []game.GamePlatform{game.GamePlatform_Unset}},
+		{CategoryID: &cpID},
+		{TagIDs: []int64{3, -1}},
+	}
+
+	for _, filter := range filters {
+		resp, err := GetGameList(context.Background(), &game.GetGameListRequest{Filter: filter})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+		assert.Equal(t, "400", resp.BaseResp.Code, resp.BaseResp.Msg)
+	}
+}
+
+// TestGetGameList_TaxonomyFilter 测试分类过滤包含所有子分类，标签过滤原样传给 DAO
+func TestGetGameList_TaxonomyFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		ListCategories(gomock.Any()).
+		Return([]*ddl.GpCategory{
+			{Id: 11, Name: "Casual"},
+			{Id: 12, ParentId: 11, Name: "Puzzle"},
+			{Id: 13, ParentId: 12, Name: "Match-3"},
+			{Id: 14, Name: "Action"},
+		}, nil).
+		Times(1)
+	mockGameDAO.EXPECT().
+		GetGameList(gomock.Any(), gomock.Any(), 1, 10).
+		DoAndReturn(func(_ context.Context, opts *dao.GameListOptions, _, _ int) ([]*dao.GameWithVersionStatus, int64, error) {
+			assert.Equal(t, []uint64{12, 13}, opts.CategoryIDs)
+			assert.Equal(t, []uint64{21, 22}, opts.TagIDs)
+			return []*dao.GameWithVersionStatus{}, 0, nil
+		}).
+		Times(1)
+
+	categoryID := int64(12)
+	req := &game.GetGameListRequest{
+		Filter:   &game.GameListFilter{CategoryID: &categoryID, TagIDs: []int64{21, 22}},
+		PageNum:  1,
+		PageSize: 10,
+	}
+
+	resp, err := GetGameList(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+}
+
+// TestGetGameList_CategoryNotFound 测试按不存在的分类过滤
+func TestGetGameList_CategoryNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().ListCategories(gomock.Any()).Return([]*ddl.GpCategory{{Id: 11, Name: "Casual"}}, nil).Times(1)
+
+	categoryID := int64(99)
+	resp, err := GetGameList(context.Background(), &game.GetGameListRequest{Filter: &game.GameListFilter{CategoryID: &categoryID}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "10018", resp.BaseResp.Code)
+}
+
+// TestGetGameList_CursorFirstPage 测试游标模式的第一页：多取一条判断 HasMore，并返回下一页游标
+func TestGetGameList_CursorFirstPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	modifyTs := time.Unix(1700000000, 0)
+	mockedGameList := []*dao.GameWithVersionStatus{
+		{GpGame: ddl.GpGame{Id: 3, GameName: "Game 3", ModifyTs: modifyTs}, Status: int(game.GameStatus_Published)},
+		{GpGame: ddl.GpGame{Id: 2, GameName: "Game 2", ModifyTs: modifyTs}, Status: int(game.GameStatus_Draft)},
+		{GpGame: ddl.GpGame{Id: 1, GameName: "Game 1", ModifyTs: modifyTs}, Status: int(game.GameStatus_Draft)},
+	}
+
+	// 期望：第一页没有游标，且多取一条；未要求总数时不会调用 CountGames
+	mockGameDAO.EXPECT().
+		GetGameListAfter(gomock.Any(), &dao.GameListOptions{}, nil, 3).
+		Return(mockedGameList, nil).
+		Times(1)
+
+	cursor := ""
+	req := &game.GetGameListRequest{
+		PageSize: 2,
+		Cursor:   &cursor,
+	}
+
+	resp, err := GetGameList(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Len(t, resp.GameList, 2)
+	assert.True(t, resp.HasMore)
+	assert.NotEmpty(t, resp.NextCursor)
+	assert.False(t, resp.IsSetTotalCount())
+
+	// 下一页游标指向本页最后一条
+	after, err := service.DecodeGameListCursor(resp.NextCursor, &dao.GameListOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), after.ID)
+	assert.True(t, modifyTs.Equal(after.SortTime))
+}
+
+// TestGetGameList_CursorLastPageWithTotal 测试游标模式的最后一页，并按需返回总数
+func TestGetGameList_CursorLastPageWithTotal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	sortField := game.GameListSortField_GameName
+	sortOrder := game.SortOrder_Asc
+	opts := &dao.GameListOptions{SortField: sortField, SortOrder: sortOrder}
+	cursor := service.EncodeGameListCursor(opts, &dao.GameWithVersionStatus{GpGame: ddl.GpGame{Id: 7, GameName: "Alpha"}})
+
+	mockGameDAO.EXPECT().
+		GetGameListAfter(gomock.Any(), opts, &dao.GameListCursor{SortTime: time.Unix(0, 0), SortName: "Alpha", ID: 7}, 11).
+		Return([]*dao.GameWithVersionStatus{
+			{GpGame: ddl.GpGame{Id: 8, GameName: "Beta"}, Status: int(game.GameStatus_Published)},
+		}, nil).
+		Times(1)
+	mockGameDAO.EXPECT().
+		CountGames(gomock.Any(), opts).
+		Return(int64(8), nil).
+		Times(1)
+
+	needTotal := true
+	req := &game.GetGameListRequest{
+		Sorter:    &game.GameListSorter{SortField: &sortField, SortOrder: &sortOrder},
+		Cursor:    &cursor,
+		NeedTotal: &needTotal,
+	}
+
+	resp, err := GetGameList(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Len(t, resp.GameList, 1)
+	assert.False(t, resp.HasMore)
+	assert.Empty(t, resp.NextCursor)
+	assert.Equal(t, int32(8), resp.GetTotalCount())
+}
+
+// TestGetGameList_InvalidCursor 测试非法游标以及与排序方式不匹配的游标都返回 400
+func TestGetGameList_InvalidCursor(t *testing.T) {
+	sortField := game.GameListSortField_CreateTime
+	malformedCursor := "not-a-cursor"
+	cursorForDefaultSort := service.EncodeGameListCursor(&dao.GameListOptions{}, &dao.GameWithVersionStatus{GpGame: ddl.GpGame{Id: 7}})
+
+	requests := []*game.GetGameListRequest{
+		{Cursor: &malformedCursor},
+		{Cursor: &cursorForDefaultSort, Sorter: &game.GameListSorter{SortField: &sortField}},
+	}
+
+	for _, req := range requests {
+		resp, err := GetGameList(context.Background(), req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+		assert.Equal(t, "400", resp.BaseResp.Code, resp.BaseResp.Msg)
+	}
+}
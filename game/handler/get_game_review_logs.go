@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/service"
+	"gorm.io/gorm"
+)
+
+// GetGameReviewLogs returns the full review history of a game version, most recent first.
+func GetGameReviewLogs(ctx context.Context, req *game.GetGameReviewLogsRequest) (*game.GetGameReviewLogsResponse, error) {
+	// --- 1. 参数校验 ---
+	if req.GameID <= 0 || req.GameVersionID <= 0 {
+		return &game.GetGameReviewLogsResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid GameID or GameVersionID"},
+		}, nil
+	}
+
+	// --- 2. 从 DAO 层获取审核记录 ---
+	reviewLogDdls, err := GameDao.ListGameReviewLogs(ctx, uint64(req.GameID), uint64(req.GameVersionID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &game.GetGameReviewLogsResponse{
+				BaseResp: &common.BaseResp{Code: "10002", Msg: "Game or Version not found"},
+			}, nil
+		}
+		return &game.GetGameReviewLogsResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to get game review logs: " + err.Error()},
+		}, nil
+	}
+
+	// --- 3. 构建并返回成功的响应 ---
+	reviewLogs := make([]*game.GameReviewLog, 0, len(reviewLogDdls))
+	for _, reviewLogDdl := range reviewLogDdls {
+		reviewLogs = append(reviewLogs, service.ConvertDdlToGameReviewLog(reviewLogDdl))
+	}
+
+	return &game.GetGameReviewLogsResponse{
+		ReviewLogs: reviewLogs,
+		BaseResp:   &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
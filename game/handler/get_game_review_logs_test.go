@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// TestGetGameReviewLogs_Success tests that every review of a version is returned, including re-reviews
+func TestGetGameReviewLogs_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	gameID := uint64(101)
+	versionID := uint64(201)
+	mockLogs := []*ddl.GpGameReviewLog{
+		{Id: 2, GameId: gameID, GameVersionId: versionID, ReviewResult: int(game.ReviewResult__Pass), ResultStatus: int(game.GameStatus_Published), Remark: "fixed", Operator: "reviewer_bob", ReviewTime: 1700000200},
+		{Id: 1, GameId: gameID, GameVersionId: versionID, ReviewResult: int(game.ReviewResult__Reject), ResultStatus: int(game.GameStatus_Rejected), Remark: "missing icon", Operator: "reviewer_alice", ReviewTime: 1700000100},
+	}
+
+	mockGameDAO.EXPECT().
+		ListGameReviewLogs(gomock.Any(), gameID, versionID).
+		Return(mockLogs, nil).
+		Times(1)
+
+	req := &game.GetGameReviewLogsRequest{
+		GameID:        int64(gameID),
+		GameVersionID: int64(versionID),
+	}
+
+	resp, err := GetGameReviewLogs(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Len(t, resp.ReviewLogs, 2)
+	assert.Equal(t, game.ReviewResult__Pass, resp.ReviewLogs[0].ReviewResult_)
+	assert.Equal(t, game.GameStatus_Published, resp.ReviewLogs[0].ResultStatus)
+	assert.Equal(t, "reviewer_bob", resp.ReviewLogs[0].ReviewRemark.Operator)
+	assert.Equal(t, "missing icon", resp.ReviewLogs[1].ReviewRemark.Remark)
+	assert.Equal(t, int64(1700000100), resp.ReviewLogs[1].ReviewRemark.ReviewTime)
+}
+
+// TestGetGameReviewLogs_InvalidIDs tests the failure case when GameID or GameVersionID is invalid
+func TestGetGameReviewLogs_InvalidIDs(t *testing.T) {
+	req := &game.GetGameReviewLogsRequest{
+		GameID:        101,
+		GameVersionID: -1,
+	}
+
+	resp, err := GetGameReviewLogs(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+}
+
+// TestGetGameReviewLogs_NotFound tests the scenario where the game or version is not found
+func TestGetGameReviewLogs_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		ListGameReviewLogs(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, gorm.ErrRecordNotFound).
+		Times(1)
+
+	req := &game.GetGameReviewLogsRequest{
+		GameID:        999,
+		GameVersionID: 9999,
+	}
+
+	resp, err := GetGameReviewLogs(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10002", resp.BaseResp.Code)
+}
+
+// TestGetGameReviewLogs_DaoError tests the scenario where the DAO returns a general error
+func TestGetGameReviewLogs_DaoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		ListGameReviewLogs(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("database connection error")).
+		Times(1)
+
+	req := &game.GetGameReviewLogsRequest{
+		GameID:        101,
+		GameVersionID: 201,
+	}
+
+	resp, err := GetGameReviewLogs(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "500", resp.BaseResp.Code)
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/service"
+)
+
+// GetGameRollout returns the staged rollout in progress on a game together with the history of its rollouts.
+func GetGameRollout(ctx context.Context, req *game.GetGameRolloutRequest) (*game.GetGameRolloutResponse, error) {
+	// --- 1. 参数校验 ---
+	if req.GameID <= 0 {
+		return &game.GetGameRolloutResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid GameID"},
+		}, nil
+	}
+
+	// 只有游戏所属的 CP 可以查看灰度记录
+	if baseResp := authorizeGameOwner(ctx, uint64(req.GameID), 0); baseResp != nil {
+		return &game.GetGameRolloutResponse{BaseResp: baseResp}, nil
+	}
+
+	// --- 2. 读取灰度状态与操作记录 ---
+	gameDdl, err := GameDao.GetGame(ctx, uint64(req.GameID))
+	if err != nil {
+		return &game.GetGameRolloutResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to get game: " + err.Error()},
+		}, nil
+	}
+	rolloutLogDdls, err := GameDao.ListGameRolloutLogs(ctx, uint64(req.GameID))
+	if err != nil {
+		return &game.GetGameRolloutResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to list rollout logs: " + err.Error()},
+		}, nil
+	}
+
+	// --- 3. 构建并返回成功的响应 ---
+	rolloutLogs := make([]*game.RolloutLog, 0, len(rolloutLogDdls))
+	for _, rolloutLogDdl := range rolloutLogDdls {
+		rolloutLogs = append(rolloutLogs, service.ConvertDdlToRolloutLog(rolloutLogDdl))
+	}
+	return &game.GetGameRolloutResponse{
+		Rollout:     service.ConvertDdlToGameRollout(gameDdl),
+		RolloutLogs: rolloutLogs,
+		BaseResp:    &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
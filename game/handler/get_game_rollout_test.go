@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGetGameRollout_Success tests that the rollout in progress and its history are returned
+func TestGetGameRollout_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	gameID := uint64(101)
+	createTs := time.Unix(1700000000, 0)
+
+	mockGameDAO.EXPECT().
+		GetGame(gomock.Any(), gameID).
+		Return(&ddl.GpGame{
+			Id:                  gameID,
+			CpId:                testCpID,
+			OnlineGameVersionId: 200,
+			RolloutVersionId:    201,
+			RolloutPercentage:   10,
+			RolloutState:        int(game.RolloutState_Paused),
+		}, nil).
+		Times(1)
+	mockGameDAO.EXPECT().
+		ListGameRolloutLogs(gomock.Any(), gameID).
+		Return([]*ddl.GpGameRolloutLog{
+			{Id: 2, GameId: gameID, GameVersionId: 201, Action: int(game.RolloutAction_Pause), FromPercentage: 10, ToPercentage: 10, Operator: "ops_alice", Reason: "crash spike", CreateTs: createTs},
+			{Id: 1, GameId: gameID, GameVersionId: 201, Action: int(game.RolloutAction_Start), ToPercentage: 10, Operator: "reviewer_bob", CreateTs: createTs},
+		}, nil).
+		Times(1)
+
+	resp, err := GetGameRollout(ownerContext(), &game.GetGameRolloutRequest{GameID: int64(gameID)})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Equal(t, game.RolloutState_Paused, resp.Rollout.State)
+	assert.Equal(t, int64(200), resp.Rollout.BaseGameVersionID)
+	assert.Len(t, resp.RolloutLogs, 2)
+	assert.Equal(t, game.RolloutAction_Pause, resp.RolloutLogs[0].Action)
+	assert.Equal(t, "crash spike", resp.RolloutLogs[0].Reason)
+	assert.Equal(t, createTs.Unix(), resp.RolloutLogs[0].CreateTime)
+}
+
+// TestGetGameRollout_NoRollout tests a game without a rollout in progress
+func TestGetGameRollout_NoRollout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().GetGame(gomock.Any(), uint64(101)).Return(&ddl.GpGame{Id: 101, OnlineGameVersionId: 200}, nil).Times(1)
+	mockGameDAO.EXPECT().ListGameRolloutLogs(gomock.Any(), uint64(101)).Return(nil, nil).Times(1)
+
+	resp, err := GetGameRollout(ownerContext(), &game.GetGameRolloutRequest{GameID: 101})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Nil(t, resp.Rollout)
+	assert.Empty(t, resp.RolloutLogs)
+}
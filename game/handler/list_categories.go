@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/service"
+)
+
+// ListCategories returns the whole category tree as a flat list; ParentID links the levels.
+func ListCategories(ctx context.Context, req *game.ListCategoriesRequest) (*game.ListCategoriesResponse, error) {
+	categoryDdls, err := GameDao.ListCategories(ctx)
+	if err != nil {
+		return &game.ListCategoriesResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to list categories: " + err.Error()},
+		}, nil
+	}
+
+	categories := make([]*game.Category, 0, len(categoryDdls))
+	for _, categoryDdl := range categoryDdls {
+		categories = append(categories, service.ConvertDdlToCategory(categoryDdl))
+	}
+
+	return &game.ListCategoriesResponse{
+		Categories: categories,
+		BaseResp:   &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
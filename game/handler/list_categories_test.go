@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestListCategories_Success tests that every category is returned in the DAO order
+func TestListCategories_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		ListCategories(gomock.Any()).
+		Return([]*ddl.GpCategory{
+			{Id: 11, Name: "Casual"},
+			{Id: 12, ParentId: 11, Name: "Puzzle", SortOrder: 1},
+		}, nil).
+		Times(1)
+
+	resp, err := ListCategories(context.Background(), &game.ListCategoriesRequest{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Len(t, resp.Categories, 2)
+	assert.Equal(t, int64(11), resp.Categories[1].ParentID)
+	assert.Equal(t, "Puzzle", resp.Categories[1].Name)
+	assert.Equal(t, int32(1), resp.Categories[1].SortOrder)
+}
+
+// TestListCategories_DaoError tests the scenario where the DAO returns a general error
+func TestListCategories_DaoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().ListCategories(gomock.Any()).Return(nil, errors.New("database connection error")).Times(1)
+
+	resp, err := ListCategories(context.Background(), &game.ListCategoriesRequest{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "500", resp.BaseResp.Code)
+}
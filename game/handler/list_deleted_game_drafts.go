@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GameLaunchPad/game_management_project/game/config"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/service"
+	"gorm.io/gorm"
+)
+
+// ListDeletedGameDrafts returns the trash of a game: the deleted drafts that can still be restored.
+func ListDeletedGameDrafts(ctx context.Context, req *game.ListDeletedGameDraftsRequest) (*game.ListDeletedGameDraftsResponse, error) {
+	// parameter validation
+	if req.GameID <= 0 {
+		return &game.ListDeletedGameDraftsResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid GameID"},
+		}, nil
+	}
+
+	// drafts deleted before the retention window are expired and no longer listed
+	retention := config.TrashRetention()
+	deletedAfter := time.Now().Add(-retention).Unix()
+
+	// get deleted drafts from DAO
+	versionDdls, err := GameDao.ListDeletedGameDrafts(ctx, uint64(req.GameID), deletedAfter)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &game.ListDeletedGameDraftsResponse{
+				BaseResp: &common.BaseResp{Code: "10001", Msg: "Game not found"},
+			}, nil
+		}
+		return &game.ListDeletedGameDraftsResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to list deleted game drafts: " + err.Error()},
+		}, nil
+	}
+
+	// transform to response format
+	deletedDrafts := make([]*game.DeletedGameDraft, 0, len(versionDdls))
+	for _, versionDdl := range versionDdls {
+		deletedDraft, err := service.ConvertDdlToDeletedGameDraft(versionDdl, retention)
+		if err != nil {
+			return &game.ListDeletedGameDraftsResponse{
+				BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to convert game version data: " + err.Error()},
+			}, nil
+		}
+		deletedDrafts = append(deletedDrafts, deletedDraft)
+	}
+
+	return &game.ListDeletedGameDraftsResponse{
+		DeletedDrafts: deletedDrafts,
+		BaseResp:      &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
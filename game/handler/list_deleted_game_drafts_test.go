@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GameLaunchPad/game_management_project/game/config"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// TestListDeletedGameDrafts_Success tests listing the trash with the expiry of every draft
+func TestListDeletedGameDrafts_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	gameID := uint64(101)
+	deleteTime := time.Now().Add(-time.Hour).Unix()
+	mockVersions := []*ddl.GpGameVersion{
+		{Id: 202, GameId: gameID, GameName: "Draft 2", Status: int(game.GameStatus_Deleted), DeleteTime: deleteTime, Platform: "[]", GameIntroductionImages: "[]"},
+	}
+
+	retention := config.TrashRetention()
+	mockGameDAO.EXPECT().
+		ListDeletedGameDrafts(gomock.Any(), gameID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uint64, deletedAfter int64) ([]*ddl.GpGameVersion, error) {
+			// the retention window ends now, give or take the time the test takes
+			assert.InDelta(t, time.Now().Add(-retention).Unix(), deletedAfter, 5)
+			return mockVersions, nil
+		}).
+		Times(1)
+
+	resp, err := ListDeletedGameDrafts(context.Background(), &game.ListDeletedGameDraftsRequest{GameID: int64(gameID)})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Len(t, resp.DeletedDrafts, 1)
+	assert.Equal(t, int64(202), resp.DeletedDrafts[0].GameVersion.GamVersionID)
+	assert.Equal(t, game.GameStatus_Deleted, resp.DeletedDrafts[0].GameVersion.GameStatus)
+	assert.Equal(t, deleteTime, resp.DeletedDrafts[0].DeleteTime)
+	assert.Equal(t, deleteTime+int64(retention/time.Second), resp.DeletedDrafts[0].ExpireTime)
+}
+
+// TestListDeletedGameDrafts_InvalidGameID tests the failure case when GameID is invalid
+func TestListDeletedGameDrafts_InvalidGameID(t *testing.T) {
+	resp, err := ListDeletedGameDrafts(context.Background(), &game.ListDeletedGameDraftsRequest{GameID: 0})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+}
+
+// TestListDeletedGameDrafts_GameNotFound tests the scenario where the game does not exist
+func TestListDeletedGameDrafts_GameNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		ListDeletedGameDrafts(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, gorm.ErrRecordNotFound).
+		Times(1)
+
+	resp, err := ListDeletedGameDrafts(context.Background(), &game.ListDeletedGameDraftsRequest{GameID: 999})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10001", resp.BaseResp.Code)
+}
+
+// TestListDeletedGameDrafts_DaoError tests the scenario where the DAO returns a general error
+func TestListDeletedGameDrafts_DaoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		ListDeletedGameDrafts(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("database connection error")).
+		Times(1)
+
+	resp, err := ListDeletedGameDrafts(context.Background(), &game.ListDeletedGameDraftsRequest{GameID: 101})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "500", resp.BaseResp.Code)
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/service"
+	"gorm.io/gorm"
+)
+
+// ListGameVersions returns the full version history of a game, newest first.
+func ListGameVersions(ctx context.Context, req *game.ListGameVersionsRequest) (*game.ListGameVersionsResponse, error) {
+	// parameter validation
+	if req.GameID <= 0 {
+		return &game.ListGameVersionsResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid GameID"},
+		}, nil
+	}
+
+	statuses := make([]int, 0, len(req.StatusFilter))
+	for _, status := range req.StatusFilter {
+		if status == game.GameStatus_Unset {
+			return &game.ListGameVersionsResponse{
+				BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid status filter: " + status.String()},
+			}, nil
+		}
+		statuses = append(statuses, int(status))
+	}
+
+	pageNum := int(req.PageNum)
+	if pageNum <= 0 {
+		pageNum = 1
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	// get version history from DAO
+	versionDdls, total, err := GameDao.ListGameVersions(ctx, uint64(req.GameID), statuses, pageNum, pageSize)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &game.ListGameVersionsResponse{
+				BaseResp: &common.BaseResp{Code: "10001", Msg: "Game not found"},
+			}, nil
+		}
+		return &game.ListGameVersionsResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to list game versions: " + err.Error()},
+		}, nil
+	}
+
+	// transform to response format
+	versions, err := service.ConvertDdlToGameVersionList(versionDdls)
+	if err != nil {
+		return &game.ListGameVersionsResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to convert game version data: " + err.Error()},
+		}, nil
+	}
+
+	return &game.ListGameVersionsResponse{
+		GameVersions: versions,
+		TotalCount:   int32(total),
+		BaseResp:     &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// TestListGameVersions_Success tests the successful retrieval of a game's version history
+func TestListGameVersions_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	gameID := uint64(123)
+	mockVersions := []*ddl.GpGameVersion{
+		{Id: 202, GameId: gameID, GameName: "Version 3", Status: int(game.GameStatus_Draft), Platform: "[1]", GameIntroductionImages: "[]"},
+		{Id: 201, GameId: gameID, GameName: "Version 2", Status: int(game.GameStatus_Rejected)},
+		{Id: 200, GameId: gameID, GameName: "Version 1", Status: int(game.GameStatus_Published)},
+	}
+
+	mockGameDAO.EXPECT().
+		ListGameVersions(gomock.Any(), gameID, []int{}, 1, 10).
+		Return(mockVersions, int64(3), nil).
+		Times(1)
+
+	req := &game.ListGameVersionsRequest{
+		GameID:   int64(gameID),
+		PageNum:  1,
+		PageSize: 10,
+	}
+
+	resp, err := ListGameVersions(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Equal(t, int32(3), resp.TotalCount)
+	assert.Len(t, resp.GameVersions, 3)
+	assert.Equal(t, int64(202), resp.GameVersions[0].GamVersionID)
+	assert.Equal(t, []game.GamePlatform{game.GamePlatform_Android}, resp.GameVersions[0].GamePlatforms)
+	assert.Equal(t, game.GameStatus_Published, resp.GameVersions[2].GameStatus)
+}
+
+// TestListGameVersions_Media tests that a stored gallery is returned in position order and that the introduction images of a version written before the gallery existed load as screenshots
+func TestListGameVersions_Media(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	gameID := uint64(123)
+	mockVersions := []*ddl.GpGameVersion{
+		{
+			Id: 201, GameId: gameID, GameIntroductionImages: `["b.png"]`,
+			Media: `[{"type":1,"uri":"b.png","position":4},{"type":3,"uri":"a.png","caption":"Launch art","position":1}]`,
+		},
+		{Id: 200, GameId: gameID, GameIntroductionImages: `["1.png","2.png"]`},
+	}
+
+	mockGameDAO.EXPECT().
+		ListGameVersions(gomock.Any(), gameID, []int{}, 1, 10).
+		Return(mockVersions, int64(2), nil).
+		Times(1)
+
+	resp, err := ListGameVersions(context.Background(), &game.ListGameVersionsRequest{GameID: int64(gameID)})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Equal(t, []*game.MediaItem{
+		{Type: game.MediaType_PromoArt, URI: "a.png", Caption: "Launch art", Position: 1},
+		{Type: game.MediaType_Screenshot, URI: "b.png", Position: 4},
+	}, resp.GameVersions[0].Media)
+	assert.Equal(t, []*game.MediaItem{
+		{Type: game.MediaType_Screenshot, URI: "1.png", Position: 0},
+		{Type: game.MediaType_Screenshot, URI: "2.png", Position: 1},
+	}, resp.GameVersions[1].Media)
+	assert.Equal(t, []string{"1.png", "2.png"}, resp.GameVersions[1].GameIntroductionImages)
+}
+
+// TestListGameVersions_WithStatusFilter tests that the status filter and default paging are passed to the DAO
+func TestListGameVersions_WithStatusFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	gameID := uint64(124)
+	expectedStatuses := []int{int(game.GameStatus_Published), int(game.GameStatus_Rejected)}
+
+	mockGameDAO.EXPECT().
+		ListGameVersions(gomock.Any(), gameID, expectedStatuses, 1, 10).
+		Return([]*ddl.GpGameVersion{}, int64(0), nil).
+		Times(1)
+
+	req := &game.ListGameVersionsRequest{
+		GameID:       int64(gameID),
+		StatusFilter: []game.GameStatus{game.GameStatus_Published, game.GameStatus_Rejected},
+	}
+
+	resp, err := ListGameVersions(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Empty(t, resp.GameVersions)
+}
+
+// TestListGameVersions_InvalidStatusFilter tests that an Unset status in the filter is rejected
+func TestListGameVersions_InvalidStatusFilter(t *testing.T) {
+	req := &game.ListGameVersionsRequest{
+		GameID:       125,
+		StatusFilter: []game.GameStatus{game.GameStatus_Unset},
+	}
+
+	resp, err := ListGameVersions(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+	assert.Contains(t, resp.BaseResp.Msg, "Invalid status filter")
+}
+
+// TestListGameVersions_InvalidGameID tests the failure case when GameID is 0 or negative
+func TestListGameVersions_InvalidGameID(t *testing.T) {
+	req := &game.ListGameVersionsRequest{
+		GameID: 0,
+	}
+
+	resp, err := ListGameVersions(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+	assert.Equal(t, "Invalid GameID", resp.BaseResp.Msg)
+}
+
+// TestListGameVersions_GameNotFound tests the scenario where the game does not exist
+func TestListGameVersions_GameNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		ListGameVersions(gomock.Any(), uint64(999), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, int64(0), gorm.ErrRecordNotFound).
+		Times(1)
+
+	req := &game.ListGameVersionsRequest{
+		GameID: 999,
+	}
+
+	resp, err := ListGameVersions(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10001", resp.BaseResp.Code)
+	assert.Equal(t, "Game not found", resp.BaseResp.Msg)
+}
+
+// TestListGameVersions_DaoError tests the scenario where the DAO returns a general error
+func TestListGameVersions_DaoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		ListGameVersions(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, int64(0), errors.New("database connection lost")).
+		Times(1)
+
+	req := &game.ListGameVersionsRequest{
+		GameID: 126,
+	}
+
+	resp, err := ListGameVersions(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "500", resp.BaseResp.Code)
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/service"
+)
+
+// ListTags returns every tag, ordered by name.
+func ListTags(ctx context.Context, req *game.ListTagsRequest) (*game.ListTagsResponse, error) {
+	tagDdls, err := GameDao.ListTags(ctx)
+	if err != nil {
+		return &game.ListTagsResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to list tags: " + err.Error()},
+		}, nil
+	}
+
+	tags := make([]*game.Tag, 0, len(tagDdls))
+	for _, tagDdl := range tagDdls {
+		tags = append(tags, service.ConvertDdlToTag(tagDdl))
+	}
+
+	return &game.ListTagsResponse{
+		Tags:     tags,
+		BaseResp: &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
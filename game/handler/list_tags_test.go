@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestListTags_Success tests that every tag is returned
+func TestListTags_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		ListTags(gomock.Any()).
+		Return([]*ddl.GpTag{{Id: 21, Name: "Co-op"}, {Id: 22, Name: "Multiplayer"}}, nil).
+		Times(1)
+
+	resp, err := ListTags(context.Background(), &game.ListTagsRequest{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Len(t, resp.Tags, 2)
+	assert.Equal(t, int64(22), resp.Tags[1].TagID)
+	assert.Equal(t, "Multiplayer", resp.Tags[1].Name)
+}
+
+// TestListTags_DaoError tests the scenario where the DAO returns a general error
+func TestListTags_DaoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().ListTags(gomock.Any()).Return(nil, errors.New("database connection error")).Times(1)
+
+	resp, err := ListTags(context.Background(), &game.ListTagsRequest{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "500", resp.BaseResp.Code)
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/yitter/idgenerator-go/idgen"
+	"gorm.io/gorm"
+)
+
+// ReleasePackageName lets an admin free a package name held by a game, or hand it to another game.
+func ReleasePackageName(ctx context.Context, req *game.ReleasePackageNameRequest) (*game.ReleasePackageNameResponse, error) {
+	// --- 1. 参数校验 ---
+	if req.Platform != game.GamePlatform_Android && req.Platform != game.GamePlatform_IOS {
+		return &game.ReleasePackageNameResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Platform must be Android or IOS"},
+		}, nil
+	}
+	if strings.TrimSpace(req.PackageName) == "" {
+		return &game.ReleasePackageNameResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "PackageName is required"},
+		}, nil
+	}
+	if req.IsSetTransferToGameID() && req.GetTransferToGameID() <= 0 {
+		return &game.ReleasePackageNameResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid TransferToGameID"},
+		}, nil
+	}
+	if strings.TrimSpace(req.Reason) == "" || strings.TrimSpace(req.Operator) == "" {
+		return &game.ReleasePackageNameResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Reason and Operator are required to release a package name"},
+		}, nil
+	}
+
+	if baseResp := authorizeAdmin(ctx); baseResp != nil {
+		return &game.ReleasePackageNameResponse{BaseResp: baseResp}, nil
+	}
+
+	operationLog := &ddl.GpGameOperationLog{
+		Id:       uint64(idgen.NextId()),
+		Operator: req.Operator,
+		Reason:   req.Reason,
+	}
+
+	// --- 2. 调用 DAO 层释放或转移包名占用 ---
+	previousGameID, err := GameDao.ReleasePackageName(ctx, int(req.Platform), req.PackageName, uint64(req.GetTransferToGameID()), operationLog)
+	if err != nil {
+		// the game the claim should go to does not exist
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &game.ReleasePackageNameResponse{
+				BaseResp: &common.BaseResp{Code: "10001", Msg: "Game not found"},
+			}, nil
+		}
+		if errors.Is(err, dao.ErrPackageNameNotClaimed) {
+			return &game.ReleasePackageNameResponse{
+				BaseResp: &common.BaseResp{Code: "10017", Msg: err.Error()},
+			}, nil
+		}
+		return &game.ReleasePackageNameResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to release package name: " + err.Error()},
+		}, nil
+	}
+
+	// --- 3. 构建并返回成功的响应 ---
+	return &game.ReleasePackageNameResponse{
+		PreviousGameID: int64(previousGameID),
+		BaseResp:       &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// TestReleasePackageName_Release tests that a package name is released and the previous holder returned
+func TestReleasePackageName_Release(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		ReleasePackageName(gomock.Any(), int(game.GamePlatform_Android), "com.happy.elimination", uint64(0), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int, _ string, _ uint64, operationLog *ddl.GpGameOperationLog) (uint64, error) {
+			assert.NotZero(t, operationLog.Id)
+			assert.Equal(t, "ops_alice", operationLog.Operator)
+			assert.Equal(t, "trademark dispute", operationLog.Reason)
+			return 101, nil
+		}).
+		Times(1)
+
+	req := &game.ReleasePackageNameRequest{
+		Platform:    game.GamePlatform_Android,
+		PackageName: "com.happy.elimination",
+		Reason:      "trademark dispute",
+		Operator:    "ops_alice",
+	}
+
+	resp, err := ReleasePackageName(adminContext(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Equal(t, int64(101), resp.PreviousGameID)
+}
+
+// TestReleasePackageName_Transfer tests that a package name is handed to another game
+func TestReleasePackageName_Transfer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		ReleasePackageName(gomock.Any(), int(game.GamePlatform_IOS), "com.happy.elimination", uint64(102), gomock.Any()).
+		Return(uint64(101), nil).
+		Times(1)
+
+	transferTo := int64(102)
+	req := &game.ReleasePackageNameRequest{
+		Platform:         game.GamePlatform_IOS,
+		PackageName:      "com.happy.elimination",
+		TransferToGameID: &transferTo,
+		Reason:           "rightful owner",
+		Operator:         "ops_alice",
+	}
+
+	resp, err := ReleasePackageName(adminContext(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Equal(t, int64(101), resp.PreviousGameID)
+}
+
+// TestReleasePackageName_InvalidParams tests that malformed requests are rejected before the DAO is called
+func TestReleasePackageName_InvalidParams(t *testing.T) {
+	zero := int64(0)
+	tests := []struct {
+		name string
+		req  *game.ReleasePackageNameRequest
+	}{
+		{"web has no package", &game.ReleasePackageNameRequest{Platform: game.GamePlatform_Web, PackageName: "com.a.b", Reason: "r", Operator: "o"}},
+		{"blank package name", &game.ReleasePackageNameRequest{Platform: game.GamePlatform_Android, PackageName: " ", Reason: "r", Operator: "o"}},
+		{"invalid transfer target", &game.ReleasePackageNameRequest{Platform: game.GamePlatform_Android, PackageName: "com.a.b", TransferToGameID: &zero, Reason: "r", Operator: "o"}},
+		{"missing reason", &game.ReleasePackageNameRequest{Platform: game.GamePlatform_Android, PackageName: "com.a.b", Operator: "o"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := ReleasePackageName(context.Background(), tt.req)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "400", resp.BaseResp.Code)
+		})
+	}
+}
+
+// TestReleasePackageName_NotClaimed tests releasing a package name no game holds
+func TestReleasePackageName_NotClaimed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		ReleasePackageName(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(uint64(0), dao.ErrPackageNameNotClaimed).
+		Times(1)
+
+	req := &game.ReleasePackageNameRequest{
+		Platform:    game.GamePlatform_Android,
+		PackageName: "com.happy.elimination",
+		Reason:      "cleanup",
+		Operator:    "ops_alice",
+	}
+
+	resp, err := ReleasePackageName(adminContext(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "10017", resp.BaseResp.Code)
+}
+
+// TestReleasePackageName_TargetNotFound tests transferring a package name to a game that does not exist
+func TestReleasePackageName_TargetNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		ReleasePackageName(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(uint64(0), gorm.ErrRecordNotFound).
+		Times(1)
+
+	transferTo := int64(999)
+	req := &game.ReleasePackageNameRequest{
+		Platform:         game.GamePlatform_Android,
+		PackageName:      "com.happy.elimination",
+		TransferToGameID: &transferTo,
+		Reason:           "rightful owner",
+		Operator:         "ops_alice",
+	}
+
+	resp, err := ReleasePackageName(adminContext(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "10001", resp.BaseResp.Code)
+}
+
+// TestReleasePackageName_NotAdmin tests that a CP, or an anonymous caller, cannot release or take a package name
+func TestReleasePackageName_NotAdmin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	transferTo := int64(102)
+	req := &game.ReleasePackageNameRequest{
+		Platform:         game.GamePlatform_Android,
+		PackageName:      "com.happy.elimination",
+		TransferToGameID: &transferTo,
+		Reason:           "it is mine",
+		Operator:         "cp_mallory",
+	}
+
+	for _, ctx := range []context.Context{ownerContext(), context.Background()} {
+		resp, err := ReleasePackageName(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "10015", resp.BaseResp.Code)
+	}
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/rollout"
+	"gorm.io/gorm"
+)
+
+// maxPlayerIDLength bounds the player IDs that are hashed into rollout buckets.
+const maxPlayerIDLength = 128
+
+// ResolveServedVersion returns the version of a game a player should get, which is the rolling-out version
+// for the players its rollout reaches and the online version for everyone else.
+func ResolveServedVersion(ctx context.Context, req *game.ResolveServedVersionRequest) (*game.ResolveServedVersionResponse, error) {
+	// --- 1. 参数校验 ---
+	if req.GameID <= 0 {
+		return &game.ResolveServedVersionResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid GameID"},
+		}, nil
+	}
+	if strings.TrimSpace(req.PlayerID) == "" || len(req.PlayerID) > maxPlayerIDLength {
+		return &game.ResolveServedVersionResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid PlayerID"},
+		}, nil
+	}
+
+	// --- 2. 读取游戏的上线版本与灰度状态 ---
+	gameDdl, err := GameDao.GetGame(ctx, uint64(req.GameID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &game.ResolveServedVersionResponse{
+				BaseResp: &common.BaseResp{Code: "10001", Msg: "Game not found"},
+			}, nil
+		}
+		return &game.ResolveServedVersionResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to get game: " + err.Error()},
+		}, nil
+	}
+
+	// --- 3. 按玩家分桶决定下发的版本 ---
+	versionID, inRollout := rollout.ServedVersion(gameDdl, req.PlayerID)
+	if versionID == 0 {
+		return &game.ResolveServedVersionResponse{
+			BaseResp: &common.BaseResp{Code: "10009", Msg: "The game has no online version"},
+		}, nil
+	}
+	return &game.ResolveServedVersionResponse{
+		GameVersionID: int64(versionID),
+		InRollout:     inRollout,
+		BaseResp:      &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/rollout"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// TestResolveServedVersion_Rollout tests that players inside and outside an active rollout get different versions
+func TestResolveServedVersion_Rollout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	gameRecord := &ddl.GpGame{
+		Id:                  101,
+		OnlineGameVersionId: 200,
+		RolloutVersionId:    201,
+		RolloutPercentage:   25,
+		RolloutState:        int(game.RolloutState_Active),
+	}
+	mockGameDAO.EXPECT().GetGame(gomock.Any(), uint64(101)).Return(gameRecord, nil).Times(40)
+
+	for i := 0; i < 40; i++ {
+		playerID := fmt.Sprintf("player-%d", i)
+		resp, err := ResolveServedVersion(context.Background(), &game.ResolveServedVersionRequest{GameID: 101, PlayerID: playerID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "200", resp.BaseResp.Code)
+		if rollout.Includes(101, 201, 25, playerID) {
+			assert.Equal(t, int64(201), resp.GameVersionID)
+			assert.True(t, resp.InRollout)
+		} else {
+			assert.Equal(t, int64(200), resp.GameVersionID)
+			assert.False(t, resp.InRollout)
+		}
+	}
+}
+
+// TestResolveServedVersion_NotOnline tests a game that has no version to serve
+func TestResolveServedVersion_NotOnline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().GetGame(gomock.Any(), uint64(101)).Return(&ddl.GpGame{Id: 101}, nil).Times(1)
+
+	resp, err := ResolveServedVersion(context.Background(), &game.ResolveServedVersionRequest{GameID: 101, PlayerID: "player-1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "10009", resp.BaseResp.Code)
+}
+
+// TestResolveServedVersion_NotFound tests an unknown game
+func TestResolveServedVersion_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().GetGame(gomock.Any(), uint64(999)).Return(nil, gorm.ErrRecordNotFound).Times(1)
+
+	resp, err := ResolveServedVersion(context.Background(), &game.ResolveServedVersionRequest{GameID: 999, PlayerID: "player-1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "10001", resp.BaseResp.Code)
+}
+
+// TestResolveServedVersion_InvalidPlayerID tests that empty and oversized player IDs are refused
+func TestResolveServedVersion_InvalidPlayerID(t *testing.T) {
+	for _, playerID := range []string{"", "  ", strings.Repeat("p", maxPlayerIDLength+1)} {
+		resp, err := ResolveServedVersion(context.Background(), &game.ResolveServedVersionRequest{GameID: 101, PlayerID: playerID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "400", resp.BaseResp.Code)
+		assert.Contains(t, resp.BaseResp.Msg, "Invalid PlayerID")
+	}
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/GameLaunchPad/game_management_project/game/constdef"
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/yitter/idgenerator-go/idgen"
+	"gorm.io/gorm"
+)
+
+// RestoreGame lifts a takedown; the taken-down version goes back to review before it can be republished.
+func RestoreGame(ctx context.Context, req *game.RestoreGameRequest) (*game.RestoreGameResponse, error) {
+	// --- 1. 参数校验 ---
+	if req.GameID <= 0 {
+		return &game.RestoreGameResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid GameID"},
+		}, nil
+	}
+	if strings.TrimSpace(req.Reason) == "" || strings.TrimSpace(req.Operator) == "" {
+		return &game.RestoreGameResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Reason and Operator are required for a restore"},
+		}, nil
+	}
+
+	// 只有游戏所属的 CP 可以操作该游戏
+	if baseResp := authorizeGameOwner(ctx, uint64(req.GameID), 0); baseResp != nil {
+		return &game.RestoreGameResponse{BaseResp: baseResp}, nil
+	}
+
+	operationLog := &ddl.GpGameOperationLog{
+		Id:            uint64(idgen.NextId()),
+		OperationType: constdef.GameOperationRestore,
+		Operator:      req.Operator,
+		Reason:        req.Reason,
+	}
+
+	// --- 2. 调用 DAO 层恢复游戏，版本重新进入审核 ---
+	err := GameDao.RestoreGame(ctx, uint64(req.GameID), operationLog)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &game.RestoreGameResponse{
+				BaseResp: &common.BaseResp{Code: "10001", Msg: "Game not found"},
+			}, nil
+		}
+		if errors.Is(err, dao.ErrGameNotTakenDown) {
+			return &game.RestoreGameResponse{
+				BaseResp: &common.BaseResp{Code: "10010", Msg: err.Error()},
+			}, nil
+		}
+		if errors.Is(err, dao.ErrIllegalStatusTransition) {
+			return &game.RestoreGameResponse{
+				BaseResp: &common.BaseResp{Code: "10007", Msg: err.Error()},
+			}, nil
+		}
+		// another game already uses one of the package names
+		if errors.Is(err, dao.ErrPackageNameConflict) {
+			return &game.RestoreGameResponse{
+				BaseResp: &common.BaseResp{Code: "10016", Msg: err.Error()},
+			}, nil
+		}
+		return &game.RestoreGameResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to restore game: " + err.Error()},
+		}, nil
+	}
+
+	RefreshSearchIndex(ctx, uint64(req.GameID))
+
+	// --- 3. 构建并返回成功的响应 ---
+	return &game.RestoreGameResponse{
+		BaseResp: &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
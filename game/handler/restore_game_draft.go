@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GameLaunchPad/game_management_project/game/config"
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"gorm.io/gorm"
+)
+
+// RestoreGameDraft takes a deleted draft out of the trash and makes it the newest version again.
+func RestoreGameDraft(ctx context.Context, req *game.RestoreGameDraftRequest) (*game.RestoreGameDraftResponse, error) {
+	// --- 1. 参数校验 ---
+	if req.GameID <= 0 || req.GameVersionID <= 0 {
+		return &game.RestoreGameDraftResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid GameID or GameVersionID"},
+		}, nil
+	}
+
+	// 只有游戏所属的 CP 可以操作该游戏
+	if baseResp := authorizeGameOwner(ctx, uint64(req.GameID), 0); baseResp != nil {
+		return &game.RestoreGameDraftResponse{BaseResp: baseResp}, nil
+	}
+
+	deletedAfter := time.Now().Add(-config.TrashRetention()).Unix()
+
+	// --- 2. 调用 DAO 层恢复草稿 ---
+	version, err := GameDao.RestoreGameDraft(ctx, uint64(req.GameID), uint64(req.GameVersionID), deletedAfter)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &game.RestoreGameDraftResponse{
+				BaseResp: &common.BaseResp{Code: "10002", Msg: "Game or Version not found"},
+			}, nil
+		}
+		if errors.Is(err, dao.ErrVersionNotDeleted) {
+			return &game.RestoreGameDraftResponse{
+				BaseResp: &common.BaseResp{Code: "10012", Msg: err.Error()},
+			}, nil
+		}
+		if errors.Is(err, dao.ErrDraftExpired) {
+			return &game.RestoreGameDraftResponse{
+				BaseResp: &common.BaseResp{Code: "10013", Msg: err.Error()},
+			}, nil
+		}
+		if errors.Is(err, dao.ErrDraftSuperseded) {
+			return &game.RestoreGameDraftResponse{
+				BaseResp: &common.BaseResp{Code: "10014", Msg: err.Error()},
+			}, nil
+		}
+		if errors.Is(err, dao.ErrIllegalStatusTransition) {
+			return &game.RestoreGameDraftResponse{
+				BaseResp: &common.BaseResp{Code: "10007", Msg: err.Error()},
+			}, nil
+		}
+		return &game.RestoreGameDraftResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to restore game draft: " + err.Error()},
+		}, nil
+	}
+
+	RefreshSearchIndex(ctx, uint64(req.GameID))
+
+	// --- 3. 构建并返回成功的响应 ---
+	return &game.RestoreGameDraftResponse{
+		Revision: version.Revision,
+		BaseResp: &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
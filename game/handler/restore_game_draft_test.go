@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// TestRestoreGameDraft_Success tests restoring a deleted draft and returning its new revision
+func TestRestoreGameDraft_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		RestoreGameDraft(gomock.Any(), uint64(101), uint64(202), gomock.Any()).
+		Return(&ddl.GpGameVersion{Id: 202, GameId: 101, Status: int(game.GameStatus_Draft), Revision: 5}, nil).
+		Times(1)
+
+	req := &game.RestoreGameDraftRequest{
+		GameID:        101,
+		GameVersionID: 202,
+	}
+
+	resp, err := RestoreGameDraft(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Equal(t, int64(5), resp.Revision)
+}
+
+// TestRestoreGameDraft_InvalidIDs tests the failure case when GameID or GameVersionID is invalid
+func TestRestoreGameDraft_InvalidIDs(t *testing.T) {
+	req := &game.RestoreGameDraftRequest{
+		GameID:        101,
+		GameVersionID: 0,
+	}
+
+	resp, err := RestoreGameDraft(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+}
+
+// TestRestoreGameDraft_BusinessErrors tests the error codes of drafts that cannot be restored
+func TestRestoreGameDraft_BusinessErrors(t *testing.T) {
+	cases := []struct {
+		err  error
+		code string
+	}{
+		{gorm.ErrRecordNotFound, "10002"},
+		{dao.ErrVersionNotDeleted, "10012"},
+		{dao.ErrDraftExpired, "10013"},
+		{dao.ErrDraftSuperseded, "10014"},
+		{errors.New("database connection error"), "500"},
+	}
+
+	for _, c := range cases {
+		ctrl := gomock.NewController(t)
+		mockGameDAO := mock.NewMockIGameDAO(ctrl)
+		GameDao = mockGameDAO
+		expectGameOwner(mockGameDAO)
+
+		mockGameDAO.EXPECT().
+			RestoreGameDraft(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, c.err).
+			Times(1)
+
+		req := &game.RestoreGameDraftRequest{
+			GameID:        101,
+			GameVersionID: 202,
+		}
+
+		resp, err := RestoreGameDraft(ownerContext(), req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+		assert.Equal(t, c.code, resp.BaseResp.Code, c.err.Error())
+		ctrl.Finish()
+	}
+}
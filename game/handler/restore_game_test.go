@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/constdef"
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// TestRestoreGame_Success tests a successful restore and the recorded operation
+func TestRestoreGame_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		RestoreGame(gomock.Any(), uint64(101), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uint64, operationLog *ddl.GpGameOperationLog) error {
+			assert.NotZero(t, operationLog.Id)
+			assert.Equal(t, constdef.GameOperationRestore, operationLog.OperationType)
+			assert.Equal(t, "ops_alice", operationLog.Operator)
+			assert.Equal(t, "complaint withdrawn", operationLog.Reason)
+			return nil
+		}).
+		Times(1)
+
+	req := &game.RestoreGameRequest{
+		GameID:   101,
+		Reason:   "complaint withdrawn",
+		Operator: "ops_alice",
+	}
+
+	resp, err := RestoreGame(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+}
+
+// TestRestoreGame_MissingOperator tests that the operator is required
+func TestRestoreGame_MissingOperator(t *testing.T) {
+	req := &game.RestoreGameRequest{
+		GameID: 101,
+		Reason: "complaint withdrawn",
+	}
+
+	resp, err := RestoreGame(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+}
+
+// TestRestoreGame_InvalidGameID tests the failure case when GameID is invalid
+func TestRestoreGame_InvalidGameID(t *testing.T) {
+	req := &game.RestoreGameRequest{
+		GameID:   -1,
+		Reason:   "complaint withdrawn",
+		Operator: "ops_alice",
+	}
+
+	resp, err := RestoreGame(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+}
+
+// TestRestoreGame_NotTakenDown tests that only a taken-down game can be restored
+func TestRestoreGame_NotTakenDown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		RestoreGame(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(dao.ErrGameNotTakenDown).
+		Times(1)
+
+	req := &game.RestoreGameRequest{
+		GameID:   101,
+		Reason:   "complaint withdrawn",
+		Operator: "ops_alice",
+	}
+
+	resp, err := RestoreGame(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10010", resp.BaseResp.Code)
+	assert.Equal(t, dao.ErrGameNotTakenDown.Error(), resp.BaseResp.Msg)
+}
+
+// TestRestoreGame_GameNotFound tests the scenario where the game does not exist
+func TestRestoreGame_GameNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		RestoreGame(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(gorm.ErrRecordNotFound).
+		Times(1)
+
+	req := &game.RestoreGameRequest{
+		GameID:   999,
+		Reason:   "complaint withdrawn",
+		Operator: "ops_alice",
+	}
+
+	resp, err := RestoreGame(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10001", resp.BaseResp.Code)
+}
+
+// TestRestoreGame_DaoError tests the scenario where the DAO returns a general error
+func TestRestoreGame_DaoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		RestoreGame(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errors.New("database connection error")).
+		Times(1)
+
+	req := &game.RestoreGameRequest{
+		GameID:   101,
+		Reason:   "complaint withdrawn",
+		Operator: "ops_alice",
+	}
+
+	resp, err := RestoreGame(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "500", resp.BaseResp.Code)
+}
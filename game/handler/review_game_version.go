@@ -3,9 +3,13 @@ package handler
 import (
 	"context"
 	"errors"
+	"time"
 
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
 	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
 	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/yitter/idgenerator-go/idgen"
 	"gorm.io/gorm"
 )
 
@@ -30,12 +34,66 @@ func ReviewGameVersion(ctx context.Context, req *game.ReviewGameVersionRequest)
 		}, nil
 	}
 
-	// 注意：当前请求中没有 reviewComment 字段，我们暂时传入空字符串。
-	// 这是一个未来可以优化的地方，可以在 IDL 中为 ReviewGameVersionRequest 添加一个可选的 comment 字段。
-	reviewComment := ""
+	// 定时发布只对审核通过有意义，且发布时间必须晚于当前时间
+	if req.IsSetPublishAt() {
+		if req.ReviewResult_ != game.ReviewResult__Pass {
+			return &game.ReviewGameVersionResponse{
+				BaseResp: &common.BaseResp{Code: "400", Msg: "PublishAt can only be set when the review result is Pass"},
+			}, nil
+		}
+		if req.GetPublishAt() <= time.Now().Unix() {
+			return &game.ReviewGameVersionResponse{
+				BaseResp: &common.BaseResp{Code: "400", Msg: "PublishAt must be in the future"},
+			}, nil
+		}
+	}
+
+	// 灰度发布同样只对审核通过有意义，全量发布不需要设置比例，且不能与定时发布同时使用
+	if req.IsSetRolloutPercentage() {
+		if req.ReviewResult_ != game.ReviewResult__Pass || req.IsSetPublishAt() {
+			return &game.ReviewGameVersionResponse{
+				BaseResp: &common.BaseResp{Code: "400", Msg: "RolloutPercentage can only be set when the review result is Pass and PublishAt is not set"},
+			}, nil
+		}
+		if req.GetRolloutPercentage() < 1 || req.GetRolloutPercentage() > 99 {
+			return &game.ReviewGameVersionResponse{
+				BaseResp: &common.BaseResp{Code: "400", Msg: "RolloutPercentage must be between 1 and 99"},
+			}, nil
+		}
+	}
+
+	// 只有游戏所属的 CP 可以操作该游戏
+	if baseResp := authorizeGameOwner(ctx, uint64(req.GameID), 0); baseResp != nil {
+		return &game.ReviewGameVersionResponse{BaseResp: baseResp}, nil
+	}
+
+	// 每次审核都会追加一条审核记录，审核意见与审核人取自 ReviewRemark
+	reviewLog := &ddl.GpGameReviewLog{
+		Id:           uint64(idgen.NextId()),
+		ReviewResult: int(req.ReviewResult_),
+	}
+	if req.IsSetReviewRemark() {
+		reviewLog.Remark = req.ReviewRemark.Remark
+		reviewLog.Operator = req.ReviewRemark.Operator
+		reviewLog.Meta = req.ReviewRemark.Meta
+	}
 
 	// --- 3. 调用 DAO 层更新数据库 ---
-	err := GameDao.ReviewGameVersion(ctx, uint64(req.GameID), uint64(req.GameVersionID), newStatus, reviewComment)
+	var err error
+	if req.IsSetPublishAt() {
+		// 审核通过但暂不上线，由 scheduler 在 PublishAt 到达时切换上线版本
+		err = GameDao.ScheduleGameVersion(ctx, uint64(req.GameID), uint64(req.GameVersionID), req.GetPublishAt(), reviewLog)
+	} else if req.IsSetRolloutPercentage() {
+		// 审核通过后先对部分玩家灰度，上线版本保持不变，审核人即灰度的操作人
+		rolloutLog := &ddl.GpGameRolloutLog{
+			Id:       uint64(idgen.NextId()),
+			Operator: reviewLog.Operator,
+			Reason:   reviewLog.Remark,
+		}
+		err = GameDao.StartGameRollout(ctx, uint64(req.GameID), uint64(req.GameVersionID), int(req.GetRolloutPercentage()), reviewLog, rolloutLog)
+	} else {
+		err = GameDao.ReviewGameVersion(ctx, uint64(req.GameID), uint64(req.GameVersionID), newStatus, reviewLog)
+	}
 	if err != nil {
 		// 如果 DAO 返回 "记录未找到" 错误
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -43,12 +101,32 @@ func ReviewGameVersion(ctx context.Context, req *game.ReviewGameVersionRequest)
 				BaseResp: &common.BaseResp{Code: "10002", Msg: "Game or Version not found"},
 			}, nil
 		}
+		// 版本当前状态不允许流转到审核结果对应的状态（如草稿、已审核、非最新版本）
+		if errors.Is(err, dao.ErrIllegalStatusTransition) {
+			return &game.ReviewGameVersionResponse{
+				BaseResp: &common.BaseResp{Code: "10007", Msg: err.Error()},
+			}, nil
+		}
+		// 灰度发布需要已有上线版本，供未命中灰度的玩家使用
+		if errors.Is(err, dao.ErrGameNotOnline) {
+			return &game.ReviewGameVersionResponse{
+				BaseResp: &common.BaseResp{Code: "10009", Msg: err.Error()},
+			}, nil
+		}
+		// 包名已被其他游戏占用
+		if errors.Is(err, dao.ErrPackageNameConflict) {
+			return &game.ReviewGameVersionResponse{
+				BaseResp: &common.BaseResp{Code: "10016", Msg: err.Error()},
+			}, nil
+		}
 		// 其他数据库错误
 		return &game.ReviewGameVersionResponse{
 			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to update game version status: " + err.Error()},
 		}, nil
 	}
 
+	RefreshSearchIndex(ctx, uint64(req.GameID))
+
 	// --- 4. 构建并返回成功的响应 ---
 	resp := &game.ReviewGameVersionResponse{
 		BaseResp: &common.BaseResp{Code: "200", Msg: "Success"},
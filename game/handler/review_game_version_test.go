@@ -5,7 +5,10 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
 	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
 	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
 	"github.com/golang/mock/gomock"
@@ -20,6 +23,7 @@ func TestReviewGameVersion_PassSuccess(t *testing.T) {
 
 	mockGameDAO := mock.NewMockIGameDAO(ctrl)
 	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
 
 	gameID := uint64(101)
 	versionID := uint64(201)
@@ -27,17 +31,29 @@ func TestReviewGameVersion_PassSuccess(t *testing.T) {
 
 	// define expectation: DAO's ReviewGameVersion method is called with correct parameters and returns success
 	mockGameDAO.EXPECT().
-		ReviewGameVersion(gomock.Any(), gameID, versionID, expectedStatus, "").
-		Return(nil).
+		ReviewGameVersion(gomock.Any(), gameID, versionID, expectedStatus, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ uint64, _ int, reviewLog *ddl.GpGameReviewLog) error {
+			assert.NotZero(t, reviewLog.Id)
+			assert.Equal(t, int(game.ReviewResult__Pass), reviewLog.ReviewResult)
+			assert.Equal(t, "looks good", reviewLog.Remark)
+			assert.Equal(t, "reviewer_bob", reviewLog.Operator)
+			assert.Equal(t, `{"checklist":"ok"}`, reviewLog.Meta)
+			return nil
+		}).
 		Times(1)
 
 	req := &game.ReviewGameVersionRequest{
 		GameID:        int64(gameID),
 		GameVersionID: int64(versionID),
 		ReviewResult_: game.ReviewResult__Pass,
+		ReviewRemark: &game.ReviewRemark{
+			Remark:   "looks good",
+			Operator: "reviewer_bob",
+			Meta:     `{"checklist":"ok"}`,
+		},
 	}
 
-	resp, err := ReviewGameVersion(context.Background(), req)
+	resp, err := ReviewGameVersion(ownerContext(), req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
@@ -51,6 +67,7 @@ func TestReviewGameVersion_RejectSuccess(t *testing.T) {
 
 	mockGameDAO := mock.NewMockIGameDAO(ctrl)
 	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
 
 	gameID := uint64(102)
 	versionID := uint64(202)
@@ -58,8 +75,12 @@ func TestReviewGameVersion_RejectSuccess(t *testing.T) {
 
 	// define expectation: DAO's ReviewGameVersion method is called with correct parameters and returns success
 	mockGameDAO.EXPECT().
-		ReviewGameVersion(gomock.Any(), gameID, versionID, expectedStatus, "").
-		Return(nil).
+		ReviewGameVersion(gomock.Any(), gameID, versionID, expectedStatus, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ uint64, _ int, reviewLog *ddl.GpGameReviewLog) error {
+			assert.Equal(t, int(game.ReviewResult__Reject), reviewLog.ReviewResult)
+			assert.Empty(t, reviewLog.Remark)
+			return nil
+		}).
 		Times(1)
 
 	req := &game.ReviewGameVersionRequest{
@@ -68,7 +89,7 @@ func TestReviewGameVersion_RejectSuccess(t *testing.T) {
 		ReviewResult_: game.ReviewResult__Reject,
 	}
 
-	resp, err := ReviewGameVersion(context.Background(), req)
+	resp, err := ReviewGameVersion(ownerContext(), req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
@@ -82,6 +103,7 @@ func TestReviewGameVersion_NotFound(t *testing.T) {
 
 	mockGameDAO := mock.NewMockIGameDAO(ctrl)
 	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
 
 	gameID := uint64(999)
 	versionID := uint64(9999)
@@ -98,7 +120,7 @@ func TestReviewGameVersion_NotFound(t *testing.T) {
 		ReviewResult_: game.ReviewResult__Pass,
 	}
 
-	resp, err := ReviewGameVersion(context.Background(), req)
+	resp, err := ReviewGameVersion(ownerContext(), req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
@@ -177,6 +199,7 @@ func TestReviewGameVersion_OtherError(t *testing.T) {
 
 	mockGameDAO := mock.NewMockIGameDAO(ctrl)
 	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
 
 	otherError := errors.New("database connection error")
 	mockGameDAO.EXPECT().
@@ -190,10 +213,198 @@ func TestReviewGameVersion_OtherError(t *testing.T) {
 		ReviewResult_: game.ReviewResult__Pass,
 	}
 
-	resp, err := ReviewGameVersion(context.Background(), req)
+	resp, err := ReviewGameVersion(ownerContext(), req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
 	assert.Equal(t, "500", resp.BaseResp.Code)
 	assert.Contains(t, resp.BaseResp.Msg, "Failed to update game version status")
 }
+
+// TestReviewGameVersion_ScheduledSuccess tests that a Pass with PublishAt schedules the version instead of publishing it
+func TestReviewGameVersion_ScheduledSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	gameID := uint64(103)
+	versionID := uint64(203)
+	publishAt := time.Now().Add(2 * time.Hour).Unix()
+
+	mockGameDAO.EXPECT().
+		ScheduleGameVersion(gomock.Any(), gameID, versionID, publishAt, gomock.Any()).
+		Return(nil).
+		Times(1)
+	mockGameDAO.EXPECT().ReviewGameVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	req := &game.ReviewGameVersionRequest{
+		GameID:        int64(gameID),
+		GameVersionID: int64(versionID),
+		ReviewResult_: game.ReviewResult__Pass,
+		PublishAt:     &publishAt,
+	}
+
+	resp, err := ReviewGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+}
+
+// TestReviewGameVersion_ScheduledInThePast tests that a PublishAt that is not in the future is rejected
+func TestReviewGameVersion_ScheduledInThePast(t *testing.T) {
+	publishAt := time.Now().Add(-time.Minute).Unix()
+	req := &game.ReviewGameVersionRequest{
+		GameID:        103,
+		GameVersionID: 203,
+		ReviewResult_: game.ReviewResult__Pass,
+		PublishAt:     &publishAt,
+	}
+
+	resp, err := ReviewGameVersion(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+	assert.Contains(t, resp.BaseResp.Msg, "PublishAt must be in the future")
+}
+
+// TestReviewGameVersion_ScheduledWithReject tests that PublishAt cannot be combined with a rejection
+func TestReviewGameVersion_ScheduledWithReject(t *testing.T) {
+	publishAt := time.Now().Add(time.Hour).Unix()
+	req := &game.ReviewGameVersionRequest{
+		GameID:        103,
+		GameVersionID: 203,
+		ReviewResult_: game.ReviewResult__Reject,
+		PublishAt:     &publishAt,
+	}
+
+	resp, err := ReviewGameVersion(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+	assert.Contains(t, resp.BaseResp.Msg, "PublishAt can only be set when the review result is Pass")
+}
+
+// TestReviewGameVersion_IllegalTransition tests that reviewing a version outside the Reviewing status is refused
+func TestReviewGameVersion_IllegalTransition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	illegalErr := dao.CheckTransition(game.GameStatus_Rejected, game.GameStatus_Published)
+	mockGameDAO.EXPECT().
+		ReviewGameVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(illegalErr).
+		Times(1)
+
+	req := &game.ReviewGameVersionRequest{
+		GameID:        104,
+		GameVersionID: 204,
+		ReviewResult_: game.ReviewResult__Pass,
+	}
+
+	resp, err := ReviewGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10007", resp.BaseResp.Code)
+	assert.Equal(t, illegalErr.Error(), resp.BaseResp.Msg)
+}
+
+// TestReviewGameVersion_RolloutSuccess tests that a pass with a rollout percentage starts a staged rollout
+func TestReviewGameVersion_RolloutSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	gameID := uint64(105)
+	versionID := uint64(205)
+	percentage := int32(10)
+
+	mockGameDAO.EXPECT().
+		StartGameRollout(gomock.Any(), gameID, versionID, 10, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ uint64, _ int, reviewLog *ddl.GpGameReviewLog, rolloutLog *ddl.GpGameRolloutLog) error {
+			assert.NotZero(t, reviewLog.Id)
+			assert.NotZero(t, rolloutLog.Id)
+			assert.Equal(t, "reviewer_bob", rolloutLog.Operator)
+			assert.Equal(t, "canary first", rolloutLog.Reason)
+			return nil
+		}).
+		Times(1)
+	mockGameDAO.EXPECT().ReviewGameVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	req := &game.ReviewGameVersionRequest{
+		GameID:            int64(gameID),
+		GameVersionID:     int64(versionID),
+		ReviewResult_:     game.ReviewResult__Pass,
+		RolloutPercentage: &percentage,
+		ReviewRemark:      &game.ReviewRemark{Remark: "canary first", Operator: "reviewer_bob"},
+	}
+
+	resp, err := ReviewGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+}
+
+// TestReviewGameVersion_RolloutInvalid tests that rollout percentages out of range or combined with a rejection or a schedule are refused
+func TestReviewGameVersion_RolloutInvalid(t *testing.T) {
+	publishAt := time.Now().Add(time.Hour).Unix()
+	zero, ten, full := int32(0), int32(10), int32(100)
+	cases := []*game.ReviewGameVersionRequest{
+		{GameID: 105, GameVersionID: 205, ReviewResult_: game.ReviewResult__Pass, RolloutPercentage: &zero},
+		{GameID: 105, GameVersionID: 205, ReviewResult_: game.ReviewResult__Pass, RolloutPercentage: &full},
+		{GameID: 105, GameVersionID: 205, ReviewResult_: game.ReviewResult__Reject, RolloutPercentage: &ten},
+		{GameID: 105, GameVersionID: 205, ReviewResult_: game.ReviewResult__Pass, RolloutPercentage: &ten, PublishAt: &publishAt},
+	}
+
+	for _, req := range cases {
+		resp, err := ReviewGameVersion(context.Background(), req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+		assert.Equal(t, "400", resp.BaseResp.Code)
+		assert.Contains(t, resp.BaseResp.Msg, "RolloutPercentage")
+	}
+}
+
+// TestReviewGameVersion_RolloutNotOnline tests that a rollout is refused for a game without an online version to fall back on
+func TestReviewGameVersion_RolloutNotOnline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		StartGameRollout(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(dao.ErrGameNotOnline).
+		Times(1)
+
+	percentage := int32(20)
+	req := &game.ReviewGameVersionRequest{
+		GameID:            105,
+		GameVersionID:     205,
+		ReviewResult_:     game.ReviewResult__Pass,
+		RolloutPercentage: &percentage,
+	}
+
+	resp, err := ReviewGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10009", resp.BaseResp.Code)
+}
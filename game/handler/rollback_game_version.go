@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/GameLaunchPad/game_management_project/game/constdef"
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/yitter/idgenerator-go/idgen"
+	"gorm.io/gorm"
+)
+
+// RollbackGameVersion points a game's online version back at an earlier published version.
+func RollbackGameVersion(ctx context.Context, req *game.RollbackGameVersionRequest) (*game.RollbackGameVersionResponse, error) {
+	// --- 1. 参数校验 ---
+	if req.GameID <= 0 || req.GameVersionID <= 0 {
+		return &game.RollbackGameVersionResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid GameID or GameVersionID"},
+		}, nil
+	}
+	if strings.TrimSpace(req.Reason) == "" || strings.TrimSpace(req.Operator) == "" {
+		return &game.RollbackGameVersionResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Reason and Operator are required for a rollback"},
+		}, nil
+	}
+
+	// 只有游戏所属的 CP 可以操作该游戏
+	if baseResp := authorizeGameOwner(ctx, uint64(req.GameID), 0); baseResp != nil {
+		return &game.RollbackGameVersionResponse{BaseResp: baseResp}, nil
+	}
+
+	operationLog := &ddl.GpGameOperationLog{
+		Id:            uint64(idgen.NextId()),
+		OperationType: constdef.GameOperationRollback,
+		Operator:      req.Operator,
+		Reason:        req.Reason,
+	}
+
+	// --- 2. 调用 DAO 层切换上线版本 ---
+	err := GameDao.RollbackGameVersion(ctx, uint64(req.GameID), uint64(req.GameVersionID), operationLog)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &game.RollbackGameVersionResponse{
+				BaseResp: &common.BaseResp{Code: "10002", Msg: "Game or Version not found"},
+			}, nil
+		}
+		if errors.Is(err, dao.ErrVersionNeverPublished) {
+			return &game.RollbackGameVersionResponse{
+				BaseResp: &common.BaseResp{Code: "10004", Msg: err.Error()},
+			}, nil
+		}
+		if errors.Is(err, dao.ErrGameNotOnline) {
+			return &game.RollbackGameVersionResponse{
+				BaseResp: &common.BaseResp{Code: "10009", Msg: err.Error()},
+			}, nil
+		}
+		if errors.Is(err, dao.ErrVersionOffline) {
+			return &game.RollbackGameVersionResponse{
+				BaseResp: &common.BaseResp{Code: "10024", Msg: err.Error()},
+			}, nil
+		}
+		if errors.Is(err, dao.ErrVersionAlreadyOnline) {
+			return &game.RollbackGameVersionResponse{
+				BaseResp: &common.BaseResp{Code: "10005", Msg: err.Error()},
+			}, nil
+		}
+		if errors.Is(err, dao.ErrGameTakenDown) {
+			return &game.RollbackGameVersionResponse{
+				BaseResp: &common.BaseResp{Code: "10011", Msg: err.Error()},
+			}, nil
+		}
+		// another game already uses one of the package names
+		if errors.Is(err, dao.ErrPackageNameConflict) {
+			return &game.RollbackGameVersionResponse{
+				BaseResp: &common.BaseResp{Code: "10016", Msg: err.Error()},
+			}, nil
+		}
+		return &game.RollbackGameVersionResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to rollback game version: " + err.Error()},
+		}, nil
+	}
+
+	RefreshSearchIndex(ctx, uint64(req.GameID))
+
+	// --- 3. 构建并返回成功的响应 ---
+	return &game.RollbackGameVersionResponse{
+		BaseResp: &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
@@ -0,0 +1,287 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/constdef"
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// TestRollbackGameVersion_Success tests a successful rollback and the recorded operation
+func TestRollbackGameVersion_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	gameID := uint64(101)
+	versionID := uint64(200)
+
+	mockGameDAO.EXPECT().
+		RollbackGameVersion(gomock.Any(), gameID, versionID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ uint64, operationLog *ddl.GpGameOperationLog) error {
+			assert.NotZero(t, operationLog.Id)
+			assert.Equal(t, constdef.GameOperationRollback, operationLog.OperationType)
+			assert.Equal(t, "ops_alice", operationLog.Operator)
+			assert.Equal(t, "crash on startup", operationLog.Reason)
+			return nil
+		}).
+		Times(1)
+
+	req := &game.RollbackGameVersionRequest{
+		GameID:        int64(gameID),
+		GameVersionID: int64(versionID),
+		Reason:        "crash on startup",
+		Operator:      "ops_alice",
+	}
+
+	resp, err := RollbackGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+}
+
+// TestRollbackGameVersion_InvalidIDs tests the failure case when GameID or GameVersionID is invalid
+func TestRollbackGameVersion_InvalidIDs(t *testing.T) {
+	req := &game.RollbackGameVersionRequest{
+		GameID:        101,
+		GameVersionID: 0,
+		Reason:        "crash on startup",
+		Operator:      "ops_alice",
+	}
+
+	resp, err := RollbackGameVersion(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+	assert.Contains(t, resp.BaseResp.Msg, "Invalid GameID or GameVersionID")
+}
+
+// TestRollbackGameVersion_MissingReason tests that a rollback without a reason is refused
+func TestRollbackGameVersion_MissingReason(t *testing.T) {
+	req := &game.RollbackGameVersionRequest{
+		GameID:        101,
+		GameVersionID: 200,
+		Reason:        "  ",
+		Operator:      "ops_alice",
+	}
+
+	resp, err := RollbackGameVersion(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+	assert.Contains(t, resp.BaseResp.Msg, "Reason and Operator are required")
+}
+
+// TestRollbackGameVersion_NeverPublished tests that a version which was never published cannot be rolled back to
+func TestRollbackGameVersion_NeverPublished(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		RollbackGameVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(dao.ErrVersionNeverPublished).
+		Times(1)
+
+	req := &game.RollbackGameVersionRequest{
+		GameID:        101,
+		GameVersionID: 201,
+		Reason:        "crash on startup",
+		Operator:      "ops_alice",
+	}
+
+	resp, err := RollbackGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10004", resp.BaseResp.Code)
+	assert.Equal(t, dao.ErrVersionNeverPublished.Error(), resp.BaseResp.Msg)
+}
+
+// TestRollbackGameVersion_VersionOffline tests that a version taken offline cannot be rolled back to
+func TestRollbackGameVersion_VersionOffline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		RollbackGameVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(dao.ErrVersionOffline).
+		Times(1)
+
+	req := &game.RollbackGameVersionRequest{
+		GameID:        101,
+		GameVersionID: 201,
+		Reason:        "crash on startup",
+		Operator:      "ops_alice",
+	}
+
+	resp, err := RollbackGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10024", resp.BaseResp.Code)
+	assert.Equal(t, dao.ErrVersionOffline.Error(), resp.BaseResp.Msg)
+}
+
+// TestRollbackGameVersion_GameNotOnline tests that a game without an online version cannot be rolled back
+func TestRollbackGameVersion_GameNotOnline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		RollbackGameVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(dao.ErrGameNotOnline).
+		Times(1)
+
+	req := &game.RollbackGameVersionRequest{
+		GameID:        101,
+		GameVersionID: 200,
+		Reason:        "crash on startup",
+		Operator:      "ops_alice",
+	}
+
+	resp, err := RollbackGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10009", resp.BaseResp.Code)
+}
+
+// TestRollbackGameVersion_AlreadyOnline tests rolling back to the version that is already online
+func TestRollbackGameVersion_AlreadyOnline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		RollbackGameVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(dao.ErrVersionAlreadyOnline).
+		Times(1)
+
+	req := &game.RollbackGameVersionRequest{
+		GameID:        101,
+		GameVersionID: 202,
+		Reason:        "crash on startup",
+		Operator:      "ops_alice",
+	}
+
+	resp, err := RollbackGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10005", resp.BaseResp.Code)
+}
+
+// TestRollbackGameVersion_GameTakenDown tests that a taken-down game cannot be put back online by a rollback
+func TestRollbackGameVersion_GameTakenDown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		RollbackGameVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(dao.ErrGameTakenDown).
+		Times(1)
+
+	req := &game.RollbackGameVersionRequest{
+		GameID:        101,
+		GameVersionID: 200,
+		Reason:        "crash on startup",
+		Operator:      "ops_alice",
+	}
+
+	resp, err := RollbackGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10011", resp.BaseResp.Code)
+}
+
+// TestRollbackGameVersion_NotFound tests the scenario where the game or version is not found
+func TestRollbackGameVersion_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		RollbackGameVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(gorm.ErrRecordNotFound).
+		Times(1)
+
+	req := &game.RollbackGameVersionRequest{
+		GameID:        999,
+		GameVersionID: 9999,
+		Reason:        "crash on startup",
+		Operator:      "ops_alice",
+	}
+
+	resp, err := RollbackGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10002", resp.BaseResp.Code)
+	assert.Equal(t, "Game or Version not found", resp.BaseResp.Msg)
+}
+
+// TestRollbackGameVersion_DaoError tests the scenario where the DAO returns a general error
+func TestRollbackGameVersion_DaoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		RollbackGameVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errors.New("database connection error")).
+		Times(1)
+
+	req := &game.RollbackGameVersionRequest{
+		GameID:        101,
+		GameVersionID: 200,
+		Reason:        "crash on startup",
+		Operator:      "ops_alice",
+	}
+
+	resp, err := RollbackGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "500", resp.BaseResp.Code)
+	assert.Contains(t, resp.BaseResp.Msg, "Failed to rollback game version")
+}
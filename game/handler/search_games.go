@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/search"
+	"github.com/GameLaunchPad/game_management_project/game/service"
+)
+
+// SearchIndex is the full-text index behind SearchGames, opened in main. Write paths keep it up to
+// date through RefreshSearchIndex; while it is nil indexing is skipped and SearchGames fails.
+var SearchIndex *search.Index
+
+// SearchGames handles full-text search over game names, introductions and package names.
+func SearchGames(ctx context.Context, req *game.SearchGamesRequest) (*game.SearchGamesResponse, error) {
+	// parameter validation
+	queryText := strings.TrimSpace(req.Query)
+	if queryText == "" {
+		return &game.SearchGamesResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Query is required"},
+		}, nil
+	}
+	if SearchIndex == nil {
+		return &game.SearchGamesResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Search index is not available"},
+		}, nil
+	}
+
+	pageNum := int(req.PageNum)
+	if pageNum <= 0 {
+		pageNum = 1
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	query := &search.Query{
+		Text:   queryText,
+		CpID:   uint64(req.GetCpID()),
+		Offset: (pageNum - 1) * pageSize,
+		Limit:  pageSize,
+	}
+	for _, status := range req.Status {
+		query.Statuses = append(query.Statuses, int(status))
+	}
+
+	// search the index
+	result := SearchIndex.Search(query)
+
+	// construct response
+	hits := make([]*game.SearchGameHit, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		hits = append(hits, service.ConvertSearchHitToGame(hit))
+	}
+	return &game.SearchGamesResponse{
+		Hits:       hits,
+		TotalCount: int32(result.Total),
+		BaseResp:   &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
+
+// RefreshSearchIndex re-reads a game and puts its current state into the search index. The write
+// has already been committed when this runs, so a failure is logged and left for the next write
+// or a rebuild to repair rather than failing the request. The index saves itself in the background.
+func RefreshSearchIndex(ctx context.Context, gameID uint64) {
+	if SearchIndex == nil {
+		return
+	}
+	gameDdl, newestVersionDdl, _, err := GameDao.GetGameDetail(ctx, gameID)
+	if err != nil {
+		log.Printf("search index: failed to load game %d: %v", gameID, err)
+		return
+	}
+	SearchIndex.Put(service.ConvertDdlToSearchDocument(gameDdl, newestVersionDdl))
+}
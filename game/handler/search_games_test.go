@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/search"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// useSearchIndex installs an in-memory search index for the duration of a test.
+func useSearchIndex(t *testing.T, docs ...*search.Document) *search.Index {
+	index, err := search.Open("")
+	assert.NoError(t, err)
+	for _, doc := range docs {
+		index.Put(doc)
+	}
+	SearchIndex = index
+	t.Cleanup(func() { SearchIndex = nil })
+	return index
+}
+
+// TestSearchGames_Success tests a search with filters, paging and highlights
+func TestSearchGames_Success(t *testing.T) {
+	useSearchIndex(t,
+		&search.Document{GameID: 101, CpID: 1, GameName: "王者荣耀", GameIntroduction: "5V5 英雄公平对战", Status: int(game.GameStatus_Published), UpdateTime: 100},
+		&search.Document{GameID: 102, CpID: 1, GameName: "荣耀大陆", Status: int(game.GameStatus_Draft), UpdateTime: 200},
+		&search.Document{GameID: 103, CpID: 2, GameName: "荣耀之战", Status: int(game.GameStatus_Published), UpdateTime: 300},
+	)
+
+	cpID := int64(1)
+	req := &game.SearchGamesRequest{
+		Query:    "荣耀",
+		CpID:     &cpID,
+		Status:   []game.GameStatus{game.GameStatus_Published},
+		PageNum:  1,
+		PageSize: 10,
+	}
+
+	resp, err := SearchGames(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Equal(t, int32(1), resp.TotalCount)
+	assert.Len(t, resp.Hits, 1)
+	assert.Equal(t, int64(101), resp.Hits[0].Game.GameID)
+	assert.Equal(t, game.GameStatus_Published, resp.Hits[0].Game.GameStatus)
+	assert.Greater(t, resp.Hits[0].Score, 0.0)
+	assert.Equal(t, []*game.SearchHighlight{{Field: "game_name", Snippet: "王者<em>荣耀</em>"}}, resp.Hits[0].Highlights)
+}
+
+// TestSearchGames_EmptyQuery tests that a blank query is rejected
+func TestSearchGames_EmptyQuery(t *testing.T) {
+	useSearchIndex(t)
+
+	resp, err := SearchGames(context.Background(), &game.SearchGamesRequest{Query: "   "})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+}
+
+// TestSearchGames_IndexUnavailable tests searching before the index is opened
+func TestSearchGames_IndexUnavailable(t *testing.T) {
+	resp, err := SearchGames(context.Background(), &game.SearchGamesRequest{Query: "荣耀"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "500", resp.BaseResp.Code)
+}
+
+// TestRefreshSearchIndex tests that a write path puts the newest version of the game into the index
+func TestRefreshSearchIndex(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	index := useSearchIndex(t)
+
+	gameID := uint64(101)
+	mockGame := &ddl.GpGame{Id: gameID, CpId: 1, GameName: "Old Name", TakedownVersionId: 200, CreateTs: time.Now(), ModifyTs: time.Now()}
+	mockNewestVersion := &ddl.GpGameVersion{Id: 201, GameId: gameID, GameName: "开心消消乐", PackageName: "com.happy.elimination", Status: int(game.GameStatus_Draft)}
+	mockGameDAO.EXPECT().
+		GetGameDetail(gomock.Any(), gameID).
+		Return(mockGame, mockNewestVersion, nil, nil).
+		Times(1)
+
+	RefreshSearchIndex(context.Background(), gameID)
+
+	result := index.Search(&search.Query{Text: "xiaoxiaole"})
+	assert.Equal(t, 1, result.Total)
+	assert.Equal(t, "开心消消乐", result.Hits[0].Document.GameName)
+	// a taken-down game is reported as Offline, like in GetGameList
+	assert.Equal(t, int(game.GameStatus_Offline), result.Hits[0].Document.Status)
+	assert.Empty(t, index.Search(&search.Query{Text: "Old Name"}).Hits)
+}
@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/service"
+	"github.com/GameLaunchPad/game_management_project/game/validation"
+	"gorm.io/gorm"
+)
+
+// SubmitGameVersion moves the newest draft of a game into review without copying it.
+func SubmitGameVersion(ctx context.Context, req *game.SubmitGameVersionRequest) (*game.SubmitGameVersionResponse, error) {
+	// --- 1. 参数校验 ---
+	if req.GameID <= 0 || req.GameVersionID <= 0 {
+		return &game.SubmitGameVersionResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid GameID or GameVersionID"},
+		}, nil
+	}
+
+	// 只有游戏所属的 CP 可以操作该游戏
+	if baseResp := authorizeGameOwner(ctx, uint64(req.GameID), 0); baseResp != nil {
+		return &game.SubmitGameVersionResponse{BaseResp: baseResp}, nil
+	}
+
+	// --- 2. 按提交审核的要求校验草稿内容 ---
+	versionDdl, err := GameDao.GetGameVersion(ctx, uint64(req.GameID), uint64(req.GameVersionID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &game.SubmitGameVersionResponse{
+				BaseResp: &common.BaseResp{Code: "10002", Msg: "Game or Version not found"},
+			}, nil
+		}
+		return &game.SubmitGameVersionResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to get game version: " + err.Error()},
+		}, nil
+	}
+	version, err := service.ConvertDdlToGameVersion(versionDdl)
+	if err != nil {
+		return &game.SubmitGameVersionResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to convert game version: " + err.Error()},
+		}, nil
+	}
+	if fieldErrors, baseResp := validateGameVersion(version, validation.ModeSubmit); baseResp != nil {
+		return &game.SubmitGameVersionResponse{FieldErrors: fieldErrors, BaseResp: baseResp}, nil
+	}
+
+	// --- 3. 解析随提交上传的 APK，包名须与版本的 Android 包名一致 ---
+	apkManifest, fieldErrors, baseResp := inspectSubmittedApk(req, version)
+	if baseResp != nil {
+		return &game.SubmitGameVersionResponse{FieldErrors: fieldErrors, BaseResp: baseResp}, nil
+	}
+
+	// --- 4. 调用 DAO 层将草稿提交审核，只提交刚刚校验过的修订 ---
+	err = GameDao.SubmitGameVersion(ctx, uint64(req.GameID), uint64(req.GameVersionID), versionDdl.Revision, apkManifest)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &game.SubmitGameVersionResponse{
+				BaseResp: &common.BaseResp{Code: "10002", Msg: "Game or Version not found"},
+			}, nil
+		}
+		if errors.Is(err, dao.ErrIllegalStatusTransition) {
+			return &game.SubmitGameVersionResponse{
+				BaseResp: &common.BaseResp{Code: "10007", Msg: err.Error()},
+			}, nil
+		}
+		// the draft was edited after it was validated
+		if errors.Is(err, dao.ErrRevisionConflict) {
+			return &game.SubmitGameVersionResponse{
+				BaseResp: &common.BaseResp{Code: "10008", Msg: err.Error()},
+			}, nil
+		}
+		// another game already uses one of the package names
+		if errors.Is(err, dao.ErrPackageNameConflict) {
+			return &game.SubmitGameVersionResponse{
+				BaseResp: &common.BaseResp{Code: "10016", Msg: err.Error()},
+			}, nil
+		}
+		// the game has been released, what changed since has to be told
+		if fieldErrors := contentFieldErrors(err); fieldErrors != nil {
+			return &game.SubmitGameVersionResponse{
+				FieldErrors: fieldErrors,
+				BaseResp:    &common.BaseResp{Code: "400", Msg: "Invalid game version content"},
+			}, nil
+		}
+		return &game.SubmitGameVersionResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to submit game version: " + err.Error()},
+		}, nil
+	}
+
+	RefreshSearchIndex(ctx, uint64(req.GameID))
+
+	// --- 5. 构建并返回成功的响应 ---
+	return &game.SubmitGameVersionResponse{
+		BaseResp: &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
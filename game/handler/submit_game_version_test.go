@@ -0,0 +1,396 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/config"
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/service"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// submittableDraft returns a draft whose content passes the checks for review, at revision 3.
+func submittableDraft() *ddl.GpGameVersion {
+	return &ddl.GpGameVersion{
+		Id:                     201,
+		GameId:                 101,
+		GameName:               "Happy Elimination",
+		GameIcon:               "https://cdn.example.com/icon.png",
+		HeaderImage:            "https://cdn.example.com/header.png",
+		GameIntroduction:       "Match three.",
+		GameIntroductionImages: `["https://cdn.example.com/1.png"]`,
+		Platform:               "[1]",
+		PackageName:            "com.happy.elimination",
+		DownloadUrl:            "https://download.example.com/happy.apk",
+		Status:                 int(game.GameStatus_Draft),
+		Revision:               3,
+	}
+}
+
+// expectSubmittableDraft lets SubmitGameVersion load the draft returned by submittableDraft.
+func expectSubmittableDraft(mockGameDAO *mock.MockIGameDAO) {
+	mockGameDAO.EXPECT().
+		GetGameVersion(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(submittableDraft(), nil).
+		Times(1)
+}
+
+// TestSubmitGameVersion_Success tests that a draft is submitted for review
+func TestSubmitGameVersion_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+	expectSubmittableDraft(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		SubmitGameVersion(gomock.Any(), uint64(101), uint64(201), int64(3), "").
+		Return(nil).
+		Times(1)
+
+	req := &game.SubmitGameVersionRequest{
+		GameID:        101,
+		GameVersionID: 201,
+	}
+
+	resp, err := SubmitGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+}
+
+// TestSubmitGameVersion_InvalidIDs tests the failure case when GameID or GameVersionID is invalid
+func TestSubmitGameVersion_InvalidIDs(t *testing.T) {
+	req := &game.SubmitGameVersionRequest{
+		GameID:        101,
+		GameVersionID: 0,
+	}
+
+	resp, err := SubmitGameVersion(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+}
+
+// TestSubmitGameVersion_IllegalTransition tests that only a draft can be submitted
+func TestSubmitGameVersion_IllegalTransition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+	expectSubmittableDraft(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		SubmitGameVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(dao.CheckTransition(game.GameStatus_Published, game.GameStatus_Reviewing)).
+		Times(1)
+
+	req := &game.SubmitGameVersionRequest{
+		GameID:        101,
+		GameVersionID: 201,
+	}
+
+	resp, err := SubmitGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10007", resp.BaseResp.Code)
+}
+
+// TestSubmitGameVersion_NotFound tests the scenario where the game or version is not found
+func TestSubmitGameVersion_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+	expectSubmittableDraft(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		SubmitGameVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(gorm.ErrRecordNotFound).
+		Times(1)
+
+	req := &game.SubmitGameVersionRequest{
+		GameID:        999,
+		GameVersionID: 9999,
+	}
+
+	resp, err := SubmitGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10002", resp.BaseResp.Code)
+}
+
+// TestSubmitGameVersion_DaoError tests the scenario where the DAO returns a general error
+func TestSubmitGameVersion_DaoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+	expectSubmittableDraft(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		SubmitGameVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errors.New("database connection error")).
+		Times(1)
+
+	req := &game.SubmitGameVersionRequest{
+		GameID:        101,
+		GameVersionID: 201,
+	}
+
+	resp, err := SubmitGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "500", resp.BaseResp.Code)
+}
+
+// TestSubmitGameVersion_InvalidContent tests that an incomplete draft is not submitted and its field errors are returned
+func TestSubmitGameVersion_InvalidContent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	draft := submittableDraft()
+	draft.PackageName = "elimination"
+	draft.DownloadUrl = ""
+	mockGameDAO.EXPECT().
+		GetGameVersion(gomock.Any(), uint64(101), uint64(201)).
+		Return(draft, nil).
+		Times(1)
+
+	req := &game.SubmitGameVersionRequest{
+		GameID:        101,
+		GameVersionID: 201,
+	}
+
+	resp, err := SubmitGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+	assert.Equal(t, []*game.FieldError{
+		{Field: "download_url", Code: "required", Message: "download_url is required"},
+		{Field: "package_name", Code: "invalid_format", Message: "Android package name must be in reverse-DNS form, such as com.example.game"},
+	}, resp.FieldErrors)
+}
+
+// TestSubmitGameVersion_EditedAfterValidation tests that a draft edited between validation and submit is not submitted
+func TestSubmitGameVersion_EditedAfterValidation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+	expectSubmittableDraft(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		SubmitGameVersion(gomock.Any(), uint64(101), uint64(201), int64(3), "").
+		Return(dao.ErrRevisionConflict).
+		Times(1)
+
+	req := &game.SubmitGameVersionRequest{
+		GameID:        101,
+		GameVersionID: 201,
+	}
+
+	resp, err := SubmitGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "10008", resp.BaseResp.Code)
+}
+
+// TestSubmitGameVersion_PackageNameConflict tests that a draft whose package name is held by another game is not submitted
+func TestSubmitGameVersion_PackageNameConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+	expectSubmittableDraft(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		SubmitGameVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(fmt.Errorf("%w: com.happy.elimination on Android", dao.ErrPackageNameConflict)).
+		Times(1)
+
+	req := &game.SubmitGameVersionRequest{GameID: 101, GameVersionID: 201}
+
+	resp, err := SubmitGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "10016", resp.BaseResp.Code)
+}
+
+// TestSubmitGameVersion_ReleaseNotesRequired tests that a released game's draft without release notes is reported as a field error
+func TestSubmitGameVersion_ReleaseNotesRequired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+	expectSubmittableDraft(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		SubmitGameVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(dao.ErrReleaseNotesRequired).
+		Times(1)
+
+	req := &game.SubmitGameVersionRequest{GameID: 101, GameVersionID: 201}
+
+	resp, err := SubmitGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+	assert.Len(t, resp.FieldErrors, 1)
+	assert.Equal(t, "release_notes", resp.FieldErrors[0].Field)
+	assert.Equal(t, "required", resp.FieldErrors[0].Code)
+}
+
+// testApk is an APK of com.happy.elimination 1.2.0 (120) for SDK 21 and up.
+const testApk = "testdata/com.happy.elimination.apk"
+
+// TestSubmitGameVersion_WithApk tests that the APK sent with a submit is inspected and stored with the version
+func TestSubmitGameVersion_WithApk(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+	expectSubmittableDraft(mockGameDAO)
+
+	apkFile, err := os.ReadFile(testApk)
+	assert.NoError(t, err)
+	mockGameDAO.EXPECT().
+		SubmitGameVersion(gomock.Any(), uint64(101), uint64(201), int64(3), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ uint64, _ int64, apkManifest string) error {
+			version, err := service.ConvertDdlToGameVersion(&ddl.GpGameVersion{ApkManifest: apkManifest})
+			assert.NoError(t, err)
+			assert.Equal(t, "com.happy.elimination", version.ApkManifest.PackageName)
+			assert.Equal(t, int64(120), version.ApkManifest.VersionCode)
+			assert.Equal(t, "1.2.0", version.ApkManifest.VersionName)
+			assert.Equal(t, int32(21), version.ApkManifest.MinSdkVersion)
+			assert.Len(t, version.ApkManifest.CertificateSHA256, 64)
+			return nil
+		}).
+		Times(1)
+
+	req := &game.SubmitGameVersionRequest{GameID: 101, GameVersionID: 201, ApkFile: apkFile}
+
+	resp, err := SubmitGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+}
+
+// TestSubmitGameVersion_ApkPackageMismatch tests that an APK of another package is rejected without submitting
+func TestSubmitGameVersion_ApkPackageMismatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	draft := submittableDraft()
+	draft.PackageName = "com.happy.other"
+	mockGameDAO.EXPECT().GetGameVersion(gomock.Any(), uint64(101), uint64(201)).Return(draft, nil).Times(1)
+	mockGameDAO.EXPECT().SubmitGameVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	apkFile, err := os.ReadFile(testApk)
+	assert.NoError(t, err)
+	req := &game.SubmitGameVersionRequest{GameID: 101, GameVersionID: 201, ApkFile: apkFile}
+
+	resp, err := SubmitGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+	assert.Equal(t, []*game.FieldError{
+		{Field: "package_name", Code: "invalid_value", Message: "the APK is package com.happy.elimination, not com.happy.other"},
+	}, resp.FieldErrors)
+}
+
+// TestSubmitGameVersion_InvalidApk tests that a file that is not an APK is rejected
+func TestSubmitGameVersion_InvalidApk(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+	expectSubmittableDraft(mockGameDAO)
+
+	req := &game.SubmitGameVersionRequest{GameID: 101, GameVersionID: 201, ApkFile: []byte("not an apk")}
+
+	resp, err := SubmitGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+}
+
+// TestSubmitGameVersion_ApkPath tests that APKs are read by path only from apk.upload_dir
+func TestSubmitGameVersion_ApkPath(t *testing.T) {
+	previous := config.GlobalConfig
+	defer func() { config.GlobalConfig = previous }()
+	config.GlobalConfig = &config.Config{}
+	config.GlobalConfig.Apk.UploadDir = "testdata"
+
+	tests := []struct {
+		name     string
+		path     string
+		wantCode string
+	}{
+		{"inside the upload dir", "com.happy.elimination.apk", "200"},
+		{"outside the upload dir", "../submit_game_version.go", "400"},
+		{"missing", "missing.apk", "400"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockGameDAO := mock.NewMockIGameDAO(ctrl)
+			GameDao = mockGameDAO
+			expectGameOwner(mockGameDAO)
+			expectSubmittableDraft(mockGameDAO)
+			if tt.wantCode == "200" {
+				mockGameDAO.EXPECT().SubmitGameVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Not("")).Return(nil).Times(1)
+			}
+
+			path := tt.path
+			req := &game.SubmitGameVersionRequest{GameID: 101, GameVersionID: 201, ApkPath: &path}
+
+			resp, err := SubmitGameVersion(ownerContext(), req)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantCode, resp.BaseResp.Code)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/GameLaunchPad/game_management_project/game/constdef"
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/yitter/idgenerator-go/idgen"
+	"gorm.io/gorm"
+)
+
+// TakedownGame pulls a live game offline; the online version becomes Offline.
+func TakedownGame(ctx context.Context, req *game.TakedownGameRequest) (*game.TakedownGameResponse, error) {
+	// --- 1. 参数校验 ---
+	if req.GameID <= 0 {
+		return &game.TakedownGameResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid GameID"},
+		}, nil
+	}
+	if strings.TrimSpace(req.Reason) == "" || strings.TrimSpace(req.Operator) == "" {
+		return &game.TakedownGameResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Reason and Operator are required for a takedown"},
+		}, nil
+	}
+
+	// 只有游戏所属的 CP 可以操作该游戏
+	if baseResp := authorizeGameOwner(ctx, uint64(req.GameID), 0); baseResp != nil {
+		return &game.TakedownGameResponse{BaseResp: baseResp}, nil
+	}
+
+	operationLog := &ddl.GpGameOperationLog{
+		Id:            uint64(idgen.NextId()),
+		OperationType: constdef.GameOperationTakedown,
+		Operator:      req.Operator,
+		Reason:        req.Reason,
+	}
+
+	// --- 2. 调用 DAO 层下架游戏 ---
+	err := GameDao.TakedownGame(ctx, uint64(req.GameID), operationLog)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &game.TakedownGameResponse{
+				BaseResp: &common.BaseResp{Code: "10001", Msg: "Game not found"},
+			}, nil
+		}
+		if errors.Is(err, dao.ErrGameNotOnline) {
+			return &game.TakedownGameResponse{
+				BaseResp: &common.BaseResp{Code: "10009", Msg: err.Error()},
+			}, nil
+		}
+		if errors.Is(err, dao.ErrIllegalStatusTransition) {
+			return &game.TakedownGameResponse{
+				BaseResp: &common.BaseResp{Code: "10007", Msg: err.Error()},
+			}, nil
+		}
+		return &game.TakedownGameResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to take down game: " + err.Error()},
+		}, nil
+	}
+
+	RefreshSearchIndex(ctx, uint64(req.GameID))
+
+	// --- 3. 构建并返回成功的响应 ---
+	return &game.TakedownGameResponse{
+		BaseResp: &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/constdef"
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// TestTakedownGame_Success tests a successful takedown and the recorded operation
+func TestTakedownGame_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		TakedownGame(gomock.Any(), uint64(101), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uint64, operationLog *ddl.GpGameOperationLog) error {
+			assert.NotZero(t, operationLog.Id)
+			assert.Equal(t, constdef.GameOperationTakedown, operationLog.OperationType)
+			assert.Equal(t, "ops_alice", operationLog.Operator)
+			assert.Equal(t, "copyright complaint", operationLog.Reason)
+			return nil
+		}).
+		Times(1)
+
+	req := &game.TakedownGameRequest{
+		GameID:   101,
+		Reason:   "copyright complaint",
+		Operator: "ops_alice",
+	}
+
+	resp, err := TakedownGame(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+}
+
+// TestTakedownGame_MissingOperator tests that the operator is required
+func TestTakedownGame_MissingOperator(t *testing.T) {
+	req := &game.TakedownGameRequest{
+		GameID: 101,
+		Reason: "copyright complaint",
+	}
+
+	resp, err := TakedownGame(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+}
+
+// TestTakedownGame_InvalidGameID tests the failure case when GameID is invalid
+func TestTakedownGame_InvalidGameID(t *testing.T) {
+	req := &game.TakedownGameRequest{
+		GameID:   -1,
+		Reason:   "copyright complaint",
+		Operator: "ops_alice",
+	}
+
+	resp, err := TakedownGame(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+}
+
+// TestTakedownGame_NotOnline tests that a game without an online version cannot be taken down
+func TestTakedownGame_NotOnline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		TakedownGame(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(dao.ErrGameNotOnline).
+		Times(1)
+
+	req := &game.TakedownGameRequest{
+		GameID:   101,
+		Reason:   "copyright complaint",
+		Operator: "ops_alice",
+	}
+
+	resp, err := TakedownGame(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10009", resp.BaseResp.Code)
+	assert.Equal(t, dao.ErrGameNotOnline.Error(), resp.BaseResp.Msg)
+}
+
+// TestTakedownGame_GameNotFound tests the scenario where the game does not exist
+func TestTakedownGame_GameNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		TakedownGame(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(gorm.ErrRecordNotFound).
+		Times(1)
+
+	req := &game.TakedownGameRequest{
+		GameID:   999,
+		Reason:   "copyright complaint",
+		Operator: "ops_alice",
+	}
+
+	resp, err := TakedownGame(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10001", resp.BaseResp.Code)
+}
+
+// TestTakedownGame_DaoError tests the scenario where the DAO returns a general error
+func TestTakedownGame_DaoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		TakedownGame(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errors.New("database connection error")).
+		Times(1)
+
+	req := &game.TakedownGameRequest{
+		GameID:   101,
+		Reason:   "copyright complaint",
+		Operator: "ops_alice",
+	}
+
+	resp, err := TakedownGame(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "500", resp.BaseResp.Code)
+}
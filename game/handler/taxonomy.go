@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+)
+
+// maxTaxonomyNameLength is the length of gp_category.name and gp_tag.name.
+const maxTaxonomyNameLength = 64
+
+// normalizeTaxonomyName trims a category or tag name, and returns the response to send back when it is
+// blank or too long.
+func normalizeTaxonomyName(name string) (string, *common.BaseResp) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", &common.BaseResp{Code: "400", Msg: "Name is required"}
+	}
+	if utf8.RuneCountInString(name) > maxTaxonomyNameLength {
+		return "", &common.BaseResp{Code: "400", Msg: "Name must be at most 64 characters long"}
+	}
+	return name, nil
+}
+
+// taxonomyErrorResp maps an error of the category and tag DAO methods to a response.
+func taxonomyErrorResp(err error, action string) *common.BaseResp {
+	switch {
+	case errors.Is(err, dao.ErrCategoryNotFound):
+		return &common.BaseResp{Code: "10018", Msg: "Category not found"}
+	case errors.Is(err, dao.ErrTagNotFound):
+		return &common.BaseResp{Code: "10018", Msg: "Tag not found"}
+	case errors.Is(err, dao.ErrTaxonomyNameConflict):
+		return &common.BaseResp{Code: "10019", Msg: err.Error()}
+	case errors.Is(err, dao.ErrInvalidCategoryParent):
+		return &common.BaseResp{Code: "10020", Msg: err.Error()}
+	case errors.Is(err, dao.ErrTaxonomyInUse):
+		return &common.BaseResp{Code: "10021", Msg: err.Error()}
+	}
+	return &common.BaseResp{Code: "500", Msg: "Failed to " + action + ": " + err.Error()}
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+)
+
+// UpdateCategory renames, reorders or moves a category.
+func UpdateCategory(ctx context.Context, req *game.UpdateCategoryRequest) (*game.UpdateCategoryResponse, error) {
+	// --- 1. 参数校验 ---
+	if req.CategoryID <= 0 {
+		return &game.UpdateCategoryResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid CategoryID"},
+		}, nil
+	}
+	name, baseResp := normalizeTaxonomyName(req.Name)
+	if baseResp != nil {
+		return &game.UpdateCategoryResponse{BaseResp: baseResp}, nil
+	}
+	if req.ParentID < 0 {
+		return &game.UpdateCategoryResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid ParentID"},
+		}, nil
+	}
+
+	// --- 2. 调用 DAO 层修改分类 ---
+	category := &ddl.GpCategory{
+		Id:        uint64(req.CategoryID),
+		ParentId:  uint64(req.ParentID),
+		Name:      name,
+		SortOrder: int(req.SortOrder),
+	}
+	if err := GameDao.UpdateCategory(ctx, category); err != nil {
+		return &game.UpdateCategoryResponse{BaseResp: taxonomyErrorResp(err, "update category")}, nil
+	}
+
+	// --- 3. 构建并返回成功的响应 ---
+	return &game.UpdateCategoryResponse{
+		BaseResp: &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
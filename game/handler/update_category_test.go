@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUpdateCategory_Success tests that a category is moved and renamed
+func TestUpdateCategory_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().
+		UpdateCategory(gomock.Any(), &ddl.GpCategory{Id: 12, ParentId: 0, Name: "Casual", SortOrder: 1}).
+		Return(nil).
+		Times(1)
+
+	resp, err := UpdateCategory(context.Background(), &game.UpdateCategoryRequest{CategoryID: 12, Name: "Casual", SortOrder: 1})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+}
+
+// TestUpdateCategory_InvalidID tests the failure case when CategoryID is invalid
+func TestUpdateCategory_InvalidID(t *testing.T) {
+	resp, err := UpdateCategory(context.Background(), &game.UpdateCategoryRequest{CategoryID: 0, Name: "Casual"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+}
+
+// TestUpdateCategory_NotFound tests updating a category that does not exist
+func TestUpdateCategory_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().UpdateCategory(gomock.Any(), gomock.Any()).Return(dao.ErrCategoryNotFound).Times(1)
+
+	resp, err := UpdateCategory(context.Background(), &game.UpdateCategoryRequest{CategoryID: 999, Name: "Casual"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "10018", resp.BaseResp.Code)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/GameLaunchPad/game_management_project/game/dao"
 	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
 	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
 	"github.com/GameLaunchPad/game_management_project/game/service"
@@ -25,6 +26,16 @@ func UpdateGameDraft(ctx context.Context, req *game.UpdateGameDraftRequest) (*ga
 		}, nil
 	}
 
+	// drafts only have to fit the storage, content submitted for review must be complete
+	if fieldErrors, baseResp := validateGameVersion(req.GameDetail.GameVersion, validationModeOf(req.SubmitMode)); baseResp != nil {
+		return &game.UpdateGameDraftResponse{FieldErrors: fieldErrors, BaseResp: baseResp}, nil
+	}
+
+	// only the CP that owns the game may edit it
+	if baseResp := authorizeGameOwner(ctx, uint64(req.GameDetail.GameID), req.GameDetail.CpID); baseResp != nil {
+		return &game.UpdateGameDraftResponse{BaseResp: baseResp}, nil
+	}
+
 	// generate new version ID
 	gameID := uint64(req.GameDetail.GameID)
 	versionID := uint64(idgen.NextId())
@@ -39,23 +50,52 @@ func UpdateGameDraft(ctx context.Context, req *game.UpdateGameDraftRequest) (*ga
 	gameVersionDdl.Id = versionID
 	gameVersionDdl.GameId = gameID
 
-	gameVersionDdl.Status = int(game.GameStatus_Draft)
+	gameVersionDdl.Status = int(initialStatusOf(req.SubmitMode))
 
-	// call DAO to update the draft
-	err = GameDao.UpdateGameDraft(ctx, gameID, gameVersionDdl)
+	// call DAO to update the draft; an existing draft is edited in place and keeps its version ID
+	err = GameDao.UpdateGameDraft(ctx, gameID, gameVersionDdl, req.ExpectedRevision)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return &game.UpdateGameDraftResponse{
 				BaseResp: &common.BaseResp{Code: "10001", Msg: "Game not found"},
 			}, nil
 		}
+		// the newest version is scheduled for publishing, a new draft cannot replace it
+		if errors.Is(err, dao.ErrIllegalStatusTransition) {
+			return &game.UpdateGameDraftResponse{
+				BaseResp: &common.BaseResp{Code: "10007", Msg: err.Error()},
+			}, nil
+		}
+		// someone else saved the game after the client loaded it
+		if errors.Is(err, dao.ErrRevisionConflict) {
+			return &game.UpdateGameDraftResponse{
+				BaseResp: &common.BaseResp{Code: "10008", Msg: err.Error()},
+			}, nil
+		}
+		// another game already uses one of the package names
+		if errors.Is(err, dao.ErrPackageNameConflict) {
+			return &game.UpdateGameDraftResponse{
+				BaseResp: &common.BaseResp{Code: "10016", Msg: err.Error()},
+			}, nil
+		}
+		// the category or a tag does not exist (any more), or the release notes are missing
+		if fieldErrors := contentFieldErrors(err); fieldErrors != nil {
+			return &game.UpdateGameDraftResponse{
+				FieldErrors: fieldErrors,
+				BaseResp:    &common.BaseResp{Code: "400", Msg: "Invalid game version content"},
+			}, nil
+		}
 		return &game.UpdateGameDraftResponse{
 			BaseResp: &common.BaseResp{Code: "500", Msg: "Internal Server Error: " + err.Error()},
 		}, nil
 	}
 
+	RefreshSearchIndex(ctx, gameID)
+
 	// construct success response
 	return &game.UpdateGameDraftResponse{
-		BaseResp: &common.BaseResp{Code: "200", Msg: "Success"},
+		GameVersionID: int64(gameVersionDdl.Id),
+		Revision:      gameVersionDdl.Revision,
+		BaseResp:      &common.BaseResp{Code: "200", Msg: "Success"},
 	}, nil
 }
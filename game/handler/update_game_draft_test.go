@@ -3,8 +3,11 @@ package handler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
 	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
 	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
 	"github.com/golang/mock/gomock"
@@ -20,8 +23,9 @@ func TestUpdateGameDraft_Success(t *testing.T) {
 
 	mockGameDAO := mock.NewMockIGameDAO(ctrl)
 	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
 
-	mockGameDAO.EXPECT().UpdateGameDraft(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mockGameDAO.EXPECT().UpdateGameDraft(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
 	req := &game.UpdateGameDraftRequest{
 		GameDetail: &game.GameDetailWrite{
@@ -33,7 +37,7 @@ func TestUpdateGameDraft_Success(t *testing.T) {
 		},
 	}
 
-	resp, err := UpdateGameDraft(context.Background(), req)
+	resp, err := UpdateGameDraft(ownerContext(), req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
@@ -48,8 +52,9 @@ func TestUpdateGameDraft_GameNotFound(t *testing.T) {
 
 	mockGameDAO := mock.NewMockIGameDAO(ctrl)
 	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
 
-	mockGameDAO.EXPECT().UpdateGameDraft(gomock.Any(), gomock.Any(), gomock.Any()).Return(gorm.ErrRecordNotFound).Times(1)
+	mockGameDAO.EXPECT().UpdateGameDraft(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(gorm.ErrRecordNotFound).Times(1)
 
 	req := &game.UpdateGameDraftRequest{
 		GameDetail: &game.GameDetailWrite{
@@ -61,7 +66,7 @@ func TestUpdateGameDraft_GameNotFound(t *testing.T) {
 		},
 	}
 
-	resp, err := UpdateGameDraft(context.Background(), req)
+	resp, err := UpdateGameDraft(ownerContext(), req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
@@ -69,6 +74,188 @@ func TestUpdateGameDraft_GameNotFound(t *testing.T) {
 	assert.Equal(t, "Game not found", resp.BaseResp.Msg)
 }
 
+// TestUpdateGameDraft_SubmitReview tests that SubmitReview writes the new version in the Reviewing status
+func TestUpdateGameDraft_SubmitReview(t *testing.T) {
+	setupIDGenerator()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().UpdateGameDraft(gomock.Any(), uint64(12345), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uint64, version *ddl.GpGameVersion, _ *int64) error {
+			assert.Equal(t, int(game.GameStatus_Reviewing), version.Status)
+			return nil
+		}).
+		Times(1)
+
+	req := &game.UpdateGameDraftRequest{
+		GameDetail: &game.GameDetailWrite{
+			GameID:      12345,
+			CpID:        1001,
+			GameVersion: completeGameVersion("My Game V2"),
+		},
+		SubmitMode: game.SubmitMode_SubmitReview,
+	}
+
+	resp, err := UpdateGameDraft(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+}
+
+// TestUpdateGameDraft_NewestVersionScheduled tests that a new draft cannot replace a version that is scheduled for publishing
+func TestUpdateGameDraft_NewestVersionScheduled(t *testing.T) {
+	setupIDGenerator()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		UpdateGameDraft(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(fmt.Errorf("%w: the newest version is Scheduled", dao.ErrIllegalStatusTransition)).
+		Times(1)
+
+	req := &game.UpdateGameDraftRequest{
+		GameDetail: &game.GameDetailWrite{
+			GameID: 12345,
+			CpID:   1001,
+			GameVersion: &game.GameVersion{
+				GameName: "My Game V3",
+			},
+		},
+	}
+
+	resp, err := UpdateGameDraft(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10007", resp.BaseResp.Code)
+}
+
+// TestUpdateGameDraft_InPlace tests that the expected revision is passed down and the edited draft is returned
+func TestUpdateGameDraft_InPlace(t *testing.T) {
+	setupIDGenerator()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	expectedRevision := int64(4)
+	mockGameDAO.EXPECT().UpdateGameDraft(gomock.Any(), uint64(12345), gomock.Any(), &expectedRevision).
+		DoAndReturn(func(_ context.Context, _ uint64, version *ddl.GpGameVersion, _ *int64) error {
+			// the DAO edits the existing draft, so the version ID generated by the handler is replaced
+			version.Id = 777
+			version.Revision = 5
+			return nil
+		}).
+		Times(1)
+
+	req := &game.UpdateGameDraftRequest{
+		GameDetail: &game.GameDetailWrite{
+			GameID: 12345,
+			CpID:   1001,
+			GameVersion: &game.GameVersion{
+				GameName: "My Game V2 (autosave)",
+			},
+		},
+		ExpectedRevision: &expectedRevision,
+	}
+
+	resp, err := UpdateGameDraft(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Equal(t, int64(777), resp.GameVersionID)
+	assert.Equal(t, int64(5), resp.Revision)
+}
+
+// TestUpdateGameDraft_Media tests that the gallery is stored in position order and its screenshots replace the introduction images
+func TestUpdateGameDraft_Media(t *testing.T) {
+	setupIDGenerator()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	var stored *ddl.GpGameVersion
+	mockGameDAO.EXPECT().UpdateGameDraft(gomock.Any(), uint64(12345), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uint64, version *ddl.GpGameVersion, _ *int64) error {
+			stored = version
+			return nil
+		}).
+		Times(1)
+
+	req := &game.UpdateGameDraftRequest{
+		GameDetail: &game.GameDetailWrite{
+			GameID: 12345,
+			GameVersion: &game.GameVersion{
+				GameName:               "My Game V2",
+				GameIntroductionImages: []string{"https://cdn.example.com/old.png"},
+				Media: []*game.MediaItem{
+					{Type: game.MediaType_Screenshot, URI: "https://cdn.example.com/2.png", Position: 2},
+					{Type: game.MediaType_TrailerVideo, URI: "https://cdn.example.com/trailer.mp4", ThumbnailURI: "https://cdn.example.com/trailer.png", Position: 0},
+					{Type: game.MediaType_Screenshot, URI: "https://cdn.example.com/1.png", Orientation: game.MediaOrientation_Landscape, AspectRatio: "16:9", Caption: "Boss fight", Position: 1},
+				},
+			},
+		},
+	}
+
+	resp, err := UpdateGameDraft(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Equal(t, `["https://cdn.example.com/1.png","https://cdn.example.com/2.png"]`, stored.GameIntroductionImages)
+	assert.Equal(t, `[{"type":2,"uri":"https://cdn.example.com/trailer.mp4","thumbnail_uri":"https://cdn.example.com/trailer.png","position":0},`+
+		`{"type":1,"uri":"https://cdn.example.com/1.png","orientation":1,"aspect_ratio":"16:9","caption":"Boss fight","position":1},`+
+		`{"type":1,"uri":"https://cdn.example.com/2.png","position":2}]`, stored.Media)
+}
+
+// TestUpdateGameDraft_RevisionConflict tests that a stale write is rejected with the conflict code
+func TestUpdateGameDraft_RevisionConflict(t *testing.T) {
+	setupIDGenerator()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		UpdateGameDraft(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(dao.ErrRevisionConflict).
+		Times(1)
+
+	expectedRevision := int64(3)
+	req := &game.UpdateGameDraftRequest{
+		GameDetail: &game.GameDetailWrite{
+			GameID: 12345,
+			CpID:   1001,
+			GameVersion: &game.GameVersion{
+				GameName: "My Game V2",
+			},
+		},
+		ExpectedRevision: &expectedRevision,
+	}
+
+	resp, err := UpdateGameDraft(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10008", resp.BaseResp.Code)
+}
+
 // TestUpdateGameDraft_FailWithZeroGameID tests the failure case when GameID is zero
 func TestUpdateGameDraft_FailWithZeroGameID(t *testing.T) {
 	req := &game.UpdateGameDraftRequest{
@@ -145,10 +332,11 @@ func TestUpdateGameDraft_OtherError(t *testing.T) {
 
 	mockGameDAO := mock.NewMockIGameDAO(ctrl)
 	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
 
 	otherError := errors.New("database connection error")
 	mockGameDAO.EXPECT().
-		UpdateGameDraft(gomock.Any(), gomock.Any(), gomock.Any()).
+		UpdateGameDraft(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(otherError).
 		Times(1)
 
@@ -162,10 +350,48 @@ func TestUpdateGameDraft_OtherError(t *testing.T) {
 		},
 	}
 
-	resp, err := UpdateGameDraft(context.Background(), req)
+	resp, err := UpdateGameDraft(ownerContext(), req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
 	assert.Equal(t, "500", resp.BaseResp.Code)
 	assert.Contains(t, resp.BaseResp.Msg, "Internal Server Error")
 }
+
+// TestUpdateGameDraft_UnknownTag tests that a draft carrying a tag that does not exist is rejected with a field error
+func TestUpdateGameDraft_UnknownTag(t *testing.T) {
+	setupIDGenerator()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().UpdateGameDraft(gomock.Any(), uint64(12345), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uint64, version *ddl.GpGameVersion, _ *int64) error {
+			assert.Equal(t, uint64(11), version.CategoryId)
+			assert.Equal(t, "[21,99]", version.TagIds)
+			return fmt.Errorf("%w: 99", dao.ErrTagNotFound)
+		}).
+		Times(1)
+
+	req := &game.UpdateGameDraftRequest{
+		GameDetail: &game.GameDetailWrite{
+			GameID: 12345,
+			CpID:   1001,
+			GameVersion: &game.GameVersion{
+				GameName:   "My Game V2",
+				CategoryID: 11,
+				TagIDs:     []int64{21, 99},
+			},
+		},
+	}
+
+	resp, err := UpdateGameDraft(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+	assert.Len(t, resp.FieldErrors, 1)
+	assert.Equal(t, "tag_ids", resp.FieldErrors[0].Field)
+}
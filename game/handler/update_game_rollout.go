@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/service"
+	"github.com/yitter/idgenerator-go/idgen"
+	"gorm.io/gorm"
+)
+
+// UpdateGameRollout raises, pauses, resumes or rolls back the staged rollout of a game version.
+func UpdateGameRollout(ctx context.Context, req *game.UpdateGameRolloutRequest) (*game.UpdateGameRolloutResponse, error) {
+	// --- 1. 参数校验 ---
+	if req.GameID <= 0 || req.GameVersionID <= 0 {
+		return &game.UpdateGameRolloutResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid GameID or GameVersionID"},
+		}, nil
+	}
+	switch req.Action {
+	case game.RolloutAction_Raise:
+		if !req.IsSetPercentage() || req.GetPercentage() < 1 || req.GetPercentage() > 100 {
+			return &game.UpdateGameRolloutResponse{
+				BaseResp: &common.BaseResp{Code: "400", Msg: "Percentage between 1 and 100 is required to raise a rollout"},
+			}, nil
+		}
+	case game.RolloutAction_Pause, game.RolloutAction_Resume, game.RolloutAction_Rollback:
+		if req.IsSetPercentage() {
+			return &game.UpdateGameRolloutResponse{
+				BaseResp: &common.BaseResp{Code: "400", Msg: "Percentage can only be set to raise a rollout"},
+			}, nil
+		}
+	default:
+		return &game.UpdateGameRolloutResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid rollout action"},
+		}, nil
+	}
+	if strings.TrimSpace(req.Reason) == "" || strings.TrimSpace(req.Operator) == "" {
+		return &game.UpdateGameRolloutResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Reason and Operator are required to change a rollout"},
+		}, nil
+	}
+
+	// 只有游戏所属的 CP 可以操作该游戏
+	if baseResp := authorizeGameOwner(ctx, uint64(req.GameID), 0); baseResp != nil {
+		return &game.UpdateGameRolloutResponse{BaseResp: baseResp}, nil
+	}
+
+	rolloutLog := &ddl.GpGameRolloutLog{
+		Id:       uint64(idgen.NextId()),
+		Operator: req.Operator,
+		Reason:   req.Reason,
+	}
+
+	// --- 2. 调用 DAO 层调整灰度 ---
+	gameDdl, err := GameDao.UpdateGameRollout(ctx, uint64(req.GameID), uint64(req.GameVersionID), req.Action, int(req.GetPercentage()), rolloutLog)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &game.UpdateGameRolloutResponse{
+				BaseResp: &common.BaseResp{Code: "10001", Msg: "Game not found"},
+			}, nil
+		}
+		// 该版本没有进行中的灰度，可能已全量发布、已回滚或被更新的版本取代
+		if errors.Is(err, dao.ErrNoActiveRollout) {
+			return &game.UpdateGameRolloutResponse{
+				BaseResp: &common.BaseResp{Code: "10022", Msg: err.Error()},
+			}, nil
+		}
+		// 比例只能提高，暂停中的灰度须先恢复
+		if errors.Is(err, dao.ErrIllegalRolloutChange) {
+			return &game.UpdateGameRolloutResponse{
+				BaseResp: &common.BaseResp{Code: "10023", Msg: err.Error()},
+			}, nil
+		}
+		return &game.UpdateGameRolloutResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to update game rollout: " + err.Error()},
+		}, nil
+	}
+
+	// 全量发布会切换上线版本
+	RefreshSearchIndex(ctx, uint64(req.GameID))
+
+	// --- 3. 构建并返回成功的响应 ---
+	return &game.UpdateGameRolloutResponse{
+		Rollout:  service.ConvertDdlToGameRollout(gameDdl),
+		BaseResp: &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
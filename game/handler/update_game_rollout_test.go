@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUpdateGameRollout_Raise tests raising a rollout and the returned rollout state
+func TestUpdateGameRollout_Raise(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	gameID := uint64(101)
+	versionID := uint64(201)
+
+	mockGameDAO.EXPECT().
+		UpdateGameRollout(gomock.Any(), gameID, versionID, game.RolloutAction_Raise, 50, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ uint64, _ game.RolloutAction, _ int, rolloutLog *ddl.GpGameRolloutLog) (*ddl.GpGame, error) {
+			assert.NotZero(t, rolloutLog.Id)
+			assert.Equal(t, "ops_alice", rolloutLog.Operator)
+			assert.Equal(t, "no crashes at 10%", rolloutLog.Reason)
+			return &ddl.GpGame{
+				Id:                  gameID,
+				OnlineGameVersionId: 200,
+				RolloutVersionId:    versionID,
+				RolloutPercentage:   50,
+				RolloutState:        int(game.RolloutState_Active),
+			}, nil
+		}).
+		Times(1)
+
+	percentage := int32(50)
+	req := &game.UpdateGameRolloutRequest{
+		GameID:        int64(gameID),
+		GameVersionID: int64(versionID),
+		Action:        game.RolloutAction_Raise,
+		Percentage:    &percentage,
+		Reason:        "no crashes at 10%",
+		Operator:      "ops_alice",
+	}
+
+	resp, err := UpdateGameRollout(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Equal(t, &game.GameRollout{
+		GameVersionID:     201,
+		Percentage:        50,
+		State:             game.RolloutState_Active,
+		BaseGameVersionID: 200,
+	}, resp.Rollout)
+}
+
+// TestUpdateGameRollout_Complete tests that a rollout raised to 100% returns no rollout state
+func TestUpdateGameRollout_Complete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		UpdateGameRollout(gomock.Any(), uint64(101), uint64(201), game.RolloutAction_Raise, 100, gomock.Any()).
+		Return(&ddl.GpGame{Id: 101, OnlineGameVersionId: 201}, nil).
+		Times(1)
+
+	percentage := int32(100)
+	req := &game.UpdateGameRolloutRequest{
+		GameID:        101,
+		GameVersionID: 201,
+		Action:        game.RolloutAction_Raise,
+		Percentage:    &percentage,
+		Reason:        "healthy",
+		Operator:      "ops_alice",
+	}
+
+	resp, err := UpdateGameRollout(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+	assert.Nil(t, resp.Rollout)
+}
+
+// TestUpdateGameRollout_InvalidRequest tests the parameter checks made before touching the game
+func TestUpdateGameRollout_InvalidRequest(t *testing.T) {
+	zero, ten := int32(0), int32(10)
+	cases := []struct {
+		name string
+		req  *game.UpdateGameRolloutRequest
+		msg  string
+	}{
+		{"missing version", &game.UpdateGameRolloutRequest{GameID: 101, Action: game.RolloutAction_Pause, Reason: "r", Operator: "o"}, "Invalid GameID or GameVersionID"},
+		{"raise without percentage", &game.UpdateGameRolloutRequest{GameID: 101, GameVersionID: 201, Action: game.RolloutAction_Raise, Reason: "r", Operator: "o"}, "Percentage between 1 and 100"},
+		{"raise to zero", &game.UpdateGameRolloutRequest{GameID: 101, GameVersionID: 201, Action: game.RolloutAction_Raise, Percentage: &zero, Reason: "r", Operator: "o"}, "Percentage between 1 and 100"},
+		{"pause with percentage", &game.UpdateGameRolloutRequest{GameID: 101, GameVersionID: 201, Action: game.RolloutAction_Pause, Percentage: &ten, Reason: "r", Operator: "o"}, "Percentage can only be set"},
+		{"start", &game.UpdateGameRolloutRequest{GameID: 101, GameVersionID: 201, Action: game.RolloutAction_Start, Reason: "r", Operator: "o"}, "Invalid rollout action"},
+		{"missing reason", &game.UpdateGameRolloutRequest{GameID: 101, GameVersionID: 201, Action: game.RolloutAction_Rollback, Reason: " ", Operator: "o"}, "Reason and Operator are required"},
+	}
+
+	for _, c := range cases {
+		resp, err := UpdateGameRollout(context.Background(), c.req)
+
+		assert.NoError(t, err, c.name)
+		assert.Equal(t, "400", resp.BaseResp.Code, c.name)
+		assert.Contains(t, resp.BaseResp.Msg, c.msg, c.name)
+	}
+}
+
+// TestUpdateGameRollout_DaoErrors tests the codes returned for a version without a rollout and an illegal change
+func TestUpdateGameRollout_DaoErrors(t *testing.T) {
+	cases := []struct {
+		err  error
+		code string
+	}{
+		{dao.ErrNoActiveRollout, "10022"},
+		{dao.ErrIllegalRolloutChange, "10023"},
+	}
+
+	for _, c := range cases {
+		ctrl := gomock.NewController(t)
+		mockGameDAO := mock.NewMockIGameDAO(ctrl)
+		GameDao = mockGameDAO
+		expectGameOwner(mockGameDAO)
+
+		mockGameDAO.EXPECT().
+			UpdateGameRollout(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, c.err).
+			Times(1)
+
+		req := &game.UpdateGameRolloutRequest{
+			GameID:        101,
+			GameVersionID: 201,
+			Action:        game.RolloutAction_Resume,
+			Reason:        "crash rate is back to normal",
+			Operator:      "ops_alice",
+		}
+
+		resp, err := UpdateGameRollout(ownerContext(), req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, c.code, resp.BaseResp.Code)
+		ctrl.Finish()
+	}
+}
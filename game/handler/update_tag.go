@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+)
+
+// UpdateTag renames a tag.
+func UpdateTag(ctx context.Context, req *game.UpdateTagRequest) (*game.UpdateTagResponse, error) {
+	// --- 1. 参数校验 ---
+	if req.TagID <= 0 {
+		return &game.UpdateTagResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid TagID"},
+		}, nil
+	}
+	name, baseResp := normalizeTaxonomyName(req.Name)
+	if baseResp != nil {
+		return &game.UpdateTagResponse{BaseResp: baseResp}, nil
+	}
+
+	// --- 2. 调用 DAO 层修改标签 ---
+	if err := GameDao.UpdateTag(ctx, uint64(req.TagID), name); err != nil {
+		return &game.UpdateTagResponse{BaseResp: taxonomyErrorResp(err, "update tag")}, nil
+	}
+
+	// --- 3. 构建并返回成功的响应 ---
+	return &game.UpdateTagResponse{
+		BaseResp: &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
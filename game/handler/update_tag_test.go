@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUpdateTag_Success tests that a tag is renamed
+func TestUpdateTag_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().UpdateTag(gomock.Any(), uint64(21), "Co-op").Return(nil).Times(1)
+
+	resp, err := UpdateTag(context.Background(), &game.UpdateTagRequest{TagID: 21, Name: "Co-op"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+}
+
+// TestUpdateTag_NotFound tests renaming a tag that does not exist
+func TestUpdateTag_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+
+	mockGameDAO.EXPECT().UpdateTag(gomock.Any(), gomock.Any(), gomock.Any()).Return(dao.ErrTagNotFound).Times(1)
+
+	resp, err := UpdateTag(context.Background(), &game.UpdateTagRequest{TagID: 999, Name: "Co-op"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "10018", resp.BaseResp.Code)
+}
+
+// TestUpdateTag_InvalidID tests the failure case when TagID is invalid
+func TestUpdateTag_InvalidID(t *testing.T) {
+	resp, err := UpdateTag(context.Background(), &game.UpdateTagRequest{Name: "Co-op"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/service"
+	"github.com/GameLaunchPad/game_management_project/game/validation"
+)
+
+// VersionValidator checks the content written by CreateGameDetail, UpdateGameDraft and SubmitGameVersion.
+// Extra rules can be registered on it before the server starts.
+var VersionValidator = validation.Default()
+
+// validateGameVersion runs VersionValidator over version. It returns the field errors and the response
+// to send back when the version is invalid, and nil, nil otherwise.
+func validateGameVersion(version *game.GameVersion, mode validation.Mode) ([]*game.FieldError, *common.BaseResp) {
+	fieldErrors := VersionValidator.Validate(version, mode)
+	if len(fieldErrors) == 0 {
+		return nil, nil
+	}
+	return service.ConvertFieldErrorsToGame(fieldErrors), &common.BaseResp{Code: "400", Msg: "Invalid game version content"}
+}
+
+// validationModeOf maps the SubmitMode of a create or update request to how strictly its content is checked.
+func validationModeOf(mode game.SubmitMode) validation.Mode {
+	if mode == game.SubmitMode_SubmitReview {
+		return validation.ModeSubmit
+	}
+	return validation.ModeDraft
+}
+
+// contentFieldErrors reports a write rejected because of content only the DAO can check, a category or tag
+// that does not exist or release notes missing for a released game, as a field error, and returns nil for
+// any other error.
+func contentFieldErrors(err error) []*game.FieldError {
+	switch {
+	case errors.Is(err, dao.ErrCategoryNotFound):
+		return []*game.FieldError{{Field: "category_id", Code: validation.CodeInvalidValue, Message: err.Error()}}
+	case errors.Is(err, dao.ErrTagNotFound):
+		return []*game.FieldError{{Field: "tag_ids", Code: validation.CodeInvalidValue, Message: err.Error()}}
+	case errors.Is(err, dao.ErrReleaseNotesRequired):
+		return []*game.FieldError{{Field: "release_notes", Code: validation.CodeRequired, Message: err.Error()}}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// completeGameVersion returns content that may be submitted for review.
+func completeGameVersion(name string) *game.GameVersion {
+	return &game.GameVersion{
+		GameName:         name,
+		GameIcon:         "https://cdn.example.com/icon.png",
+		HeaderImage:      "https://cdn.example.com/header.png",
+		GameIntroduction: "Match three.",
+		GamePlatforms:    []game.GamePlatform{game.GamePlatform_Android},
+		PackageName:      "com.happy.elimination",
+		DownloadURL:      "https://download.example.com/happy.apk",
+	}
+}
+
+// TestCreateGameDetail_IncompleteSubmit tests that content submitted for review on create must be complete
+func TestCreateGameDetail_IncompleteSubmit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	GameDao = mock.NewMockIGameDAO(ctrl)
+
+	req := &game.CreateGameDetailRequest{
+		GameDetail: &game.GameDetailWrite{
+			GameVersion: &game.GameVersion{GameName: "My First Game"},
+		},
+		SubmitMode: game.SubmitMode_SubmitReview,
+	}
+
+	resp, err := CreateGameDetail(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+	var fields []string
+	for _, fe := range resp.FieldErrors {
+		fields = append(fields, fe.Field)
+	}
+	assert.Equal(t, []string{"game_icon", "header_image", "game_introduction", "game_platforms"}, fields)
+}
+
+// TestUpdateGameDraft_DraftTooLong tests that even a draft is rejected when it does not fit the storage
+func TestUpdateGameDraft_DraftTooLong(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	GameDao = mock.NewMockIGameDAO(ctrl)
+
+	req := &game.UpdateGameDraftRequest{
+		GameDetail: &game.GameDetailWrite{
+			GameID: 12345,
+			GameVersion: &game.GameVersion{
+				GameIcon:      "https://cdn.example.com/" + strings.Repeat("a", 512),
+				GamePlatforms: []game.GamePlatform{game.GamePlatform_Unset},
+			},
+		},
+	}
+
+	resp, err := UpdateGameDraft(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+	assert.Equal(t, []*game.FieldError{
+		{Field: "game_icon", Code: "too_long", Message: "game_icon must be at most 512 long"},
+		{Field: "game_platforms[0]", Code: "invalid_value", Message: "platform 0 is not a valid platform"},
+	}, resp.FieldErrors)
+}
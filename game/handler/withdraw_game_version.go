@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"gorm.io/gorm"
+)
+
+// WithdrawGameVersion takes a version that is under review back to Draft.
+func WithdrawGameVersion(ctx context.Context, req *game.WithdrawGameVersionRequest) (*game.WithdrawGameVersionResponse, error) {
+	// --- 1. 参数校验 ---
+	if req.GameID <= 0 || req.GameVersionID <= 0 {
+		return &game.WithdrawGameVersionResponse{
+			BaseResp: &common.BaseResp{Code: "400", Msg: "Invalid GameID or GameVersionID"},
+		}, nil
+	}
+
+	// 只有游戏所属的 CP 可以操作该游戏
+	if baseResp := authorizeGameOwner(ctx, uint64(req.GameID), 0); baseResp != nil {
+		return &game.WithdrawGameVersionResponse{BaseResp: baseResp}, nil
+	}
+
+	// --- 2. 调用 DAO 层撤回审核 ---
+	err := GameDao.WithdrawGameVersion(ctx, uint64(req.GameID), uint64(req.GameVersionID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &game.WithdrawGameVersionResponse{
+				BaseResp: &common.BaseResp{Code: "10002", Msg: "Game or Version not found"},
+			}, nil
+		}
+		if errors.Is(err, dao.ErrIllegalStatusTransition) {
+			return &game.WithdrawGameVersionResponse{
+				BaseResp: &common.BaseResp{Code: "10007", Msg: err.Error()},
+			}, nil
+		}
+		return &game.WithdrawGameVersionResponse{
+			BaseResp: &common.BaseResp{Code: "500", Msg: "Failed to withdraw game version: " + err.Error()},
+		}, nil
+	}
+
+	RefreshSearchIndex(ctx, uint64(req.GameID))
+
+	// --- 3. 构建并返回成功的响应 ---
+	return &game.WithdrawGameVersionResponse{
+		BaseResp: &common.BaseResp{Code: "200", Msg: "Success"},
+	}, nil
+}
@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// TestWithdrawGameVersion_Success tests that a version under review is withdrawn back to draft
+func TestWithdrawGameVersion_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		WithdrawGameVersion(gomock.Any(), uint64(101), uint64(201)).
+		Return(nil).
+		Times(1)
+
+	req := &game.WithdrawGameVersionRequest{
+		GameID:        101,
+		GameVersionID: 201,
+	}
+
+	resp, err := WithdrawGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "200", resp.BaseResp.Code)
+}
+
+// TestWithdrawGameVersion_InvalidIDs tests the failure case when GameID or GameVersionID is invalid
+func TestWithdrawGameVersion_InvalidIDs(t *testing.T) {
+	req := &game.WithdrawGameVersionRequest{
+		GameID:        101,
+		GameVersionID: 0,
+	}
+
+	resp, err := WithdrawGameVersion(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "400", resp.BaseResp.Code)
+}
+
+// TestWithdrawGameVersion_IllegalTransition tests that only a version under review can be withdrawn
+func TestWithdrawGameVersion_IllegalTransition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		WithdrawGameVersion(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(dao.CheckTransition(game.GameStatus_Published, game.GameStatus_Draft)).
+		Times(1)
+
+	req := &game.WithdrawGameVersionRequest{
+		GameID:        101,
+		GameVersionID: 201,
+	}
+
+	resp, err := WithdrawGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10007", resp.BaseResp.Code)
+}
+
+// TestWithdrawGameVersion_NotFound tests the scenario where the game or version is not found
+func TestWithdrawGameVersion_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		WithdrawGameVersion(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(gorm.ErrRecordNotFound).
+		Times(1)
+
+	req := &game.WithdrawGameVersionRequest{
+		GameID:        999,
+		GameVersionID: 9999,
+	}
+
+	resp, err := WithdrawGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "10002", resp.BaseResp.Code)
+}
+
+// TestWithdrawGameVersion_DaoError tests the scenario where the DAO returns a general error
+func TestWithdrawGameVersion_DaoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	GameDao = mockGameDAO
+	expectGameOwner(mockGameDAO)
+
+	mockGameDAO.EXPECT().
+		WithdrawGameVersion(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errors.New("database connection error")).
+		Times(1)
+
+	req := &game.WithdrawGameVersionRequest{
+		GameID:        101,
+		GameVersionID: 201,
+	}
+
+	resp, err := WithdrawGameVersion(ownerContext(), req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "500", resp.BaseResp.Code)
+}
@@ -10,6 +10,157 @@ import (
 	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/common"
 )
 
+type OnlineStatus int64
+
+const (
+	OnlineStatus_Unset       OnlineStatus = 0
+	OnlineStatus_Online      OnlineStatus = 1
+	OnlineStatus_NeverOnline OnlineStatus = 2
+	OnlineStatus_TakenDown   OnlineStatus = 3
+)
+
+func (p OnlineStatus) String() string {
+	switch p {
+	case OnlineStatus_Unset:
+		return "Unset"
+	case OnlineStatus_Online:
+		return "Online"
+	case OnlineStatus_NeverOnline:
+		return "NeverOnline"
+	case OnlineStatus_TakenDown:
+		return "TakenDown"
+	}
+	return "<UNSET>"
+}
+
+func OnlineStatusFromString(s string) (OnlineStatus, error) {
+	switch s {
+	case "Unset":
+		return OnlineStatus_Unset, nil
+	case "Online":
+		return OnlineStatus_Online, nil
+	case "NeverOnline":
+		return OnlineStatus_NeverOnline, nil
+	case "TakenDown":
+		return OnlineStatus_TakenDown, nil
+	}
+	return OnlineStatus(0), fmt.Errorf("not a valid OnlineStatus string")
+}
+
+func OnlineStatusPtr(v OnlineStatus) *OnlineStatus { return &v }
+func (p *OnlineStatus) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = OnlineStatus(result.Int64)
+	return
+}
+
+func (p *OnlineStatus) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type GameListSortField int64
+
+const (
+	GameListSortField_Unset      GameListSortField = 0
+	GameListSortField_CreateTime GameListSortField = 1
+	GameListSortField_UpdateTime GameListSortField = 2
+	GameListSortField_GameName   GameListSortField = 3
+)
+
+func (p GameListSortField) String() string {
+	switch p {
+	case GameListSortField_Unset:
+		return "Unset"
+	case GameListSortField_CreateTime:
+		return "CreateTime"
+	case GameListSortField_UpdateTime:
+		return "UpdateTime"
+	case GameListSortField_GameName:
+		return "GameName"
+	}
+	return "<UNSET>"
+}
+
+func GameListSortFieldFromString(s string) (GameListSortField, error) {
+	switch s {
+	case "Unset":
+		return GameListSortField_Unset, nil
+	case "CreateTime":
+		return GameListSortField_CreateTime, nil
+	case "UpdateTime":
+		return GameListSortField_UpdateTime, nil
+	case "GameName":
+		return GameListSortField_GameName, nil
+	}
+	return GameListSortField(0), fmt.Errorf("not a valid GameListSortField string")
+}
+
+func GameListSortFieldPtr(v GameListSortField) *GameListSortField { return &v }
+func (p *GameListSortField) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = GameListSortField(result.Int64)
+	return
+}
+
+func (p *GameListSortField) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type SortOrder int64
+
+const (
+	SortOrder_Unset SortOrder = 0
+	SortOrder_Desc  SortOrder = 1
+	SortOrder_Asc   SortOrder = 2
+)
+
+func (p SortOrder) String() string {
+	switch p {
+	case SortOrder_Unset:
+		return "Unset"
+	case SortOrder_Desc:
+		return "Desc"
+	case SortOrder_Asc:
+		return "Asc"
+	}
+	return "<UNSET>"
+}
+
+func SortOrderFromString(s string) (SortOrder, error) {
+	switch s {
+	case "Unset":
+		return SortOrder_Unset, nil
+	case "Desc":
+		return SortOrder_Desc, nil
+	case "Asc":
+		return SortOrder_Asc, nil
+	}
+	return SortOrder(0), fmt.Errorf("not a valid SortOrder string")
+}
+
+func SortOrderPtr(v SortOrder) *SortOrder { return &v }
+func (p *SortOrder) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = SortOrder(result.Int64)
+	return
+}
+
+func (p *SortOrder) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
 type GameStatus int64
 
 const (
@@ -18,6 +169,9 @@ const (
 	GameStatus_Reviewing GameStatus = 2
 	GameStatus_Published GameStatus = 3
 	GameStatus_Rejected  GameStatus = 4
+	GameStatus_Scheduled GameStatus = 5
+	GameStatus_Offline   GameStatus = 6
+	GameStatus_Deleted   GameStatus = 7
 )
 
 func (p GameStatus) String() string {
@@ -32,6 +186,12 @@ func (p GameStatus) String() string {
 		return "Published"
 	case GameStatus_Rejected:
 		return "Rejected"
+	case GameStatus_Scheduled:
+		return "Scheduled"
+	case GameStatus_Offline:
+		return "Offline"
+	case GameStatus_Deleted:
+		return "Deleted"
 	}
 	return "<UNSET>"
 }
@@ -48,6 +208,12 @@ func GameStatusFromString(s string) (GameStatus, error) {
 		return GameStatus_Published, nil
 	case "Rejected":
 		return GameStatus_Rejected, nil
+	case "Scheduled":
+		return GameStatus_Scheduled, nil
+	case "Offline":
+		return GameStatus_Offline, nil
+	case "Deleted":
+		return GameStatus_Deleted, nil
 	}
 	return GameStatus(0), fmt.Errorf("not a valid GameStatus string")
 }
@@ -67,6 +233,157 @@ func (p *GameStatus) Value() (driver.Value, error) {
 	return int64(*p), nil
 }
 
+type RolloutState int64
+
+const (
+	RolloutState_Unset  RolloutState = 0
+	RolloutState_Active RolloutState = 1
+	RolloutState_Paused RolloutState = 2
+)
+
+func (p RolloutState) String() string {
+	switch p {
+	case RolloutState_Unset:
+		return "Unset"
+	case RolloutState_Active:
+		return "Active"
+	case RolloutState_Paused:
+		return "Paused"
+	}
+	return "<UNSET>"
+}
+
+func RolloutStateFromString(s string) (RolloutState, error) {
+	switch s {
+	case "Unset":
+		return RolloutState_Unset, nil
+	case "Active":
+		return RolloutState_Active, nil
+	case "Paused":
+		return RolloutState_Paused, nil
+	}
+	return RolloutState(0), fmt.Errorf("not a valid RolloutState string")
+}
+
+func RolloutStatePtr(v RolloutState) *RolloutState { return &v }
+func (p *RolloutState) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = RolloutState(result.Int64)
+	return
+}
+
+func (p *RolloutState) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type MediaType int64
+
+const (
+	MediaType_Unset        MediaType = 0
+	MediaType_Screenshot   MediaType = 1
+	MediaType_TrailerVideo MediaType = 2
+	MediaType_PromoArt     MediaType = 3
+)
+
+func (p MediaType) String() string {
+	switch p {
+	case MediaType_Unset:
+		return "Unset"
+	case MediaType_Screenshot:
+		return "Screenshot"
+	case MediaType_TrailerVideo:
+		return "TrailerVideo"
+	case MediaType_PromoArt:
+		return "PromoArt"
+	}
+	return "<UNSET>"
+}
+
+func MediaTypeFromString(s string) (MediaType, error) {
+	switch s {
+	case "Unset":
+		return MediaType_Unset, nil
+	case "Screenshot":
+		return MediaType_Screenshot, nil
+	case "TrailerVideo":
+		return MediaType_TrailerVideo, nil
+	case "PromoArt":
+		return MediaType_PromoArt, nil
+	}
+	return MediaType(0), fmt.Errorf("not a valid MediaType string")
+}
+
+func MediaTypePtr(v MediaType) *MediaType { return &v }
+func (p *MediaType) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = MediaType(result.Int64)
+	return
+}
+
+func (p *MediaType) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type MediaOrientation int64
+
+const (
+	MediaOrientation_Unset     MediaOrientation = 0
+	MediaOrientation_Landscape MediaOrientation = 1
+	MediaOrientation_Portrait  MediaOrientation = 2
+	MediaOrientation_Square    MediaOrientation = 3
+)
+
+func (p MediaOrientation) String() string {
+	switch p {
+	case MediaOrientation_Unset:
+		return "Unset"
+	case MediaOrientation_Landscape:
+		return "Landscape"
+	case MediaOrientation_Portrait:
+		return "Portrait"
+	case MediaOrientation_Square:
+		return "Square"
+	}
+	return "<UNSET>"
+}
+
+func MediaOrientationFromString(s string) (MediaOrientation, error) {
+	switch s {
+	case "Unset":
+		return MediaOrientation_Unset, nil
+	case "Landscape":
+		return MediaOrientation_Landscape, nil
+	case "Portrait":
+		return MediaOrientation_Portrait, nil
+	case "Square":
+		return MediaOrientation_Square, nil
+	}
+	return MediaOrientation(0), fmt.Errorf("not a valid MediaOrientation string")
+}
+
+func MediaOrientationPtr(v MediaOrientation) *MediaOrientation { return &v }
+func (p *MediaOrientation) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = MediaOrientation(result.Int64)
+	return
+}
+
+func (p *MediaOrientation) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
 type GamePlatform int64
 
 const (
@@ -213,11 +530,80 @@ func (p *ReviewResult_) Value() (driver.Value, error) {
 	return int64(*p), nil
 }
 
+type RolloutAction int64
+
+const (
+	RolloutAction_Unset    RolloutAction = 0
+	RolloutAction_Start    RolloutAction = 1
+	RolloutAction_Raise    RolloutAction = 2
+	RolloutAction_Pause    RolloutAction = 3
+	RolloutAction_Resume   RolloutAction = 4
+	RolloutAction_Rollback RolloutAction = 5
+	RolloutAction_Complete RolloutAction = 6
+)
+
+func (p RolloutAction) String() string {
+	switch p {
+	case RolloutAction_Unset:
+		return "Unset"
+	case RolloutAction_Start:
+		return "Start"
+	case RolloutAction_Raise:
+		return "Raise"
+	case RolloutAction_Pause:
+		return "Pause"
+	case RolloutAction_Resume:
+		return "Resume"
+	case RolloutAction_Rollback:
+		return "Rollback"
+	case RolloutAction_Complete:
+		return "Complete"
+	}
+	return "<UNSET>"
+}
+
+func RolloutActionFromString(s string) (RolloutAction, error) {
+	switch s {
+	case "Unset":
+		return RolloutAction_Unset, nil
+	case "Start":
+		return RolloutAction_Start, nil
+	case "Raise":
+		return RolloutAction_Raise, nil
+	case "Pause":
+		return RolloutAction_Pause, nil
+	case "Resume":
+		return RolloutAction_Resume, nil
+	case "Rollback":
+		return RolloutAction_Rollback, nil
+	case "Complete":
+		return RolloutAction_Complete, nil
+	}
+	return RolloutAction(0), fmt.Errorf("not a valid RolloutAction string")
+}
+
+func RolloutActionPtr(v RolloutAction) *RolloutAction { return &v }
+func (p *RolloutAction) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = RolloutAction(result.Int64)
+	return
+}
+
+func (p *RolloutAction) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
 type GetGameListRequest struct {
-	Filter   *GameListFilter `thrift:"Filter,1,optional" frugal:"1,optional,GameListFilter" json:"Filter,omitempty"`
-	Sorter   *GameListSorter `thrift:"Sorter,2,optional" frugal:"2,optional,GameListSorter" json:"Sorter,omitempty"`
-	PageNum  int32           `thrift:"PageNum,3" frugal:"3,default,i32" json:"PageNum"`
-	PageSize int32           `thrift:"PageSize,4" frugal:"4,default,i32" json:"PageSize"`
+	Filter    *GameListFilter `thrift:"Filter,1,optional" frugal:"1,optional,GameListFilter" json:"Filter,omitempty"`
+	Sorter    *GameListSorter `thrift:"Sorter,2,optional" frugal:"2,optional,GameListSorter" json:"Sorter,omitempty"`
+	PageNum   int32           `thrift:"PageNum,3" frugal:"3,default,i32" json:"PageNum"`
+	PageSize  int32           `thrift:"PageSize,4" frugal:"4,default,i32" json:"PageSize"`
+	Cursor    *string         `thrift:"Cursor,5,optional" frugal:"5,optional,string" json:"Cursor,omitempty"`
+	NeedTotal *bool           `thrift:"NeedTotal,6,optional" frugal:"6,optional,bool" json:"NeedTotal,omitempty"`
 }
 
 func NewGetGameListRequest() *GetGameListRequest {
@@ -252,6 +638,24 @@ func (p *GetGameListRequest) GetPageNum() (v int32) {
 func (p *GetGameListRequest) GetPageSize() (v int32) {
 	return p.PageSize
 }
+
+var GetGameListRequest_Cursor_DEFAULT string
+
+func (p *GetGameListRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return GetGameListRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+var GetGameListRequest_NeedTotal_DEFAULT bool
+
+func (p *GetGameListRequest) GetNeedTotal() (v bool) {
+	if !p.IsSetNeedTotal() {
+		return GetGameListRequest_NeedTotal_DEFAULT
+	}
+	return *p.NeedTotal
+}
 func (p *GetGameListRequest) SetFilter(val *GameListFilter) {
 	p.Filter = val
 }
@@ -264,6 +668,12 @@ func (p *GetGameListRequest) SetPageNum(val int32) {
 func (p *GetGameListRequest) SetPageSize(val int32) {
 	p.PageSize = val
 }
+func (p *GetGameListRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *GetGameListRequest) SetNeedTotal(val *bool) {
+	p.NeedTotal = val
+}
 
 func (p *GetGameListRequest) IsSetFilter() bool {
 	return p.Filter != nil
@@ -273,6 +683,14 @@ func (p *GetGameListRequest) IsSetSorter() bool {
 	return p.Sorter != nil
 }
 
+func (p *GetGameListRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *GetGameListRequest) IsSetNeedTotal() bool {
+	return p.NeedTotal != nil
+}
+
 func (p *GetGameListRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -285,10 +703,22 @@ var fieldIDToName_GetGameListRequest = map[int16]string{
 	2: "Sorter",
 	3: "PageNum",
 	4: "PageSize",
+	5: "Cursor",
+	6: "NeedTotal",
 }
 
 type GameListFilter struct {
-	FilterText *string `thrift:"FilterText,1,optional" frugal:"1,optional,string" json:"FilterText,omitempty"`
+	FilterText      *string        `thrift:"FilterText,1,optional" frugal:"1,optional,string" json:"FilterText,omitempty"`
+	CpID            *int64         `thrift:"CpID,2,optional" frugal:"2,optional,i64" json:"CpID,omitempty"`
+	NewestStatus_   []GameStatus   `thrift:"NewestStatus,3,optional" frugal:"3,optional,list<GameStatus>" json:"NewestStatus,omitempty"`
+	OnlineStatus    *OnlineStatus  `thrift:"OnlineStatus,4,optional" frugal:"4,optional,OnlineStatus" json:"OnlineStatus,omitempty"`
+	Platforms       []GamePlatform `thrift:"Platforms,5,optional" frugal:"5,optional,list<GamePlatform>" json:"Platforms,omitempty"`
+	CreateTimeStart *int64         `thrift:"CreateTimeStart,6,optional" frugal:"6,optional,i64" json:"CreateTimeStart,omitempty"`
+	CreateTimeEnd   *int64         `thrift:"CreateTimeEnd,7,optional" frugal:"7,optional,i64" json:"CreateTimeEnd,omitempty"`
+	UpdateTimeStart *int64         `thrift:"UpdateTimeStart,8,optional" frugal:"8,optional,i64" json:"UpdateTimeStart,omitempty"`
+	UpdateTimeEnd   *int64         `thrift:"UpdateTimeEnd,9,optional" frugal:"9,optional,i64" json:"UpdateTimeEnd,omitempty"`
+	CategoryID      *int64         `thrift:"CategoryID,10,optional" frugal:"10,optional,i64" json:"CategoryID,omitempty"`
+	TagIDs          []int64        `thrift:"TagIDs,11,optional" frugal:"11,optional,list<i64>" json:"TagIDs,omitempty"`
 }
 
 func NewGameListFilter() *GameListFilter {
@@ -306,102 +736,333 @@ func (p *GameListFilter) GetFilterText() (v string) {
 	}
 	return *p.FilterText
 }
-func (p *GameListFilter) SetFilterText(val *string) {
-	p.FilterText = val
-}
 
-func (p *GameListFilter) IsSetFilterText() bool {
-	return p.FilterText != nil
-}
+var GameListFilter_CpID_DEFAULT int64
 
-func (p *GameListFilter) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *GameListFilter) GetCpID() (v int64) {
+	if !p.IsSetCpID() {
+		return GameListFilter_CpID_DEFAULT
 	}
-	return fmt.Sprintf("GameListFilter(%+v)", *p)
+	return *p.CpID
 }
 
-var fieldIDToName_GameListFilter = map[int16]string{
-	1: "FilterText",
-}
+var GameListFilter_NewestStatus__DEFAULT []GameStatus
 
-type GameListSorter struct {
-	UpdateTime *int64 `thrift:"UpdateTime,1,optional" frugal:"1,optional,i64" json:"UpdateTime,omitempty"`
+func (p *GameListFilter) GetNewestStatus_() (v []GameStatus) {
+	if !p.IsSetNewestStatus_() {
+		return GameListFilter_NewestStatus__DEFAULT
+	}
+	return p.NewestStatus_
 }
 
-func NewGameListSorter() *GameListSorter {
-	return &GameListSorter{}
-}
+var GameListFilter_OnlineStatus_DEFAULT OnlineStatus
 
-func (p *GameListSorter) InitDefault() {
+func (p *GameListFilter) GetOnlineStatus() (v OnlineStatus) {
+	if !p.IsSetOnlineStatus() {
+		return GameListFilter_OnlineStatus_DEFAULT
+	}
+	return *p.OnlineStatus
 }
 
-var GameListSorter_UpdateTime_DEFAULT int64
+var GameListFilter_Platforms_DEFAULT []GamePlatform
 
-func (p *GameListSorter) GetUpdateTime() (v int64) {
-	if !p.IsSetUpdateTime() {
-		return GameListSorter_UpdateTime_DEFAULT
+func (p *GameListFilter) GetPlatforms() (v []GamePlatform) {
+	if !p.IsSetPlatforms() {
+		return GameListFilter_Platforms_DEFAULT
 	}
-	return *p.UpdateTime
-}
-func (p *GameListSorter) SetUpdateTime(val *int64) {
-	p.UpdateTime = val
+	return p.Platforms
 }
 
-func (p *GameListSorter) IsSetUpdateTime() bool {
-	return p.UpdateTime != nil
-}
+var GameListFilter_CreateTimeStart_DEFAULT int64
 
-func (p *GameListSorter) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *GameListFilter) GetCreateTimeStart() (v int64) {
+	if !p.IsSetCreateTimeStart() {
+		return GameListFilter_CreateTimeStart_DEFAULT
 	}
-	return fmt.Sprintf("GameListSorter(%+v)", *p)
+	return *p.CreateTimeStart
 }
 
-var fieldIDToName_GameListSorter = map[int16]string{
-	1: "UpdateTime",
-}
+var GameListFilter_CreateTimeEnd_DEFAULT int64
 
-type GetGameListResponse struct {
-	GameList   []*BriefGame     `thrift:"GameList,1" frugal:"1,default,list<BriefGame>" json:"GameList"`
-	TotalCount int32            `thrift:"TotalCount,2" frugal:"2,default,i32" json:"TotalCount"`
-	BaseResp   *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+func (p *GameListFilter) GetCreateTimeEnd() (v int64) {
+	if !p.IsSetCreateTimeEnd() {
+		return GameListFilter_CreateTimeEnd_DEFAULT
+	}
+	return *p.CreateTimeEnd
 }
 
-func NewGetGameListResponse() *GetGameListResponse {
-	return &GetGameListResponse{}
-}
+var GameListFilter_UpdateTimeStart_DEFAULT int64
 
-func (p *GetGameListResponse) InitDefault() {
+func (p *GameListFilter) GetUpdateTimeStart() (v int64) {
+	if !p.IsSetUpdateTimeStart() {
+		return GameListFilter_UpdateTimeStart_DEFAULT
+	}
+	return *p.UpdateTimeStart
 }
 
-func (p *GetGameListResponse) GetGameList() (v []*BriefGame) {
-	return p.GameList
+var GameListFilter_UpdateTimeEnd_DEFAULT int64
+
+func (p *GameListFilter) GetUpdateTimeEnd() (v int64) {
+	if !p.IsSetUpdateTimeEnd() {
+		return GameListFilter_UpdateTimeEnd_DEFAULT
+	}
+	return *p.UpdateTimeEnd
 }
 
-func (p *GetGameListResponse) GetTotalCount() (v int32) {
-	return p.TotalCount
+var GameListFilter_CategoryID_DEFAULT int64
+
+func (p *GameListFilter) GetCategoryID() (v int64) {
+	if !p.IsSetCategoryID() {
+		return GameListFilter_CategoryID_DEFAULT
+	}
+	return *p.CategoryID
 }
 
-var GetGameListResponse_BaseResp_DEFAULT *common.BaseResp
+var GameListFilter_TagIDs_DEFAULT []int64
 
-func (p *GetGameListResponse) GetBaseResp() (v *common.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return GetGameListResponse_BaseResp_DEFAULT
+func (p *GameListFilter) GetTagIDs() (v []int64) {
+	if !p.IsSetTagIDs() {
+		return GameListFilter_TagIDs_DEFAULT
 	}
-	return p.BaseResp
+	return p.TagIDs
+}
+func (p *GameListFilter) SetFilterText(val *string) {
+	p.FilterText = val
+}
+func (p *GameListFilter) SetCpID(val *int64) {
+	p.CpID = val
+}
+func (p *GameListFilter) SetNewestStatus_(val []GameStatus) {
+	p.NewestStatus_ = val
+}
+func (p *GameListFilter) SetOnlineStatus(val *OnlineStatus) {
+	p.OnlineStatus = val
+}
+func (p *GameListFilter) SetPlatforms(val []GamePlatform) {
+	p.Platforms = val
+}
+func (p *GameListFilter) SetCreateTimeStart(val *int64) {
+	p.CreateTimeStart = val
+}
+func (p *GameListFilter) SetCreateTimeEnd(val *int64) {
+	p.CreateTimeEnd = val
+}
+func (p *GameListFilter) SetUpdateTimeStart(val *int64) {
+	p.UpdateTimeStart = val
+}
+func (p *GameListFilter) SetUpdateTimeEnd(val *int64) {
+	p.UpdateTimeEnd = val
+}
+func (p *GameListFilter) SetCategoryID(val *int64) {
+	p.CategoryID = val
+}
+func (p *GameListFilter) SetTagIDs(val []int64) {
+	p.TagIDs = val
+}
+
+func (p *GameListFilter) IsSetFilterText() bool {
+	return p.FilterText != nil
+}
+
+func (p *GameListFilter) IsSetCpID() bool {
+	return p.CpID != nil
+}
+
+func (p *GameListFilter) IsSetNewestStatus_() bool {
+	return p.NewestStatus_ != nil
+}
+
+func (p *GameListFilter) IsSetOnlineStatus() bool {
+	return p.OnlineStatus != nil
+}
+
+func (p *GameListFilter) IsSetPlatforms() bool {
+	return p.Platforms != nil
+}
+
+func (p *GameListFilter) IsSetCreateTimeStart() bool {
+	return p.CreateTimeStart != nil
+}
+
+func (p *GameListFilter) IsSetCreateTimeEnd() bool {
+	return p.CreateTimeEnd != nil
+}
+
+func (p *GameListFilter) IsSetUpdateTimeStart() bool {
+	return p.UpdateTimeStart != nil
+}
+
+func (p *GameListFilter) IsSetUpdateTimeEnd() bool {
+	return p.UpdateTimeEnd != nil
+}
+
+func (p *GameListFilter) IsSetCategoryID() bool {
+	return p.CategoryID != nil
+}
+
+func (p *GameListFilter) IsSetTagIDs() bool {
+	return p.TagIDs != nil
+}
+
+func (p *GameListFilter) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameListFilter(%+v)", *p)
+}
+
+var fieldIDToName_GameListFilter = map[int16]string{
+	1:  "FilterText",
+	2:  "CpID",
+	3:  "NewestStatus",
+	4:  "OnlineStatus",
+	5:  "Platforms",
+	6:  "CreateTimeStart",
+	7:  "CreateTimeEnd",
+	8:  "UpdateTimeStart",
+	9:  "UpdateTimeEnd",
+	10: "CategoryID",
+	11: "TagIDs",
+}
+
+type GameListSorter struct {
+	UpdateTime *int64             `thrift:"UpdateTime,1,optional" frugal:"1,optional,i64" json:"UpdateTime,omitempty"`
+	SortField  *GameListSortField `thrift:"SortField,2,optional" frugal:"2,optional,GameListSortField" json:"SortField,omitempty"`
+	SortOrder  *SortOrder         `thrift:"SortOrder,3,optional" frugal:"3,optional,SortOrder" json:"SortOrder,omitempty"`
+}
+
+func NewGameListSorter() *GameListSorter {
+	return &GameListSorter{}
+}
+
+func (p *GameListSorter) InitDefault() {
+}
+
+var GameListSorter_UpdateTime_DEFAULT int64
+
+func (p *GameListSorter) GetUpdateTime() (v int64) {
+	if !p.IsSetUpdateTime() {
+		return GameListSorter_UpdateTime_DEFAULT
+	}
+	return *p.UpdateTime
+}
+
+var GameListSorter_SortField_DEFAULT GameListSortField
+
+func (p *GameListSorter) GetSortField() (v GameListSortField) {
+	if !p.IsSetSortField() {
+		return GameListSorter_SortField_DEFAULT
+	}
+	return *p.SortField
+}
+
+var GameListSorter_SortOrder_DEFAULT SortOrder
+
+func (p *GameListSorter) GetSortOrder() (v SortOrder) {
+	if !p.IsSetSortOrder() {
+		return GameListSorter_SortOrder_DEFAULT
+	}
+	return *p.SortOrder
+}
+func (p *GameListSorter) SetUpdateTime(val *int64) {
+	p.UpdateTime = val
+}
+func (p *GameListSorter) SetSortField(val *GameListSortField) {
+	p.SortField = val
+}
+func (p *GameListSorter) SetSortOrder(val *SortOrder) {
+	p.SortOrder = val
+}
+
+func (p *GameListSorter) IsSetUpdateTime() bool {
+	return p.UpdateTime != nil
+}
+
+func (p *GameListSorter) IsSetSortField() bool {
+	return p.SortField != nil
+}
+
+func (p *GameListSorter) IsSetSortOrder() bool {
+	return p.SortOrder != nil
+}
+
+func (p *GameListSorter) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameListSorter(%+v)", *p)
+}
+
+var fieldIDToName_GameListSorter = map[int16]string{
+	1: "UpdateTime",
+	2: "SortField",
+	3: "SortOrder",
+}
+
+type GetGameListResponse struct {
+	GameList   []*BriefGame     `thrift:"GameList,1" frugal:"1,default,list<BriefGame>" json:"GameList"`
+	TotalCount *int32           `thrift:"TotalCount,2,optional" frugal:"2,optional,i32" json:"TotalCount,omitempty"`
+	NextCursor string           `thrift:"NextCursor,3" frugal:"3,default,string" json:"NextCursor"`
+	HasMore    bool             `thrift:"HasMore,4" frugal:"4,default,bool" json:"HasMore"`
+	BaseResp   *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewGetGameListResponse() *GetGameListResponse {
+	return &GetGameListResponse{}
+}
+
+func (p *GetGameListResponse) InitDefault() {
+}
+
+func (p *GetGameListResponse) GetGameList() (v []*BriefGame) {
+	return p.GameList
+}
+
+var GetGameListResponse_TotalCount_DEFAULT int32
+
+func (p *GetGameListResponse) GetTotalCount() (v int32) {
+	if !p.IsSetTotalCount() {
+		return GetGameListResponse_TotalCount_DEFAULT
+	}
+	return *p.TotalCount
+}
+
+func (p *GetGameListResponse) GetNextCursor() (v string) {
+	return p.NextCursor
+}
+
+func (p *GetGameListResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
+
+var GetGameListResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *GetGameListResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetGameListResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
 }
 func (p *GetGameListResponse) SetGameList(val []*BriefGame) {
 	p.GameList = val
 }
-func (p *GetGameListResponse) SetTotalCount(val int32) {
+func (p *GetGameListResponse) SetTotalCount(val *int32) {
 	p.TotalCount = val
 }
+func (p *GetGameListResponse) SetNextCursor(val string) {
+	p.NextCursor = val
+}
+func (p *GetGameListResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
 func (p *GetGameListResponse) SetBaseResp(val *common.BaseResp) {
 	p.BaseResp = val
 }
 
+func (p *GetGameListResponse) IsSetTotalCount() bool {
+	return p.TotalCount != nil
+}
+
 func (p *GetGameListResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
@@ -416,18 +1077,24 @@ func (p *GetGameListResponse) String() string {
 var fieldIDToName_GetGameListResponse = map[int16]string{
 	1:   "GameList",
 	2:   "TotalCount",
+	3:   "NextCursor",
+	4:   "HasMore",
 	255: "BaseResp",
 }
 
 type BriefGame struct {
-	GameID      int64      `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
-	CpID        int64      `thrift:"CpID,2" frugal:"2,default,i64" json:"CpID"`
-	GameName    string     `thrift:"GameName,3" frugal:"3,default,string" json:"GameName"`
-	GameIcon    string     `thrift:"GameIcon,4" frugal:"4,default,string" json:"GameIcon"`
-	HeaderImage string     `thrift:"HeaderImage,5" frugal:"5,default,string" json:"HeaderImage"`
-	CreateTime  int64      `thrift:"CreateTime,6" frugal:"6,default,i64" json:"CreateTime"`
-	UpdateTime  int64      `thrift:"UpdateTime,7" frugal:"7,default,i64" json:"UpdateTime"`
-	GameStatus  GameStatus `thrift:"GameStatus,8" frugal:"8,default,GameStatus" json:"GameStatus"`
+	GameID           int64          `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+	CpID             int64          `thrift:"CpID,2" frugal:"2,default,i64" json:"CpID"`
+	GameName         string         `thrift:"GameName,3" frugal:"3,default,string" json:"GameName"`
+	GameIcon         string         `thrift:"GameIcon,4" frugal:"4,default,string" json:"GameIcon"`
+	HeaderImage      string         `thrift:"HeaderImage,5" frugal:"5,default,string" json:"HeaderImage"`
+	CreateTime       int64          `thrift:"CreateTime,6" frugal:"6,default,i64" json:"CreateTime"`
+	UpdateTime       int64          `thrift:"UpdateTime,7" frugal:"7,default,i64" json:"UpdateTime"`
+	GameStatus       GameStatus     `thrift:"GameStatus,8" frugal:"8,default,GameStatus" json:"GameStatus"`
+	GameIntroduction string         `thrift:"GameIntroduction,9" frugal:"9,default,string" json:"GameIntroduction"`
+	GamePlatforms    []GamePlatform `thrift:"GamePlatforms,10" frugal:"10,default,list<GamePlatform>" json:"GamePlatforms"`
+	PackageName      string         `thrift:"PackageName,11" frugal:"11,default,string" json:"PackageName"`
+	DownloadURL      string         `thrift:"DownloadURL,12" frugal:"12,default,string" json:"DownloadURL"`
 }
 
 func NewBriefGame() *BriefGame {
@@ -468,6 +1135,22 @@ func (p *BriefGame) GetUpdateTime() (v int64) {
 func (p *BriefGame) GetGameStatus() (v GameStatus) {
 	return p.GameStatus
 }
+
+func (p *BriefGame) GetGameIntroduction() (v string) {
+	return p.GameIntroduction
+}
+
+func (p *BriefGame) GetGamePlatforms() (v []GamePlatform) {
+	return p.GamePlatforms
+}
+
+func (p *BriefGame) GetPackageName() (v string) {
+	return p.PackageName
+}
+
+func (p *BriefGame) GetDownloadURL() (v string) {
+	return p.DownloadURL
+}
 func (p *BriefGame) SetGameID(val int64) {
 	p.GameID = val
 }
@@ -492,6 +1175,18 @@ func (p *BriefGame) SetUpdateTime(val int64) {
 func (p *BriefGame) SetGameStatus(val GameStatus) {
 	p.GameStatus = val
 }
+func (p *BriefGame) SetGameIntroduction(val string) {
+	p.GameIntroduction = val
+}
+func (p *BriefGame) SetGamePlatforms(val []GamePlatform) {
+	p.GamePlatforms = val
+}
+func (p *BriefGame) SetPackageName(val string) {
+	p.PackageName = val
+}
+func (p *BriefGame) SetDownloadURL(val string) {
+	p.DownloadURL = val
+}
 
 func (p *BriefGame) String() string {
 	if p == nil {
@@ -501,14 +1196,18 @@ func (p *BriefGame) String() string {
 }
 
 var fieldIDToName_BriefGame = map[int16]string{
-	1: "GameID",
-	2: "CpID",
-	3: "GameName",
-	4: "GameIcon",
-	5: "HeaderImage",
-	6: "CreateTime",
-	7: "UpdateTime",
-	8: "GameStatus",
+	1:  "GameID",
+	2:  "CpID",
+	3:  "GameName",
+	4:  "GameIcon",
+	5:  "HeaderImage",
+	6:  "CreateTime",
+	7:  "UpdateTime",
+	8:  "GameStatus",
+	9:  "GameIntroduction",
+	10: "GamePlatforms",
+	11: "PackageName",
+	12: "DownloadURL",
 }
 
 type GetGameDetailRequest struct {
@@ -597,12 +1296,14 @@ var fieldIDToName_GetGameDetailResponse = map[int16]string{
 }
 
 type GameDetail struct {
-	GameID             int64        `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
-	CpID               int64        `thrift:"CpID,2" frugal:"2,default,i64" json:"CpID"`
-	OnlineGameVersion  *GameVersion `thrift:"OnlineGameVersion,3" frugal:"3,default,GameVersion" json:"OnlineGameVersion"`
-	NewestGameVersion_ *GameVersion `thrift:"NewestGameVersion,4" frugal:"4,default,GameVersion" json:"NewestGameVersion"`
-	CreateTime         int64        `thrift:"CreateTime,5" frugal:"5,default,i64" json:"CreateTime"`
-	ModifyTime         int64        `thrift:"ModifyTime,6" frugal:"6,default,i64" json:"ModifyTime"`
+	GameID             int64         `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+	CpID               int64         `thrift:"CpID,2" frugal:"2,default,i64" json:"CpID"`
+	OnlineGameVersion  *GameVersion  `thrift:"OnlineGameVersion,3" frugal:"3,default,GameVersion" json:"OnlineGameVersion"`
+	NewestGameVersion_ *GameVersion  `thrift:"NewestGameVersion,4" frugal:"4,default,GameVersion" json:"NewestGameVersion"`
+	CreateTime         int64         `thrift:"CreateTime,5" frugal:"5,default,i64" json:"CreateTime"`
+	ModifyTime         int64         `thrift:"ModifyTime,6" frugal:"6,default,i64" json:"ModifyTime"`
+	Takedown           *GameTakedown `thrift:"Takedown,7,optional" frugal:"7,optional,GameTakedown" json:"Takedown,omitempty"`
+	Rollout            *GameRollout  `thrift:"Rollout,8,optional" frugal:"8,optional,GameRollout" json:"Rollout,omitempty"`
 }
 
 func NewGameDetail() *GameDetail {
@@ -645,6 +1346,24 @@ func (p *GameDetail) GetCreateTime() (v int64) {
 func (p *GameDetail) GetModifyTime() (v int64) {
 	return p.ModifyTime
 }
+
+var GameDetail_Takedown_DEFAULT *GameTakedown
+
+func (p *GameDetail) GetTakedown() (v *GameTakedown) {
+	if !p.IsSetTakedown() {
+		return GameDetail_Takedown_DEFAULT
+	}
+	return p.Takedown
+}
+
+var GameDetail_Rollout_DEFAULT *GameRollout
+
+func (p *GameDetail) GetRollout() (v *GameRollout) {
+	if !p.IsSetRollout() {
+		return GameDetail_Rollout_DEFAULT
+	}
+	return p.Rollout
+}
 func (p *GameDetail) SetGameID(val int64) {
 	p.GameID = val
 }
@@ -663,6 +1382,12 @@ func (p *GameDetail) SetCreateTime(val int64) {
 func (p *GameDetail) SetModifyTime(val int64) {
 	p.ModifyTime = val
 }
+func (p *GameDetail) SetTakedown(val *GameTakedown) {
+	p.Takedown = val
+}
+func (p *GameDetail) SetRollout(val *GameRollout) {
+	p.Rollout = val
+}
 
 func (p *GameDetail) IsSetOnlineGameVersion() bool {
 	return p.OnlineGameVersion != nil
@@ -672,6 +1397,14 @@ func (p *GameDetail) IsSetNewestGameVersion_() bool {
 	return p.NewestGameVersion_ != nil
 }
 
+func (p *GameDetail) IsSetTakedown() bool {
+	return p.Takedown != nil
+}
+
+func (p *GameDetail) IsSetRollout() bool {
+	return p.Rollout != nil
+}
+
 func (p *GameDetail) String() string {
 	if p == nil {
 		return "<nil>"
@@ -686,24 +1419,149 @@ var fieldIDToName_GameDetail = map[int16]string{
 	4: "NewestGameVersion",
 	5: "CreateTime",
 	6: "ModifyTime",
+	7: "Takedown",
+	8: "Rollout",
+}
+
+type GameTakedown struct {
+	GameVersionID int64  `thrift:"GameVersionID,1" frugal:"1,default,i64" json:"GameVersionID"`
+	Reason        string `thrift:"Reason,2" frugal:"2,default,string" json:"Reason"`
+	Operator      string `thrift:"Operator,3" frugal:"3,default,string" json:"Operator"`
+	TakedownTime  int64  `thrift:"TakedownTime,4" frugal:"4,default,i64" json:"TakedownTime"`
+}
+
+func NewGameTakedown() *GameTakedown {
+	return &GameTakedown{}
+}
+
+func (p *GameTakedown) InitDefault() {
+}
+
+func (p *GameTakedown) GetGameVersionID() (v int64) {
+	return p.GameVersionID
+}
+
+func (p *GameTakedown) GetReason() (v string) {
+	return p.Reason
+}
+
+func (p *GameTakedown) GetOperator() (v string) {
+	return p.Operator
+}
+
+func (p *GameTakedown) GetTakedownTime() (v int64) {
+	return p.TakedownTime
+}
+func (p *GameTakedown) SetGameVersionID(val int64) {
+	p.GameVersionID = val
+}
+func (p *GameTakedown) SetReason(val string) {
+	p.Reason = val
+}
+func (p *GameTakedown) SetOperator(val string) {
+	p.Operator = val
+}
+func (p *GameTakedown) SetTakedownTime(val int64) {
+	p.TakedownTime = val
+}
+
+func (p *GameTakedown) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameTakedown(%+v)", *p)
+}
+
+var fieldIDToName_GameTakedown = map[int16]string{
+	1: "GameVersionID",
+	2: "Reason",
+	3: "Operator",
+	4: "TakedownTime",
+}
+
+type GameRollout struct {
+	GameVersionID     int64        `thrift:"GameVersionID,1" frugal:"1,default,i64" json:"GameVersionID"`
+	Percentage        int32        `thrift:"Percentage,2" frugal:"2,default,i32" json:"Percentage"`
+	State             RolloutState `thrift:"State,3" frugal:"3,default,RolloutState" json:"State"`
+	BaseGameVersionID int64        `thrift:"BaseGameVersionID,4" frugal:"4,default,i64" json:"BaseGameVersionID"`
+}
+
+func NewGameRollout() *GameRollout {
+	return &GameRollout{}
+}
+
+func (p *GameRollout) InitDefault() {
+}
+
+func (p *GameRollout) GetGameVersionID() (v int64) {
+	return p.GameVersionID
+}
+
+func (p *GameRollout) GetPercentage() (v int32) {
+	return p.Percentage
+}
+
+func (p *GameRollout) GetState() (v RolloutState) {
+	return p.State
+}
+
+func (p *GameRollout) GetBaseGameVersionID() (v int64) {
+	return p.BaseGameVersionID
+}
+func (p *GameRollout) SetGameVersionID(val int64) {
+	p.GameVersionID = val
+}
+func (p *GameRollout) SetPercentage(val int32) {
+	p.Percentage = val
+}
+func (p *GameRollout) SetState(val RolloutState) {
+	p.State = val
+}
+func (p *GameRollout) SetBaseGameVersionID(val int64) {
+	p.BaseGameVersionID = val
+}
+
+func (p *GameRollout) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameRollout(%+v)", *p)
+}
+
+var fieldIDToName_GameRollout = map[int16]string{
+	1: "GameVersionID",
+	2: "Percentage",
+	3: "State",
+	4: "BaseGameVersionID",
 }
 
 type GameVersion struct {
-	GameID                 int64          `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
-	GamVersionID           int64          `thrift:"GamVersionID,2" frugal:"2,default,i64" json:"GamVersionID"`
-	GameName               string         `thrift:"GameName,3" frugal:"3,default,string" json:"GameName"`
-	GameIcon               string         `thrift:"GameIcon,4" frugal:"4,default,string" json:"GameIcon"`
-	HeaderImage            string         `thrift:"HeaderImage,5" frugal:"5,default,string" json:"HeaderImage"`
-	GameIntroduction       string         `thrift:"GameIntroduction,6" frugal:"6,default,string" json:"GameIntroduction"`
-	GameIntroductionImages []string       `thrift:"GameIntroductionImages,7" frugal:"7,default,list<string>" json:"GameIntroductionImages"`
-	GamePlatforms          []GamePlatform `thrift:"GamePlatforms,8" frugal:"8,default,list<GamePlatform>" json:"GamePlatforms"`
-	PackageName            string         `thrift:"PackageName,9" frugal:"9,default,string" json:"PackageName"`
-	DownloadURL            string         `thrift:"DownloadURL,10" frugal:"10,default,string" json:"DownloadURL"`
-	GameStatus             GameStatus     `thrift:"GameStatus,11" frugal:"11,default,GameStatus" json:"GameStatus"`
-	ReviewComment          string         `thrift:"ReviewComment,12" frugal:"12,default,string" json:"ReviewComment"`
-	ReviewTime             int64          `thrift:"ReviewTime,13" frugal:"13,default,i64" json:"ReviewTime"`
-	CreateTime             int64          `thrift:"CreateTime,14" frugal:"14,default,i64" json:"CreateTime"`
-	UpdateTime             int64          `thrift:"UpdateTime,15" frugal:"15,default,i64" json:"UpdateTime"`
+	GameID                 int64                        `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+	GamVersionID           int64                        `thrift:"GamVersionID,2" frugal:"2,default,i64" json:"GamVersionID"`
+	GameName               string                       `thrift:"GameName,3" frugal:"3,default,string" json:"GameName"`
+	GameIcon               string                       `thrift:"GameIcon,4" frugal:"4,default,string" json:"GameIcon"`
+	HeaderImage            string                       `thrift:"HeaderImage,5" frugal:"5,default,string" json:"HeaderImage"`
+	GameIntroduction       string                       `thrift:"GameIntroduction,6" frugal:"6,default,string" json:"GameIntroduction"`
+	GameIntroductionImages []string                     `thrift:"GameIntroductionImages,7" frugal:"7,default,list<string>" json:"GameIntroductionImages"`
+	GamePlatforms          []GamePlatform               `thrift:"GamePlatforms,8" frugal:"8,default,list<GamePlatform>" json:"GamePlatforms"`
+	PackageName            string                       `thrift:"PackageName,9" frugal:"9,default,string" json:"PackageName"`
+	DownloadURL            string                       `thrift:"DownloadURL,10" frugal:"10,default,string" json:"DownloadURL"`
+	GameStatus             GameStatus                   `thrift:"GameStatus,11" frugal:"11,default,GameStatus" json:"GameStatus"`
+	ReviewComment          string                       `thrift:"ReviewComment,12" frugal:"12,default,string" json:"ReviewComment"`
+	ReviewTime             int64                        `thrift:"ReviewTime,13" frugal:"13,default,i64" json:"ReviewTime"`
+	CreateTime             int64                        `thrift:"CreateTime,14" frugal:"14,default,i64" json:"CreateTime"`
+	UpdateTime             int64                        `thrift:"UpdateTime,15" frugal:"15,default,i64" json:"UpdateTime"`
+	PublishAt              int64                        `thrift:"PublishAt,16" frugal:"16,default,i64" json:"PublishAt"`
+	Operator               string                       `thrift:"Operator,17" frugal:"17,default,string" json:"Operator"`
+	Revision               int64                        `thrift:"Revision,18" frugal:"18,default,i64" json:"Revision"`
+	CategoryID             int64                        `thrift:"CategoryID,19" frugal:"19,default,i64" json:"CategoryID"`
+	TagIDs                 []int64                      `thrift:"TagIDs,20" frugal:"20,default,list<i64>" json:"TagIDs"`
+	DefaultLocale          string                       `thrift:"DefaultLocale,21" frugal:"21,default,string" json:"DefaultLocale"`
+	Listings               map[string]*LocalizedListing `thrift:"Listings,22" frugal:"22,default,map<string:LocalizedListing>" json:"Listings"`
+	Builds                 []*PlatformBuild             `thrift:"Builds,23" frugal:"23,default,list<PlatformBuild>" json:"Builds"`
+	ApkManifest            *ApkManifest                 `thrift:"ApkManifest,24,optional" frugal:"24,optional,ApkManifest" json:"ApkManifest,omitempty"`
+	ReleaseNotes           string                       `thrift:"ReleaseNotes,25" frugal:"25,default,string" json:"ReleaseNotes"`
+	Media                  []*MediaItem                 `thrift:"Media,26" frugal:"26,default,list<MediaItem>" json:"Media"`
 }
 
 func NewGameVersion() *GameVersion {
@@ -772,6 +1630,55 @@ func (p *GameVersion) GetCreateTime() (v int64) {
 func (p *GameVersion) GetUpdateTime() (v int64) {
 	return p.UpdateTime
 }
+
+func (p *GameVersion) GetPublishAt() (v int64) {
+	return p.PublishAt
+}
+
+func (p *GameVersion) GetOperator() (v string) {
+	return p.Operator
+}
+
+func (p *GameVersion) GetRevision() (v int64) {
+	return p.Revision
+}
+
+func (p *GameVersion) GetCategoryID() (v int64) {
+	return p.CategoryID
+}
+
+func (p *GameVersion) GetTagIDs() (v []int64) {
+	return p.TagIDs
+}
+
+func (p *GameVersion) GetDefaultLocale() (v string) {
+	return p.DefaultLocale
+}
+
+func (p *GameVersion) GetListings() (v map[string]*LocalizedListing) {
+	return p.Listings
+}
+
+func (p *GameVersion) GetBuilds() (v []*PlatformBuild) {
+	return p.Builds
+}
+
+var GameVersion_ApkManifest_DEFAULT *ApkManifest
+
+func (p *GameVersion) GetApkManifest() (v *ApkManifest) {
+	if !p.IsSetApkManifest() {
+		return GameVersion_ApkManifest_DEFAULT
+	}
+	return p.ApkManifest
+}
+
+func (p *GameVersion) GetReleaseNotes() (v string) {
+	return p.ReleaseNotes
+}
+
+func (p *GameVersion) GetMedia() (v []*MediaItem) {
+	return p.Media
+}
 func (p *GameVersion) SetGameID(val int64) {
 	p.GameID = val
 }
@@ -817,7 +1724,44 @@ func (p *GameVersion) SetCreateTime(val int64) {
 func (p *GameVersion) SetUpdateTime(val int64) {
 	p.UpdateTime = val
 }
-
+func (p *GameVersion) SetPublishAt(val int64) {
+	p.PublishAt = val
+}
+func (p *GameVersion) SetOperator(val string) {
+	p.Operator = val
+}
+func (p *GameVersion) SetRevision(val int64) {
+	p.Revision = val
+}
+func (p *GameVersion) SetCategoryID(val int64) {
+	p.CategoryID = val
+}
+func (p *GameVersion) SetTagIDs(val []int64) {
+	p.TagIDs = val
+}
+func (p *GameVersion) SetDefaultLocale(val string) {
+	p.DefaultLocale = val
+}
+func (p *GameVersion) SetListings(val map[string]*LocalizedListing) {
+	p.Listings = val
+}
+func (p *GameVersion) SetBuilds(val []*PlatformBuild) {
+	p.Builds = val
+}
+func (p *GameVersion) SetApkManifest(val *ApkManifest) {
+	p.ApkManifest = val
+}
+func (p *GameVersion) SetReleaseNotes(val string) {
+	p.ReleaseNotes = val
+}
+func (p *GameVersion) SetMedia(val []*MediaItem) {
+	p.Media = val
+}
+
+func (p *GameVersion) IsSetApkManifest() bool {
+	return p.ApkManifest != nil
+}
+
 func (p *GameVersion) String() string {
 	if p == nil {
 		return "<nil>"
@@ -841,6 +1785,331 @@ var fieldIDToName_GameVersion = map[int16]string{
 	13: "ReviewTime",
 	14: "CreateTime",
 	15: "UpdateTime",
+	16: "PublishAt",
+	17: "Operator",
+	18: "Revision",
+	19: "CategoryID",
+	20: "TagIDs",
+	21: "DefaultLocale",
+	22: "Listings",
+	23: "Builds",
+	24: "ApkManifest",
+	25: "ReleaseNotes",
+	26: "Media",
+}
+
+type MediaItem struct {
+	Type         MediaType        `thrift:"Type,1" frugal:"1,default,MediaType" json:"Type"`
+	URI          string           `thrift:"URI,2" frugal:"2,default,string" json:"URI"`
+	ThumbnailURI string           `thrift:"ThumbnailURI,3" frugal:"3,default,string" json:"ThumbnailURI"`
+	Orientation  MediaOrientation `thrift:"Orientation,4" frugal:"4,default,MediaOrientation" json:"Orientation"`
+	AspectRatio  string           `thrift:"AspectRatio,5" frugal:"5,default,string" json:"AspectRatio"`
+	Caption      string           `thrift:"Caption,6" frugal:"6,default,string" json:"Caption"`
+	Position     int32            `thrift:"Position,7" frugal:"7,default,i32" json:"Position"`
+}
+
+func NewMediaItem() *MediaItem {
+	return &MediaItem{}
+}
+
+func (p *MediaItem) InitDefault() {
+}
+
+func (p *MediaItem) GetType() (v MediaType) {
+	return p.Type
+}
+
+func (p *MediaItem) GetURI() (v string) {
+	return p.URI
+}
+
+func (p *MediaItem) GetThumbnailURI() (v string) {
+	return p.ThumbnailURI
+}
+
+func (p *MediaItem) GetOrientation() (v MediaOrientation) {
+	return p.Orientation
+}
+
+func (p *MediaItem) GetAspectRatio() (v string) {
+	return p.AspectRatio
+}
+
+func (p *MediaItem) GetCaption() (v string) {
+	return p.Caption
+}
+
+func (p *MediaItem) GetPosition() (v int32) {
+	return p.Position
+}
+func (p *MediaItem) SetType(val MediaType) {
+	p.Type = val
+}
+func (p *MediaItem) SetURI(val string) {
+	p.URI = val
+}
+func (p *MediaItem) SetThumbnailURI(val string) {
+	p.ThumbnailURI = val
+}
+func (p *MediaItem) SetOrientation(val MediaOrientation) {
+	p.Orientation = val
+}
+func (p *MediaItem) SetAspectRatio(val string) {
+	p.AspectRatio = val
+}
+func (p *MediaItem) SetCaption(val string) {
+	p.Caption = val
+}
+func (p *MediaItem) SetPosition(val int32) {
+	p.Position = val
+}
+
+func (p *MediaItem) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MediaItem(%+v)", *p)
+}
+
+var fieldIDToName_MediaItem = map[int16]string{
+	1: "Type",
+	2: "URI",
+	3: "ThumbnailURI",
+	4: "Orientation",
+	5: "AspectRatio",
+	6: "Caption",
+	7: "Position",
+}
+
+type ApkManifest struct {
+	PackageName       string `thrift:"PackageName,1" frugal:"1,default,string" json:"PackageName"`
+	VersionCode       int64  `thrift:"VersionCode,2" frugal:"2,default,i64" json:"VersionCode"`
+	VersionName       string `thrift:"VersionName,3" frugal:"3,default,string" json:"VersionName"`
+	MinSdkVersion     int32  `thrift:"MinSdkVersion,4" frugal:"4,default,i32" json:"MinSdkVersion"`
+	CertificateSHA256 string `thrift:"CertificateSHA256,5" frugal:"5,default,string" json:"CertificateSHA256"`
+	InspectTime       int64  `thrift:"InspectTime,6" frugal:"6,default,i64" json:"InspectTime"`
+}
+
+func NewApkManifest() *ApkManifest {
+	return &ApkManifest{}
+}
+
+func (p *ApkManifest) InitDefault() {
+}
+
+func (p *ApkManifest) GetPackageName() (v string) {
+	return p.PackageName
+}
+
+func (p *ApkManifest) GetVersionCode() (v int64) {
+	return p.VersionCode
+}
+
+func (p *ApkManifest) GetVersionName() (v string) {
+	return p.VersionName
+}
+
+func (p *ApkManifest) GetMinSdkVersion() (v int32) {
+	return p.MinSdkVersion
+}
+
+func (p *ApkManifest) GetCertificateSHA256() (v string) {
+	return p.CertificateSHA256
+}
+
+func (p *ApkManifest) GetInspectTime() (v int64) {
+	return p.InspectTime
+}
+func (p *ApkManifest) SetPackageName(val string) {
+	p.PackageName = val
+}
+func (p *ApkManifest) SetVersionCode(val int64) {
+	p.VersionCode = val
+}
+func (p *ApkManifest) SetVersionName(val string) {
+	p.VersionName = val
+}
+func (p *ApkManifest) SetMinSdkVersion(val int32) {
+	p.MinSdkVersion = val
+}
+func (p *ApkManifest) SetCertificateSHA256(val string) {
+	p.CertificateSHA256 = val
+}
+func (p *ApkManifest) SetInspectTime(val int64) {
+	p.InspectTime = val
+}
+
+func (p *ApkManifest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApkManifest(%+v)", *p)
+}
+
+var fieldIDToName_ApkManifest = map[int16]string{
+	1: "PackageName",
+	2: "VersionCode",
+	3: "VersionName",
+	4: "MinSdkVersion",
+	5: "CertificateSHA256",
+	6: "InspectTime",
+}
+
+type PlatformBuild struct {
+	Platform     GamePlatform `thrift:"Platform,1" frugal:"1,default,GamePlatform" json:"Platform"`
+	Identifier   string       `thrift:"Identifier,2" frugal:"2,default,string" json:"Identifier"`
+	VersionName  string       `thrift:"VersionName,3" frugal:"3,default,string" json:"VersionName"`
+	VersionCode  int64        `thrift:"VersionCode,4" frugal:"4,default,i64" json:"VersionCode"`
+	DownloadURL  string       `thrift:"DownloadURL,5" frugal:"5,default,string" json:"DownloadURL"`
+	MinOSVersion string       `thrift:"MinOSVersion,6" frugal:"6,default,string" json:"MinOSVersion"`
+	FileSize     int64        `thrift:"FileSize,7" frugal:"7,default,i64" json:"FileSize"`
+	SHA256       string       `thrift:"SHA256,8" frugal:"8,default,string" json:"SHA256"`
+}
+
+func NewPlatformBuild() *PlatformBuild {
+	return &PlatformBuild{}
+}
+
+func (p *PlatformBuild) InitDefault() {
+}
+
+func (p *PlatformBuild) GetPlatform() (v GamePlatform) {
+	return p.Platform
+}
+
+func (p *PlatformBuild) GetIdentifier() (v string) {
+	return p.Identifier
+}
+
+func (p *PlatformBuild) GetVersionName() (v string) {
+	return p.VersionName
+}
+
+func (p *PlatformBuild) GetVersionCode() (v int64) {
+	return p.VersionCode
+}
+
+func (p *PlatformBuild) GetDownloadURL() (v string) {
+	return p.DownloadURL
+}
+
+func (p *PlatformBuild) GetMinOSVersion() (v string) {
+	return p.MinOSVersion
+}
+
+func (p *PlatformBuild) GetFileSize() (v int64) {
+	return p.FileSize
+}
+
+func (p *PlatformBuild) GetSHA256() (v string) {
+	return p.SHA256
+}
+func (p *PlatformBuild) SetPlatform(val GamePlatform) {
+	p.Platform = val
+}
+func (p *PlatformBuild) SetIdentifier(val string) {
+	p.Identifier = val
+}
+func (p *PlatformBuild) SetVersionName(val string) {
+	p.VersionName = val
+}
+func (p *PlatformBuild) SetVersionCode(val int64) {
+	p.VersionCode = val
+}
+func (p *PlatformBuild) SetDownloadURL(val string) {
+	p.DownloadURL = val
+}
+func (p *PlatformBuild) SetMinOSVersion(val string) {
+	p.MinOSVersion = val
+}
+func (p *PlatformBuild) SetFileSize(val int64) {
+	p.FileSize = val
+}
+func (p *PlatformBuild) SetSHA256(val string) {
+	p.SHA256 = val
+}
+
+func (p *PlatformBuild) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PlatformBuild(%+v)", *p)
+}
+
+var fieldIDToName_PlatformBuild = map[int16]string{
+	1: "Platform",
+	2: "Identifier",
+	3: "VersionName",
+	4: "VersionCode",
+	5: "DownloadURL",
+	6: "MinOSVersion",
+	7: "FileSize",
+	8: "SHA256",
+}
+
+type LocalizedListing struct {
+	GameName               string   `thrift:"GameName,1" frugal:"1,default,string" json:"GameName"`
+	GameIntroduction       string   `thrift:"GameIntroduction,2" frugal:"2,default,string" json:"GameIntroduction"`
+	GameIntroductionImages []string `thrift:"GameIntroductionImages,3" frugal:"3,default,list<string>" json:"GameIntroductionImages"`
+	HeaderImage            string   `thrift:"HeaderImage,4" frugal:"4,default,string" json:"HeaderImage"`
+	ReleaseNotes           string   `thrift:"ReleaseNotes,5" frugal:"5,default,string" json:"ReleaseNotes"`
+}
+
+func NewLocalizedListing() *LocalizedListing {
+	return &LocalizedListing{}
+}
+
+func (p *LocalizedListing) InitDefault() {
+}
+
+func (p *LocalizedListing) GetGameName() (v string) {
+	return p.GameName
+}
+
+func (p *LocalizedListing) GetGameIntroduction() (v string) {
+	return p.GameIntroduction
+}
+
+func (p *LocalizedListing) GetGameIntroductionImages() (v []string) {
+	return p.GameIntroductionImages
+}
+
+func (p *LocalizedListing) GetHeaderImage() (v string) {
+	return p.HeaderImage
+}
+
+func (p *LocalizedListing) GetReleaseNotes() (v string) {
+	return p.ReleaseNotes
+}
+func (p *LocalizedListing) SetGameName(val string) {
+	p.GameName = val
+}
+func (p *LocalizedListing) SetGameIntroduction(val string) {
+	p.GameIntroduction = val
+}
+func (p *LocalizedListing) SetGameIntroductionImages(val []string) {
+	p.GameIntroductionImages = val
+}
+func (p *LocalizedListing) SetHeaderImage(val string) {
+	p.HeaderImage = val
+}
+func (p *LocalizedListing) SetReleaseNotes(val string) {
+	p.ReleaseNotes = val
+}
+
+func (p *LocalizedListing) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LocalizedListing(%+v)", *p)
+}
+
+var fieldIDToName_LocalizedListing = map[int16]string{
+	1: "GameName",
+	2: "GameIntroduction",
+	3: "GameIntroductionImages",
+	4: "HeaderImage",
+	5: "ReleaseNotes",
 }
 
 type GameDetailWrite struct {
@@ -899,6 +2168,53 @@ var fieldIDToName_GameDetailWrite = map[int16]string{
 	3: "GameVersion",
 }
 
+type FieldError struct {
+	Field   string `thrift:"Field,1" frugal:"1,default,string" json:"Field"`
+	Code    string `thrift:"Code,2" frugal:"2,default,string" json:"Code"`
+	Message string `thrift:"Message,3" frugal:"3,default,string" json:"Message"`
+}
+
+func NewFieldError() *FieldError {
+	return &FieldError{}
+}
+
+func (p *FieldError) InitDefault() {
+}
+
+func (p *FieldError) GetField() (v string) {
+	return p.Field
+}
+
+func (p *FieldError) GetCode() (v string) {
+	return p.Code
+}
+
+func (p *FieldError) GetMessage() (v string) {
+	return p.Message
+}
+func (p *FieldError) SetField(val string) {
+	p.Field = val
+}
+func (p *FieldError) SetCode(val string) {
+	p.Code = val
+}
+func (p *FieldError) SetMessage(val string) {
+	p.Message = val
+}
+
+func (p *FieldError) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FieldError(%+v)", *p)
+}
+
+var fieldIDToName_FieldError = map[int16]string{
+	1: "Field",
+	2: "Code",
+	3: "Message",
+}
+
 type CreateGameDetailRequest struct {
 	GameDetail *GameDetailWrite `thrift:"GameDetail,1" frugal:"1,default,GameDetailWrite" json:"GameDetail"`
 	SubmitMode SubmitMode       `thrift:"SubmitMode,2" frugal:"2,default,SubmitMode" json:"SubmitMode"`
@@ -947,8 +2263,9 @@ var fieldIDToName_CreateGameDetailRequest = map[int16]string{
 }
 
 type CreateGameDetailResponse struct {
-	GameID   int64            `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
-	BaseResp *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+	GameID      int64            `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+	FieldErrors []*FieldError    `thrift:"FieldErrors,2" frugal:"2,default,list<FieldError>" json:"FieldErrors"`
+	BaseResp    *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
 }
 
 func NewCreateGameDetailResponse() *CreateGameDetailResponse {
@@ -962,6 +2279,10 @@ func (p *CreateGameDetailResponse) GetGameID() (v int64) {
 	return p.GameID
 }
 
+func (p *CreateGameDetailResponse) GetFieldErrors() (v []*FieldError) {
+	return p.FieldErrors
+}
+
 var CreateGameDetailResponse_BaseResp_DEFAULT *common.BaseResp
 
 func (p *CreateGameDetailResponse) GetBaseResp() (v *common.BaseResp) {
@@ -973,6 +2294,9 @@ func (p *CreateGameDetailResponse) GetBaseResp() (v *common.BaseResp) {
 func (p *CreateGameDetailResponse) SetGameID(val int64) {
 	p.GameID = val
 }
+func (p *CreateGameDetailResponse) SetFieldErrors(val []*FieldError) {
+	p.FieldErrors = val
+}
 func (p *CreateGameDetailResponse) SetBaseResp(val *common.BaseResp) {
 	p.BaseResp = val
 }
@@ -990,12 +2314,14 @@ func (p *CreateGameDetailResponse) String() string {
 
 var fieldIDToName_CreateGameDetailResponse = map[int16]string{
 	1:   "GameID",
+	2:   "FieldErrors",
 	255: "BaseResp",
 }
 
 type UpdateGameDraftRequest struct {
-	GameDetail *GameDetailWrite `thrift:"GameDetail,1" frugal:"1,default,GameDetailWrite" json:"GameDetail"`
-	SubmitMode SubmitMode       `thrift:"SubmitMode,2" frugal:"2,default,SubmitMode" json:"SubmitMode"`
+	GameDetail       *GameDetailWrite `thrift:"GameDetail,1" frugal:"1,default,GameDetailWrite" json:"GameDetail"`
+	SubmitMode       SubmitMode       `thrift:"SubmitMode,2" frugal:"2,default,SubmitMode" json:"SubmitMode"`
+	ExpectedRevision *int64           `thrift:"ExpectedRevision,3,optional" frugal:"3,optional,i64" json:"ExpectedRevision,omitempty"`
 }
 
 func NewUpdateGameDraftRequest() *UpdateGameDraftRequest {
@@ -1017,17 +2343,33 @@ func (p *UpdateGameDraftRequest) GetGameDetail() (v *GameDetailWrite) {
 func (p *UpdateGameDraftRequest) GetSubmitMode() (v SubmitMode) {
 	return p.SubmitMode
 }
+
+var UpdateGameDraftRequest_ExpectedRevision_DEFAULT int64
+
+func (p *UpdateGameDraftRequest) GetExpectedRevision() (v int64) {
+	if !p.IsSetExpectedRevision() {
+		return UpdateGameDraftRequest_ExpectedRevision_DEFAULT
+	}
+	return *p.ExpectedRevision
+}
 func (p *UpdateGameDraftRequest) SetGameDetail(val *GameDetailWrite) {
 	p.GameDetail = val
 }
 func (p *UpdateGameDraftRequest) SetSubmitMode(val SubmitMode) {
 	p.SubmitMode = val
 }
+func (p *UpdateGameDraftRequest) SetExpectedRevision(val *int64) {
+	p.ExpectedRevision = val
+}
 
 func (p *UpdateGameDraftRequest) IsSetGameDetail() bool {
 	return p.GameDetail != nil
 }
 
+func (p *UpdateGameDraftRequest) IsSetExpectedRevision() bool {
+	return p.ExpectedRevision != nil
+}
+
 func (p *UpdateGameDraftRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1038,10 +2380,14 @@ func (p *UpdateGameDraftRequest) String() string {
 var fieldIDToName_UpdateGameDraftRequest = map[int16]string{
 	1: "GameDetail",
 	2: "SubmitMode",
+	3: "ExpectedRevision",
 }
 
 type UpdateGameDraftResponse struct {
-	BaseResp *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+	GameVersionID int64            `thrift:"GameVersionID,1" frugal:"1,default,i64" json:"GameVersionID"`
+	Revision      int64            `thrift:"Revision,2" frugal:"2,default,i64" json:"Revision"`
+	FieldErrors   []*FieldError    `thrift:"FieldErrors,3" frugal:"3,default,list<FieldError>" json:"FieldErrors"`
+	BaseResp      *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
 }
 
 func NewUpdateGameDraftResponse() *UpdateGameDraftResponse {
@@ -1051,6 +2397,18 @@ func NewUpdateGameDraftResponse() *UpdateGameDraftResponse {
 func (p *UpdateGameDraftResponse) InitDefault() {
 }
 
+func (p *UpdateGameDraftResponse) GetGameVersionID() (v int64) {
+	return p.GameVersionID
+}
+
+func (p *UpdateGameDraftResponse) GetRevision() (v int64) {
+	return p.Revision
+}
+
+func (p *UpdateGameDraftResponse) GetFieldErrors() (v []*FieldError) {
+	return p.FieldErrors
+}
+
 var UpdateGameDraftResponse_BaseResp_DEFAULT *common.BaseResp
 
 func (p *UpdateGameDraftResponse) GetBaseResp() (v *common.BaseResp) {
@@ -1059,6 +2417,15 @@ func (p *UpdateGameDraftResponse) GetBaseResp() (v *common.BaseResp) {
 	}
 	return p.BaseResp
 }
+func (p *UpdateGameDraftResponse) SetGameVersionID(val int64) {
+	p.GameVersionID = val
+}
+func (p *UpdateGameDraftResponse) SetRevision(val int64) {
+	p.Revision = val
+}
+func (p *UpdateGameDraftResponse) SetFieldErrors(val []*FieldError) {
+	p.FieldErrors = val
+}
 func (p *UpdateGameDraftResponse) SetBaseResp(val *common.BaseResp) {
 	p.BaseResp = val
 }
@@ -1075,13 +2442,19 @@ func (p *UpdateGameDraftResponse) String() string {
 }
 
 var fieldIDToName_UpdateGameDraftResponse = map[int16]string{
+	1:   "GameVersionID",
+	2:   "Revision",
+	3:   "FieldErrors",
 	255: "BaseResp",
 }
 
 type ReviewGameVersionRequest struct {
-	GameID        int64         `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
-	GameVersionID int64         `thrift:"GameVersionID,2" frugal:"2,default,i64" json:"GameVersionID"`
-	ReviewResult_ ReviewResult_ `thrift:"ReviewResult,3" frugal:"3,default,ReviewResult_" json:"ReviewResult"`
+	GameID            int64         `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+	GameVersionID     int64         `thrift:"GameVersionID,2" frugal:"2,default,i64" json:"GameVersionID"`
+	ReviewResult_     ReviewResult_ `thrift:"ReviewResult,3" frugal:"3,default,ReviewResult_" json:"ReviewResult"`
+	PublishAt         *int64        `thrift:"PublishAt,4,optional" frugal:"4,optional,i64" json:"PublishAt,omitempty"`
+	ReviewRemark      *ReviewRemark `thrift:"ReviewRemark,5,optional" frugal:"5,optional,ReviewRemark" json:"ReviewRemark,omitempty"`
+	RolloutPercentage *int32        `thrift:"RolloutPercentage,6,optional" frugal:"6,optional,i32" json:"RolloutPercentage,omitempty"`
 }
 
 func NewReviewGameVersionRequest() *ReviewGameVersionRequest {
@@ -1102,600 +2475,5771 @@ func (p *ReviewGameVersionRequest) GetGameVersionID() (v int64) {
 func (p *ReviewGameVersionRequest) GetReviewResult_() (v ReviewResult_) {
 	return p.ReviewResult_
 }
+
+var ReviewGameVersionRequest_PublishAt_DEFAULT int64
+
+func (p *ReviewGameVersionRequest) GetPublishAt() (v int64) {
+	if !p.IsSetPublishAt() {
+		return ReviewGameVersionRequest_PublishAt_DEFAULT
+	}
+	return *p.PublishAt
+}
+
+var ReviewGameVersionRequest_ReviewRemark_DEFAULT *ReviewRemark
+
+func (p *ReviewGameVersionRequest) GetReviewRemark() (v *ReviewRemark) {
+	if !p.IsSetReviewRemark() {
+		return ReviewGameVersionRequest_ReviewRemark_DEFAULT
+	}
+	return p.ReviewRemark
+}
+
+var ReviewGameVersionRequest_RolloutPercentage_DEFAULT int32
+
+func (p *ReviewGameVersionRequest) GetRolloutPercentage() (v int32) {
+	if !p.IsSetRolloutPercentage() {
+		return ReviewGameVersionRequest_RolloutPercentage_DEFAULT
+	}
+	return *p.RolloutPercentage
+}
 func (p *ReviewGameVersionRequest) SetGameID(val int64) {
 	p.GameID = val
 }
-func (p *ReviewGameVersionRequest) SetGameVersionID(val int64) {
-	p.GameVersionID = val
+func (p *ReviewGameVersionRequest) SetGameVersionID(val int64) {
+	p.GameVersionID = val
+}
+func (p *ReviewGameVersionRequest) SetReviewResult_(val ReviewResult_) {
+	p.ReviewResult_ = val
+}
+func (p *ReviewGameVersionRequest) SetPublishAt(val *int64) {
+	p.PublishAt = val
+}
+func (p *ReviewGameVersionRequest) SetReviewRemark(val *ReviewRemark) {
+	p.ReviewRemark = val
+}
+func (p *ReviewGameVersionRequest) SetRolloutPercentage(val *int32) {
+	p.RolloutPercentage = val
+}
+
+func (p *ReviewGameVersionRequest) IsSetPublishAt() bool {
+	return p.PublishAt != nil
+}
+
+func (p *ReviewGameVersionRequest) IsSetReviewRemark() bool {
+	return p.ReviewRemark != nil
+}
+
+func (p *ReviewGameVersionRequest) IsSetRolloutPercentage() bool {
+	return p.RolloutPercentage != nil
+}
+
+func (p *ReviewGameVersionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewGameVersionRequest(%+v)", *p)
+}
+
+var fieldIDToName_ReviewGameVersionRequest = map[int16]string{
+	1: "GameID",
+	2: "GameVersionID",
+	3: "ReviewResult",
+	4: "PublishAt",
+	5: "ReviewRemark",
+	6: "RolloutPercentage",
+}
+
+type ReviewRemark struct {
+	Remark     string `thrift:"Remark,1" frugal:"1,default,string" json:"Remark"`
+	Operator   string `thrift:"Operator,2" frugal:"2,default,string" json:"Operator"`
+	ReviewTime int64  `thrift:"ReviewTime,3" frugal:"3,default,i64" json:"ReviewTime"`
+	Meta       string `thrift:"Meta,4" frugal:"4,default,string" json:"Meta"`
+}
+
+func NewReviewRemark() *ReviewRemark {
+	return &ReviewRemark{}
+}
+
+func (p *ReviewRemark) InitDefault() {
+}
+
+func (p *ReviewRemark) GetRemark() (v string) {
+	return p.Remark
+}
+
+func (p *ReviewRemark) GetOperator() (v string) {
+	return p.Operator
+}
+
+func (p *ReviewRemark) GetReviewTime() (v int64) {
+	return p.ReviewTime
+}
+
+func (p *ReviewRemark) GetMeta() (v string) {
+	return p.Meta
+}
+func (p *ReviewRemark) SetRemark(val string) {
+	p.Remark = val
+}
+func (p *ReviewRemark) SetOperator(val string) {
+	p.Operator = val
+}
+func (p *ReviewRemark) SetReviewTime(val int64) {
+	p.ReviewTime = val
+}
+func (p *ReviewRemark) SetMeta(val string) {
+	p.Meta = val
+}
+
+func (p *ReviewRemark) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewRemark(%+v)", *p)
+}
+
+var fieldIDToName_ReviewRemark = map[int16]string{
+	1: "Remark",
+	2: "Operator",
+	3: "ReviewTime",
+	4: "Meta",
+}
+
+type ReviewGameVersionResponse struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewReviewGameVersionResponse() *ReviewGameVersionResponse {
+	return &ReviewGameVersionResponse{}
+}
+
+func (p *ReviewGameVersionResponse) InitDefault() {
+}
+
+var ReviewGameVersionResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ReviewGameVersionResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ReviewGameVersionResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ReviewGameVersionResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *ReviewGameVersionResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ReviewGameVersionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewGameVersionResponse(%+v)", *p)
+}
+
+var fieldIDToName_ReviewGameVersionResponse = map[int16]string{
+	255: "BaseResp",
+}
+
+type DeleteGameDraftRequest struct {
+	GameID int64 `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+}
+
+func NewDeleteGameDraftRequest() *DeleteGameDraftRequest {
+	return &DeleteGameDraftRequest{}
+}
+
+func (p *DeleteGameDraftRequest) InitDefault() {
+}
+
+func (p *DeleteGameDraftRequest) GetGameID() (v int64) {
+	return p.GameID
+}
+func (p *DeleteGameDraftRequest) SetGameID(val int64) {
+	p.GameID = val
+}
+
+func (p *DeleteGameDraftRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteGameDraftRequest(%+v)", *p)
+}
+
+var fieldIDToName_DeleteGameDraftRequest = map[int16]string{
+	1: "GameID",
+}
+
+type DeleteGameDraftResponse struct {
+	NewestGameVersionID_ int64            `thrift:"NewestGameVersionID,1" frugal:"1,default,i64" json:"NewestGameVersionID"`
+	BaseResp             *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewDeleteGameDraftResponse() *DeleteGameDraftResponse {
+	return &DeleteGameDraftResponse{}
+}
+
+func (p *DeleteGameDraftResponse) InitDefault() {
+}
+
+func (p *DeleteGameDraftResponse) GetNewestGameVersionID_() (v int64) {
+	return p.NewestGameVersionID_
+}
+
+var DeleteGameDraftResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *DeleteGameDraftResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return DeleteGameDraftResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *DeleteGameDraftResponse) SetNewestGameVersionID_(val int64) {
+	p.NewestGameVersionID_ = val
+}
+func (p *DeleteGameDraftResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *DeleteGameDraftResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *DeleteGameDraftResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteGameDraftResponse(%+v)", *p)
+}
+
+var fieldIDToName_DeleteGameDraftResponse = map[int16]string{
+	1:   "NewestGameVersionID",
+	255: "BaseResp",
+}
+
+type DeletedGameDraft struct {
+	GameVersion *GameVersion `thrift:"GameVersion,1" frugal:"1,default,GameVersion" json:"GameVersion"`
+	DeleteTime  int64        `thrift:"DeleteTime,2" frugal:"2,default,i64" json:"DeleteTime"`
+	ExpireTime  int64        `thrift:"ExpireTime,3" frugal:"3,default,i64" json:"ExpireTime"`
+}
+
+func NewDeletedGameDraft() *DeletedGameDraft {
+	return &DeletedGameDraft{}
+}
+
+func (p *DeletedGameDraft) InitDefault() {
+}
+
+var DeletedGameDraft_GameVersion_DEFAULT *GameVersion
+
+func (p *DeletedGameDraft) GetGameVersion() (v *GameVersion) {
+	if !p.IsSetGameVersion() {
+		return DeletedGameDraft_GameVersion_DEFAULT
+	}
+	return p.GameVersion
+}
+
+func (p *DeletedGameDraft) GetDeleteTime() (v int64) {
+	return p.DeleteTime
+}
+
+func (p *DeletedGameDraft) GetExpireTime() (v int64) {
+	return p.ExpireTime
+}
+func (p *DeletedGameDraft) SetGameVersion(val *GameVersion) {
+	p.GameVersion = val
+}
+func (p *DeletedGameDraft) SetDeleteTime(val int64) {
+	p.DeleteTime = val
+}
+func (p *DeletedGameDraft) SetExpireTime(val int64) {
+	p.ExpireTime = val
+}
+
+func (p *DeletedGameDraft) IsSetGameVersion() bool {
+	return p.GameVersion != nil
+}
+
+func (p *DeletedGameDraft) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeletedGameDraft(%+v)", *p)
+}
+
+var fieldIDToName_DeletedGameDraft = map[int16]string{
+	1: "GameVersion",
+	2: "DeleteTime",
+	3: "ExpireTime",
+}
+
+type ListDeletedGameDraftsRequest struct {
+	GameID int64 `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+}
+
+func NewListDeletedGameDraftsRequest() *ListDeletedGameDraftsRequest {
+	return &ListDeletedGameDraftsRequest{}
+}
+
+func (p *ListDeletedGameDraftsRequest) InitDefault() {
+}
+
+func (p *ListDeletedGameDraftsRequest) GetGameID() (v int64) {
+	return p.GameID
+}
+func (p *ListDeletedGameDraftsRequest) SetGameID(val int64) {
+	p.GameID = val
+}
+
+func (p *ListDeletedGameDraftsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListDeletedGameDraftsRequest(%+v)", *p)
+}
+
+var fieldIDToName_ListDeletedGameDraftsRequest = map[int16]string{
+	1: "GameID",
+}
+
+type ListDeletedGameDraftsResponse struct {
+	DeletedDrafts []*DeletedGameDraft `thrift:"DeletedDrafts,1" frugal:"1,default,list<DeletedGameDraft>" json:"DeletedDrafts"`
+	BaseResp      *common.BaseResp    `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewListDeletedGameDraftsResponse() *ListDeletedGameDraftsResponse {
+	return &ListDeletedGameDraftsResponse{}
+}
+
+func (p *ListDeletedGameDraftsResponse) InitDefault() {
+}
+
+func (p *ListDeletedGameDraftsResponse) GetDeletedDrafts() (v []*DeletedGameDraft) {
+	return p.DeletedDrafts
+}
+
+var ListDeletedGameDraftsResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ListDeletedGameDraftsResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ListDeletedGameDraftsResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ListDeletedGameDraftsResponse) SetDeletedDrafts(val []*DeletedGameDraft) {
+	p.DeletedDrafts = val
+}
+func (p *ListDeletedGameDraftsResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *ListDeletedGameDraftsResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListDeletedGameDraftsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListDeletedGameDraftsResponse(%+v)", *p)
+}
+
+var fieldIDToName_ListDeletedGameDraftsResponse = map[int16]string{
+	1:   "DeletedDrafts",
+	255: "BaseResp",
+}
+
+type RestoreGameDraftRequest struct {
+	GameID        int64 `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+	GameVersionID int64 `thrift:"GameVersionID,2" frugal:"2,default,i64" json:"GameVersionID"`
+}
+
+func NewRestoreGameDraftRequest() *RestoreGameDraftRequest {
+	return &RestoreGameDraftRequest{}
+}
+
+func (p *RestoreGameDraftRequest) InitDefault() {
+}
+
+func (p *RestoreGameDraftRequest) GetGameID() (v int64) {
+	return p.GameID
+}
+
+func (p *RestoreGameDraftRequest) GetGameVersionID() (v int64) {
+	return p.GameVersionID
+}
+func (p *RestoreGameDraftRequest) SetGameID(val int64) {
+	p.GameID = val
+}
+func (p *RestoreGameDraftRequest) SetGameVersionID(val int64) {
+	p.GameVersionID = val
+}
+
+func (p *RestoreGameDraftRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RestoreGameDraftRequest(%+v)", *p)
+}
+
+var fieldIDToName_RestoreGameDraftRequest = map[int16]string{
+	1: "GameID",
+	2: "GameVersionID",
+}
+
+type RestoreGameDraftResponse struct {
+	Revision int64            `thrift:"Revision,1" frugal:"1,default,i64" json:"Revision"`
+	BaseResp *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewRestoreGameDraftResponse() *RestoreGameDraftResponse {
+	return &RestoreGameDraftResponse{}
+}
+
+func (p *RestoreGameDraftResponse) InitDefault() {
+}
+
+func (p *RestoreGameDraftResponse) GetRevision() (v int64) {
+	return p.Revision
+}
+
+var RestoreGameDraftResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *RestoreGameDraftResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return RestoreGameDraftResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *RestoreGameDraftResponse) SetRevision(val int64) {
+	p.Revision = val
+}
+func (p *RestoreGameDraftResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *RestoreGameDraftResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *RestoreGameDraftResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RestoreGameDraftResponse(%+v)", *p)
+}
+
+var fieldIDToName_RestoreGameDraftResponse = map[int16]string{
+	1:   "Revision",
+	255: "BaseResp",
+}
+
+type RolloutLog struct {
+	RolloutLogID   int64         `thrift:"RolloutLogID,1" frugal:"1,default,i64" json:"RolloutLogID"`
+	GameID         int64         `thrift:"GameID,2" frugal:"2,default,i64" json:"GameID"`
+	GameVersionID  int64         `thrift:"GameVersionID,3" frugal:"3,default,i64" json:"GameVersionID"`
+	Action         RolloutAction `thrift:"Action,4" frugal:"4,default,RolloutAction" json:"Action"`
+	FromPercentage int32         `thrift:"FromPercentage,5" frugal:"5,default,i32" json:"FromPercentage"`
+	ToPercentage   int32         `thrift:"ToPercentage,6" frugal:"6,default,i32" json:"ToPercentage"`
+	Operator       string        `thrift:"Operator,7" frugal:"7,default,string" json:"Operator"`
+	Reason         string        `thrift:"Reason,8" frugal:"8,default,string" json:"Reason"`
+	CreateTime     int64         `thrift:"CreateTime,9" frugal:"9,default,i64" json:"CreateTime"`
+}
+
+func NewRolloutLog() *RolloutLog {
+	return &RolloutLog{}
+}
+
+func (p *RolloutLog) InitDefault() {
+}
+
+func (p *RolloutLog) GetRolloutLogID() (v int64) {
+	return p.RolloutLogID
+}
+
+func (p *RolloutLog) GetGameID() (v int64) {
+	return p.GameID
+}
+
+func (p *RolloutLog) GetGameVersionID() (v int64) {
+	return p.GameVersionID
+}
+
+func (p *RolloutLog) GetAction() (v RolloutAction) {
+	return p.Action
+}
+
+func (p *RolloutLog) GetFromPercentage() (v int32) {
+	return p.FromPercentage
+}
+
+func (p *RolloutLog) GetToPercentage() (v int32) {
+	return p.ToPercentage
+}
+
+func (p *RolloutLog) GetOperator() (v string) {
+	return p.Operator
+}
+
+func (p *RolloutLog) GetReason() (v string) {
+	return p.Reason
+}
+
+func (p *RolloutLog) GetCreateTime() (v int64) {
+	return p.CreateTime
+}
+func (p *RolloutLog) SetRolloutLogID(val int64) {
+	p.RolloutLogID = val
+}
+func (p *RolloutLog) SetGameID(val int64) {
+	p.GameID = val
+}
+func (p *RolloutLog) SetGameVersionID(val int64) {
+	p.GameVersionID = val
+}
+func (p *RolloutLog) SetAction(val RolloutAction) {
+	p.Action = val
+}
+func (p *RolloutLog) SetFromPercentage(val int32) {
+	p.FromPercentage = val
+}
+func (p *RolloutLog) SetToPercentage(val int32) {
+	p.ToPercentage = val
+}
+func (p *RolloutLog) SetOperator(val string) {
+	p.Operator = val
+}
+func (p *RolloutLog) SetReason(val string) {
+	p.Reason = val
+}
+func (p *RolloutLog) SetCreateTime(val int64) {
+	p.CreateTime = val
+}
+
+func (p *RolloutLog) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RolloutLog(%+v)", *p)
+}
+
+var fieldIDToName_RolloutLog = map[int16]string{
+	1: "RolloutLogID",
+	2: "GameID",
+	3: "GameVersionID",
+	4: "Action",
+	5: "FromPercentage",
+	6: "ToPercentage",
+	7: "Operator",
+	8: "Reason",
+	9: "CreateTime",
+}
+
+type UpdateGameRolloutRequest struct {
+	GameID        int64         `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+	GameVersionID int64         `thrift:"GameVersionID,2" frugal:"2,default,i64" json:"GameVersionID"`
+	Action        RolloutAction `thrift:"Action,3" frugal:"3,default,RolloutAction" json:"Action"`
+	Percentage    *int32        `thrift:"Percentage,4,optional" frugal:"4,optional,i32" json:"Percentage,omitempty"`
+	Reason        string        `thrift:"Reason,5" frugal:"5,default,string" json:"Reason"`
+	Operator      string        `thrift:"Operator,6" frugal:"6,default,string" json:"Operator"`
+}
+
+func NewUpdateGameRolloutRequest() *UpdateGameRolloutRequest {
+	return &UpdateGameRolloutRequest{}
+}
+
+func (p *UpdateGameRolloutRequest) InitDefault() {
+}
+
+func (p *UpdateGameRolloutRequest) GetGameID() (v int64) {
+	return p.GameID
+}
+
+func (p *UpdateGameRolloutRequest) GetGameVersionID() (v int64) {
+	return p.GameVersionID
+}
+
+func (p *UpdateGameRolloutRequest) GetAction() (v RolloutAction) {
+	return p.Action
+}
+
+var UpdateGameRolloutRequest_Percentage_DEFAULT int32
+
+func (p *UpdateGameRolloutRequest) GetPercentage() (v int32) {
+	if !p.IsSetPercentage() {
+		return UpdateGameRolloutRequest_Percentage_DEFAULT
+	}
+	return *p.Percentage
+}
+
+func (p *UpdateGameRolloutRequest) GetReason() (v string) {
+	return p.Reason
+}
+
+func (p *UpdateGameRolloutRequest) GetOperator() (v string) {
+	return p.Operator
+}
+func (p *UpdateGameRolloutRequest) SetGameID(val int64) {
+	p.GameID = val
+}
+func (p *UpdateGameRolloutRequest) SetGameVersionID(val int64) {
+	p.GameVersionID = val
+}
+func (p *UpdateGameRolloutRequest) SetAction(val RolloutAction) {
+	p.Action = val
+}
+func (p *UpdateGameRolloutRequest) SetPercentage(val *int32) {
+	p.Percentage = val
+}
+func (p *UpdateGameRolloutRequest) SetReason(val string) {
+	p.Reason = val
+}
+func (p *UpdateGameRolloutRequest) SetOperator(val string) {
+	p.Operator = val
+}
+
+func (p *UpdateGameRolloutRequest) IsSetPercentage() bool {
+	return p.Percentage != nil
+}
+
+func (p *UpdateGameRolloutRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateGameRolloutRequest(%+v)", *p)
+}
+
+var fieldIDToName_UpdateGameRolloutRequest = map[int16]string{
+	1: "GameID",
+	2: "GameVersionID",
+	3: "Action",
+	4: "Percentage",
+	5: "Reason",
+	6: "Operator",
+}
+
+type UpdateGameRolloutResponse struct {
+	Rollout  *GameRollout     `thrift:"Rollout,1,optional" frugal:"1,optional,GameRollout" json:"Rollout,omitempty"`
+	BaseResp *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewUpdateGameRolloutResponse() *UpdateGameRolloutResponse {
+	return &UpdateGameRolloutResponse{}
+}
+
+func (p *UpdateGameRolloutResponse) InitDefault() {
+}
+
+var UpdateGameRolloutResponse_Rollout_DEFAULT *GameRollout
+
+func (p *UpdateGameRolloutResponse) GetRollout() (v *GameRollout) {
+	if !p.IsSetRollout() {
+		return UpdateGameRolloutResponse_Rollout_DEFAULT
+	}
+	return p.Rollout
+}
+
+var UpdateGameRolloutResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *UpdateGameRolloutResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return UpdateGameRolloutResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *UpdateGameRolloutResponse) SetRollout(val *GameRollout) {
+	p.Rollout = val
+}
+func (p *UpdateGameRolloutResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *UpdateGameRolloutResponse) IsSetRollout() bool {
+	return p.Rollout != nil
+}
+
+func (p *UpdateGameRolloutResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *UpdateGameRolloutResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateGameRolloutResponse(%+v)", *p)
+}
+
+var fieldIDToName_UpdateGameRolloutResponse = map[int16]string{
+	1:   "Rollout",
+	255: "BaseResp",
+}
+
+type GetGameRolloutRequest struct {
+	GameID int64 `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+}
+
+func NewGetGameRolloutRequest() *GetGameRolloutRequest {
+	return &GetGameRolloutRequest{}
+}
+
+func (p *GetGameRolloutRequest) InitDefault() {
+}
+
+func (p *GetGameRolloutRequest) GetGameID() (v int64) {
+	return p.GameID
+}
+func (p *GetGameRolloutRequest) SetGameID(val int64) {
+	p.GameID = val
+}
+
+func (p *GetGameRolloutRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetGameRolloutRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetGameRolloutRequest = map[int16]string{
+	1: "GameID",
+}
+
+type GetGameRolloutResponse struct {
+	Rollout     *GameRollout     `thrift:"Rollout,1,optional" frugal:"1,optional,GameRollout" json:"Rollout,omitempty"`
+	RolloutLogs []*RolloutLog    `thrift:"RolloutLogs,2" frugal:"2,default,list<RolloutLog>" json:"RolloutLogs"`
+	BaseResp    *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewGetGameRolloutResponse() *GetGameRolloutResponse {
+	return &GetGameRolloutResponse{}
+}
+
+func (p *GetGameRolloutResponse) InitDefault() {
+}
+
+var GetGameRolloutResponse_Rollout_DEFAULT *GameRollout
+
+func (p *GetGameRolloutResponse) GetRollout() (v *GameRollout) {
+	if !p.IsSetRollout() {
+		return GetGameRolloutResponse_Rollout_DEFAULT
+	}
+	return p.Rollout
+}
+
+func (p *GetGameRolloutResponse) GetRolloutLogs() (v []*RolloutLog) {
+	return p.RolloutLogs
+}
+
+var GetGameRolloutResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *GetGameRolloutResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetGameRolloutResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *GetGameRolloutResponse) SetRollout(val *GameRollout) {
+	p.Rollout = val
+}
+func (p *GetGameRolloutResponse) SetRolloutLogs(val []*RolloutLog) {
+	p.RolloutLogs = val
+}
+func (p *GetGameRolloutResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *GetGameRolloutResponse) IsSetRollout() bool {
+	return p.Rollout != nil
+}
+
+func (p *GetGameRolloutResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetGameRolloutResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetGameRolloutResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetGameRolloutResponse = map[int16]string{
+	1:   "Rollout",
+	2:   "RolloutLogs",
+	255: "BaseResp",
+}
+
+type ResolveServedVersionRequest struct {
+	GameID   int64  `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+	PlayerID string `thrift:"PlayerID,2" frugal:"2,default,string" json:"PlayerID"`
+}
+
+func NewResolveServedVersionRequest() *ResolveServedVersionRequest {
+	return &ResolveServedVersionRequest{}
+}
+
+func (p *ResolveServedVersionRequest) InitDefault() {
+}
+
+func (p *ResolveServedVersionRequest) GetGameID() (v int64) {
+	return p.GameID
+}
+
+func (p *ResolveServedVersionRequest) GetPlayerID() (v string) {
+	return p.PlayerID
+}
+func (p *ResolveServedVersionRequest) SetGameID(val int64) {
+	p.GameID = val
+}
+func (p *ResolveServedVersionRequest) SetPlayerID(val string) {
+	p.PlayerID = val
+}
+
+func (p *ResolveServedVersionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResolveServedVersionRequest(%+v)", *p)
+}
+
+var fieldIDToName_ResolveServedVersionRequest = map[int16]string{
+	1: "GameID",
+	2: "PlayerID",
+}
+
+type ResolveServedVersionResponse struct {
+	GameVersionID int64            `thrift:"GameVersionID,1" frugal:"1,default,i64" json:"GameVersionID"`
+	InRollout     bool             `thrift:"InRollout,2" frugal:"2,default,bool" json:"InRollout"`
+	BaseResp      *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewResolveServedVersionResponse() *ResolveServedVersionResponse {
+	return &ResolveServedVersionResponse{}
+}
+
+func (p *ResolveServedVersionResponse) InitDefault() {
+}
+
+func (p *ResolveServedVersionResponse) GetGameVersionID() (v int64) {
+	return p.GameVersionID
+}
+
+func (p *ResolveServedVersionResponse) GetInRollout() (v bool) {
+	return p.InRollout
+}
+
+var ResolveServedVersionResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ResolveServedVersionResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ResolveServedVersionResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ResolveServedVersionResponse) SetGameVersionID(val int64) {
+	p.GameVersionID = val
+}
+func (p *ResolveServedVersionResponse) SetInRollout(val bool) {
+	p.InRollout = val
+}
+func (p *ResolveServedVersionResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *ResolveServedVersionResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ResolveServedVersionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResolveServedVersionResponse(%+v)", *p)
+}
+
+var fieldIDToName_ResolveServedVersionResponse = map[int16]string{
+	1:   "GameVersionID",
+	2:   "InRollout",
+	255: "BaseResp",
+}
+
+type GetGameChangelogRequest struct {
+	GameID   int64 `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+	PageNum  int32 `thrift:"PageNum,2" frugal:"2,default,i32" json:"PageNum"`
+	PageSize int32 `thrift:"PageSize,3" frugal:"3,default,i32" json:"PageSize"`
+}
+
+func NewGetGameChangelogRequest() *GetGameChangelogRequest {
+	return &GetGameChangelogRequest{}
+}
+
+func (p *GetGameChangelogRequest) InitDefault() {
+}
+
+func (p *GetGameChangelogRequest) GetGameID() (v int64) {
+	return p.GameID
+}
+
+func (p *GetGameChangelogRequest) GetPageNum() (v int32) {
+	return p.PageNum
+}
+
+func (p *GetGameChangelogRequest) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *GetGameChangelogRequest) SetGameID(val int64) {
+	p.GameID = val
+}
+func (p *GetGameChangelogRequest) SetPageNum(val int32) {
+	p.PageNum = val
+}
+func (p *GetGameChangelogRequest) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *GetGameChangelogRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetGameChangelogRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetGameChangelogRequest = map[int16]string{
+	1: "GameID",
+	2: "PageNum",
+	3: "PageSize",
+}
+
+type GetGameChangelogResponse struct {
+	Entries    []*ChangelogEntry `thrift:"Entries,1" frugal:"1,default,list<ChangelogEntry>" json:"Entries"`
+	TotalCount int32             `thrift:"TotalCount,2" frugal:"2,default,i32" json:"TotalCount"`
+	BaseResp   *common.BaseResp  `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewGetGameChangelogResponse() *GetGameChangelogResponse {
+	return &GetGameChangelogResponse{}
+}
+
+func (p *GetGameChangelogResponse) InitDefault() {
+}
+
+func (p *GetGameChangelogResponse) GetEntries() (v []*ChangelogEntry) {
+	return p.Entries
+}
+
+func (p *GetGameChangelogResponse) GetTotalCount() (v int32) {
+	return p.TotalCount
+}
+
+var GetGameChangelogResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *GetGameChangelogResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetGameChangelogResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *GetGameChangelogResponse) SetEntries(val []*ChangelogEntry) {
+	p.Entries = val
+}
+func (p *GetGameChangelogResponse) SetTotalCount(val int32) {
+	p.TotalCount = val
+}
+func (p *GetGameChangelogResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *GetGameChangelogResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetGameChangelogResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetGameChangelogResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetGameChangelogResponse = map[int16]string{
+	1:   "Entries",
+	2:   "TotalCount",
+	255: "BaseResp",
+}
+
+type ChangelogEntry struct {
+	GameVersionID         int64             `thrift:"GameVersionID,1" frugal:"1,default,i64" json:"GameVersionID"`
+	GameStatus            GameStatus        `thrift:"GameStatus,2" frugal:"2,default,GameStatus" json:"GameStatus"`
+	PublishTime           int64             `thrift:"PublishTime,3" frugal:"3,default,i64" json:"PublishTime"`
+	DefaultLocale         string            `thrift:"DefaultLocale,4" frugal:"4,default,string" json:"DefaultLocale"`
+	ReleaseNotes          string            `thrift:"ReleaseNotes,5" frugal:"5,default,string" json:"ReleaseNotes"`
+	LocalizedReleaseNotes map[string]string `thrift:"LocalizedReleaseNotes,6" frugal:"6,default,map<string:string>" json:"LocalizedReleaseNotes"`
+}
+
+func NewChangelogEntry() *ChangelogEntry {
+	return &ChangelogEntry{}
+}
+
+func (p *ChangelogEntry) InitDefault() {
+}
+
+func (p *ChangelogEntry) GetGameVersionID() (v int64) {
+	return p.GameVersionID
+}
+
+func (p *ChangelogEntry) GetGameStatus() (v GameStatus) {
+	return p.GameStatus
+}
+
+func (p *ChangelogEntry) GetPublishTime() (v int64) {
+	return p.PublishTime
+}
+
+func (p *ChangelogEntry) GetDefaultLocale() (v string) {
+	return p.DefaultLocale
+}
+
+func (p *ChangelogEntry) GetReleaseNotes() (v string) {
+	return p.ReleaseNotes
+}
+
+func (p *ChangelogEntry) GetLocalizedReleaseNotes() (v map[string]string) {
+	return p.LocalizedReleaseNotes
+}
+func (p *ChangelogEntry) SetGameVersionID(val int64) {
+	p.GameVersionID = val
+}
+func (p *ChangelogEntry) SetGameStatus(val GameStatus) {
+	p.GameStatus = val
+}
+func (p *ChangelogEntry) SetPublishTime(val int64) {
+	p.PublishTime = val
+}
+func (p *ChangelogEntry) SetDefaultLocale(val string) {
+	p.DefaultLocale = val
+}
+func (p *ChangelogEntry) SetReleaseNotes(val string) {
+	p.ReleaseNotes = val
+}
+func (p *ChangelogEntry) SetLocalizedReleaseNotes(val map[string]string) {
+	p.LocalizedReleaseNotes = val
+}
+
+func (p *ChangelogEntry) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ChangelogEntry(%+v)", *p)
+}
+
+var fieldIDToName_ChangelogEntry = map[int16]string{
+	1: "GameVersionID",
+	2: "GameStatus",
+	3: "PublishTime",
+	4: "DefaultLocale",
+	5: "ReleaseNotes",
+	6: "LocalizedReleaseNotes",
+}
+
+type ListGameVersionsRequest struct {
+	GameID       int64        `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+	StatusFilter []GameStatus `thrift:"StatusFilter,2,optional" frugal:"2,optional,list<GameStatus>" json:"StatusFilter,omitempty"`
+	PageNum      int32        `thrift:"PageNum,3" frugal:"3,default,i32" json:"PageNum"`
+	PageSize     int32        `thrift:"PageSize,4" frugal:"4,default,i32" json:"PageSize"`
+}
+
+func NewListGameVersionsRequest() *ListGameVersionsRequest {
+	return &ListGameVersionsRequest{}
+}
+
+func (p *ListGameVersionsRequest) InitDefault() {
+}
+
+func (p *ListGameVersionsRequest) GetGameID() (v int64) {
+	return p.GameID
+}
+
+var ListGameVersionsRequest_StatusFilter_DEFAULT []GameStatus
+
+func (p *ListGameVersionsRequest) GetStatusFilter() (v []GameStatus) {
+	if !p.IsSetStatusFilter() {
+		return ListGameVersionsRequest_StatusFilter_DEFAULT
+	}
+	return p.StatusFilter
+}
+
+func (p *ListGameVersionsRequest) GetPageNum() (v int32) {
+	return p.PageNum
+}
+
+func (p *ListGameVersionsRequest) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *ListGameVersionsRequest) SetGameID(val int64) {
+	p.GameID = val
+}
+func (p *ListGameVersionsRequest) SetStatusFilter(val []GameStatus) {
+	p.StatusFilter = val
+}
+func (p *ListGameVersionsRequest) SetPageNum(val int32) {
+	p.PageNum = val
+}
+func (p *ListGameVersionsRequest) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *ListGameVersionsRequest) IsSetStatusFilter() bool {
+	return p.StatusFilter != nil
+}
+
+func (p *ListGameVersionsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListGameVersionsRequest(%+v)", *p)
+}
+
+var fieldIDToName_ListGameVersionsRequest = map[int16]string{
+	1: "GameID",
+	2: "StatusFilter",
+	3: "PageNum",
+	4: "PageSize",
+}
+
+type ListGameVersionsResponse struct {
+	GameVersions []*GameVersion   `thrift:"GameVersions,1" frugal:"1,default,list<GameVersion>" json:"GameVersions"`
+	TotalCount   int32            `thrift:"TotalCount,2" frugal:"2,default,i32" json:"TotalCount"`
+	BaseResp     *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewListGameVersionsResponse() *ListGameVersionsResponse {
+	return &ListGameVersionsResponse{}
+}
+
+func (p *ListGameVersionsResponse) InitDefault() {
+}
+
+func (p *ListGameVersionsResponse) GetGameVersions() (v []*GameVersion) {
+	return p.GameVersions
+}
+
+func (p *ListGameVersionsResponse) GetTotalCount() (v int32) {
+	return p.TotalCount
+}
+
+var ListGameVersionsResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ListGameVersionsResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ListGameVersionsResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ListGameVersionsResponse) SetGameVersions(val []*GameVersion) {
+	p.GameVersions = val
+}
+func (p *ListGameVersionsResponse) SetTotalCount(val int32) {
+	p.TotalCount = val
+}
+func (p *ListGameVersionsResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *ListGameVersionsResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListGameVersionsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListGameVersionsResponse(%+v)", *p)
+}
+
+var fieldIDToName_ListGameVersionsResponse = map[int16]string{
+	1:   "GameVersions",
+	2:   "TotalCount",
+	255: "BaseResp",
+}
+
+type RollbackGameVersionRequest struct {
+	GameID        int64  `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+	GameVersionID int64  `thrift:"GameVersionID,2" frugal:"2,default,i64" json:"GameVersionID"`
+	Reason        string `thrift:"Reason,3" frugal:"3,default,string" json:"Reason"`
+	Operator      string `thrift:"Operator,4" frugal:"4,default,string" json:"Operator"`
+}
+
+func NewRollbackGameVersionRequest() *RollbackGameVersionRequest {
+	return &RollbackGameVersionRequest{}
+}
+
+func (p *RollbackGameVersionRequest) InitDefault() {
+}
+
+func (p *RollbackGameVersionRequest) GetGameID() (v int64) {
+	return p.GameID
+}
+
+func (p *RollbackGameVersionRequest) GetGameVersionID() (v int64) {
+	return p.GameVersionID
+}
+
+func (p *RollbackGameVersionRequest) GetReason() (v string) {
+	return p.Reason
+}
+
+func (p *RollbackGameVersionRequest) GetOperator() (v string) {
+	return p.Operator
+}
+func (p *RollbackGameVersionRequest) SetGameID(val int64) {
+	p.GameID = val
+}
+func (p *RollbackGameVersionRequest) SetGameVersionID(val int64) {
+	p.GameVersionID = val
+}
+func (p *RollbackGameVersionRequest) SetReason(val string) {
+	p.Reason = val
+}
+func (p *RollbackGameVersionRequest) SetOperator(val string) {
+	p.Operator = val
+}
+
+func (p *RollbackGameVersionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RollbackGameVersionRequest(%+v)", *p)
+}
+
+var fieldIDToName_RollbackGameVersionRequest = map[int16]string{
+	1: "GameID",
+	2: "GameVersionID",
+	3: "Reason",
+	4: "Operator",
+}
+
+type RollbackGameVersionResponse struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewRollbackGameVersionResponse() *RollbackGameVersionResponse {
+	return &RollbackGameVersionResponse{}
+}
+
+func (p *RollbackGameVersionResponse) InitDefault() {
+}
+
+var RollbackGameVersionResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *RollbackGameVersionResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return RollbackGameVersionResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *RollbackGameVersionResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *RollbackGameVersionResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *RollbackGameVersionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RollbackGameVersionResponse(%+v)", *p)
+}
+
+var fieldIDToName_RollbackGameVersionResponse = map[int16]string{
+	255: "BaseResp",
+}
+
+type CancelScheduledPublishRequest struct {
+	GameID        int64 `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+	GameVersionID int64 `thrift:"GameVersionID,2" frugal:"2,default,i64" json:"GameVersionID"`
+}
+
+func NewCancelScheduledPublishRequest() *CancelScheduledPublishRequest {
+	return &CancelScheduledPublishRequest{}
+}
+
+func (p *CancelScheduledPublishRequest) InitDefault() {
+}
+
+func (p *CancelScheduledPublishRequest) GetGameID() (v int64) {
+	return p.GameID
+}
+
+func (p *CancelScheduledPublishRequest) GetGameVersionID() (v int64) {
+	return p.GameVersionID
+}
+func (p *CancelScheduledPublishRequest) SetGameID(val int64) {
+	p.GameID = val
+}
+func (p *CancelScheduledPublishRequest) SetGameVersionID(val int64) {
+	p.GameVersionID = val
+}
+
+func (p *CancelScheduledPublishRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CancelScheduledPublishRequest(%+v)", *p)
+}
+
+var fieldIDToName_CancelScheduledPublishRequest = map[int16]string{
+	1: "GameID",
+	2: "GameVersionID",
+}
+
+type CancelScheduledPublishResponse struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewCancelScheduledPublishResponse() *CancelScheduledPublishResponse {
+	return &CancelScheduledPublishResponse{}
+}
+
+func (p *CancelScheduledPublishResponse) InitDefault() {
+}
+
+var CancelScheduledPublishResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *CancelScheduledPublishResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CancelScheduledPublishResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *CancelScheduledPublishResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *CancelScheduledPublishResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CancelScheduledPublishResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CancelScheduledPublishResponse(%+v)", *p)
+}
+
+var fieldIDToName_CancelScheduledPublishResponse = map[int16]string{
+	255: "BaseResp",
+}
+
+type GameReviewLog struct {
+	ReviewLogID   int64         `thrift:"ReviewLogID,1" frugal:"1,default,i64" json:"ReviewLogID"`
+	GameID        int64         `thrift:"GameID,2" frugal:"2,default,i64" json:"GameID"`
+	GameVersionID int64         `thrift:"GameVersionID,3" frugal:"3,default,i64" json:"GameVersionID"`
+	ReviewResult_ ReviewResult_ `thrift:"ReviewResult,4" frugal:"4,default,ReviewResult_" json:"ReviewResult"`
+	ResultStatus  GameStatus    `thrift:"ResultStatus,5" frugal:"5,default,GameStatus" json:"ResultStatus"`
+	ReviewRemark  *ReviewRemark `thrift:"ReviewRemark,6" frugal:"6,default,ReviewRemark" json:"ReviewRemark"`
+	PublishAt     int64         `thrift:"PublishAt,7" frugal:"7,default,i64" json:"PublishAt"`
+}
+
+func NewGameReviewLog() *GameReviewLog {
+	return &GameReviewLog{}
+}
+
+func (p *GameReviewLog) InitDefault() {
+}
+
+func (p *GameReviewLog) GetReviewLogID() (v int64) {
+	return p.ReviewLogID
+}
+
+func (p *GameReviewLog) GetGameID() (v int64) {
+	return p.GameID
+}
+
+func (p *GameReviewLog) GetGameVersionID() (v int64) {
+	return p.GameVersionID
+}
+
+func (p *GameReviewLog) GetReviewResult_() (v ReviewResult_) {
+	return p.ReviewResult_
+}
+
+func (p *GameReviewLog) GetResultStatus() (v GameStatus) {
+	return p.ResultStatus
+}
+
+var GameReviewLog_ReviewRemark_DEFAULT *ReviewRemark
+
+func (p *GameReviewLog) GetReviewRemark() (v *ReviewRemark) {
+	if !p.IsSetReviewRemark() {
+		return GameReviewLog_ReviewRemark_DEFAULT
+	}
+	return p.ReviewRemark
+}
+
+func (p *GameReviewLog) GetPublishAt() (v int64) {
+	return p.PublishAt
+}
+func (p *GameReviewLog) SetReviewLogID(val int64) {
+	p.ReviewLogID = val
+}
+func (p *GameReviewLog) SetGameID(val int64) {
+	p.GameID = val
+}
+func (p *GameReviewLog) SetGameVersionID(val int64) {
+	p.GameVersionID = val
+}
+func (p *GameReviewLog) SetReviewResult_(val ReviewResult_) {
+	p.ReviewResult_ = val
+}
+func (p *GameReviewLog) SetResultStatus(val GameStatus) {
+	p.ResultStatus = val
+}
+func (p *GameReviewLog) SetReviewRemark(val *ReviewRemark) {
+	p.ReviewRemark = val
+}
+func (p *GameReviewLog) SetPublishAt(val int64) {
+	p.PublishAt = val
+}
+
+func (p *GameReviewLog) IsSetReviewRemark() bool {
+	return p.ReviewRemark != nil
+}
+
+func (p *GameReviewLog) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameReviewLog(%+v)", *p)
+}
+
+var fieldIDToName_GameReviewLog = map[int16]string{
+	1: "ReviewLogID",
+	2: "GameID",
+	3: "GameVersionID",
+	4: "ReviewResult",
+	5: "ResultStatus",
+	6: "ReviewRemark",
+	7: "PublishAt",
+}
+
+type GetGameReviewLogsRequest struct {
+	GameID        int64 `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+	GameVersionID int64 `thrift:"GameVersionID,2" frugal:"2,default,i64" json:"GameVersionID"`
+}
+
+func NewGetGameReviewLogsRequest() *GetGameReviewLogsRequest {
+	return &GetGameReviewLogsRequest{}
+}
+
+func (p *GetGameReviewLogsRequest) InitDefault() {
+}
+
+func (p *GetGameReviewLogsRequest) GetGameID() (v int64) {
+	return p.GameID
+}
+
+func (p *GetGameReviewLogsRequest) GetGameVersionID() (v int64) {
+	return p.GameVersionID
+}
+func (p *GetGameReviewLogsRequest) SetGameID(val int64) {
+	p.GameID = val
+}
+func (p *GetGameReviewLogsRequest) SetGameVersionID(val int64) {
+	p.GameVersionID = val
+}
+
+func (p *GetGameReviewLogsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetGameReviewLogsRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetGameReviewLogsRequest = map[int16]string{
+	1: "GameID",
+	2: "GameVersionID",
+}
+
+type GetGameReviewLogsResponse struct {
+	ReviewLogs []*GameReviewLog `thrift:"ReviewLogs,1" frugal:"1,default,list<GameReviewLog>" json:"ReviewLogs"`
+	BaseResp   *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewGetGameReviewLogsResponse() *GetGameReviewLogsResponse {
+	return &GetGameReviewLogsResponse{}
+}
+
+func (p *GetGameReviewLogsResponse) InitDefault() {
+}
+
+func (p *GetGameReviewLogsResponse) GetReviewLogs() (v []*GameReviewLog) {
+	return p.ReviewLogs
+}
+
+var GetGameReviewLogsResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *GetGameReviewLogsResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetGameReviewLogsResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *GetGameReviewLogsResponse) SetReviewLogs(val []*GameReviewLog) {
+	p.ReviewLogs = val
+}
+func (p *GetGameReviewLogsResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *GetGameReviewLogsResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetGameReviewLogsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetGameReviewLogsResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetGameReviewLogsResponse = map[int16]string{
+	1:   "ReviewLogs",
+	255: "BaseResp",
+}
+
+type SubmitGameVersionRequest struct {
+	GameID        int64   `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+	GameVersionID int64   `thrift:"GameVersionID,2" frugal:"2,default,i64" json:"GameVersionID"`
+	ApkFile       []byte  `thrift:"ApkFile,3,optional" frugal:"3,optional,binary" json:"ApkFile,omitempty"`
+	ApkPath       *string `thrift:"ApkPath,4,optional" frugal:"4,optional,string" json:"ApkPath,omitempty"`
+}
+
+func NewSubmitGameVersionRequest() *SubmitGameVersionRequest {
+	return &SubmitGameVersionRequest{}
+}
+
+func (p *SubmitGameVersionRequest) InitDefault() {
+}
+
+func (p *SubmitGameVersionRequest) GetGameID() (v int64) {
+	return p.GameID
+}
+
+func (p *SubmitGameVersionRequest) GetGameVersionID() (v int64) {
+	return p.GameVersionID
+}
+
+var SubmitGameVersionRequest_ApkFile_DEFAULT []byte
+
+func (p *SubmitGameVersionRequest) GetApkFile() (v []byte) {
+	if !p.IsSetApkFile() {
+		return SubmitGameVersionRequest_ApkFile_DEFAULT
+	}
+	return p.ApkFile
+}
+
+var SubmitGameVersionRequest_ApkPath_DEFAULT string
+
+func (p *SubmitGameVersionRequest) GetApkPath() (v string) {
+	if !p.IsSetApkPath() {
+		return SubmitGameVersionRequest_ApkPath_DEFAULT
+	}
+	return *p.ApkPath
+}
+func (p *SubmitGameVersionRequest) SetGameID(val int64) {
+	p.GameID = val
+}
+func (p *SubmitGameVersionRequest) SetGameVersionID(val int64) {
+	p.GameVersionID = val
+}
+func (p *SubmitGameVersionRequest) SetApkFile(val []byte) {
+	p.ApkFile = val
+}
+func (p *SubmitGameVersionRequest) SetApkPath(val *string) {
+	p.ApkPath = val
+}
+
+func (p *SubmitGameVersionRequest) IsSetApkFile() bool {
+	return p.ApkFile != nil
+}
+
+func (p *SubmitGameVersionRequest) IsSetApkPath() bool {
+	return p.ApkPath != nil
+}
+
+func (p *SubmitGameVersionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SubmitGameVersionRequest(%+v)", *p)
+}
+
+var fieldIDToName_SubmitGameVersionRequest = map[int16]string{
+	1: "GameID",
+	2: "GameVersionID",
+	3: "ApkFile",
+	4: "ApkPath",
+}
+
+type SubmitGameVersionResponse struct {
+	FieldErrors []*FieldError    `thrift:"FieldErrors,1" frugal:"1,default,list<FieldError>" json:"FieldErrors"`
+	BaseResp    *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewSubmitGameVersionResponse() *SubmitGameVersionResponse {
+	return &SubmitGameVersionResponse{}
+}
+
+func (p *SubmitGameVersionResponse) InitDefault() {
+}
+
+func (p *SubmitGameVersionResponse) GetFieldErrors() (v []*FieldError) {
+	return p.FieldErrors
+}
+
+var SubmitGameVersionResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *SubmitGameVersionResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return SubmitGameVersionResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *SubmitGameVersionResponse) SetFieldErrors(val []*FieldError) {
+	p.FieldErrors = val
+}
+func (p *SubmitGameVersionResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *SubmitGameVersionResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *SubmitGameVersionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SubmitGameVersionResponse(%+v)", *p)
+}
+
+var fieldIDToName_SubmitGameVersionResponse = map[int16]string{
+	1:   "FieldErrors",
+	255: "BaseResp",
+}
+
+type WithdrawGameVersionRequest struct {
+	GameID        int64 `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+	GameVersionID int64 `thrift:"GameVersionID,2" frugal:"2,default,i64" json:"GameVersionID"`
+}
+
+func NewWithdrawGameVersionRequest() *WithdrawGameVersionRequest {
+	return &WithdrawGameVersionRequest{}
+}
+
+func (p *WithdrawGameVersionRequest) InitDefault() {
+}
+
+func (p *WithdrawGameVersionRequest) GetGameID() (v int64) {
+	return p.GameID
+}
+
+func (p *WithdrawGameVersionRequest) GetGameVersionID() (v int64) {
+	return p.GameVersionID
+}
+func (p *WithdrawGameVersionRequest) SetGameID(val int64) {
+	p.GameID = val
+}
+func (p *WithdrawGameVersionRequest) SetGameVersionID(val int64) {
+	p.GameVersionID = val
+}
+
+func (p *WithdrawGameVersionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("WithdrawGameVersionRequest(%+v)", *p)
+}
+
+var fieldIDToName_WithdrawGameVersionRequest = map[int16]string{
+	1: "GameID",
+	2: "GameVersionID",
+}
+
+type WithdrawGameVersionResponse struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewWithdrawGameVersionResponse() *WithdrawGameVersionResponse {
+	return &WithdrawGameVersionResponse{}
+}
+
+func (p *WithdrawGameVersionResponse) InitDefault() {
+}
+
+var WithdrawGameVersionResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *WithdrawGameVersionResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return WithdrawGameVersionResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *WithdrawGameVersionResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *WithdrawGameVersionResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *WithdrawGameVersionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("WithdrawGameVersionResponse(%+v)", *p)
+}
+
+var fieldIDToName_WithdrawGameVersionResponse = map[int16]string{
+	255: "BaseResp",
+}
+
+type TakedownGameRequest struct {
+	GameID   int64  `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+	Reason   string `thrift:"Reason,2" frugal:"2,default,string" json:"Reason"`
+	Operator string `thrift:"Operator,3" frugal:"3,default,string" json:"Operator"`
+}
+
+func NewTakedownGameRequest() *TakedownGameRequest {
+	return &TakedownGameRequest{}
+}
+
+func (p *TakedownGameRequest) InitDefault() {
+}
+
+func (p *TakedownGameRequest) GetGameID() (v int64) {
+	return p.GameID
+}
+
+func (p *TakedownGameRequest) GetReason() (v string) {
+	return p.Reason
+}
+
+func (p *TakedownGameRequest) GetOperator() (v string) {
+	return p.Operator
+}
+func (p *TakedownGameRequest) SetGameID(val int64) {
+	p.GameID = val
+}
+func (p *TakedownGameRequest) SetReason(val string) {
+	p.Reason = val
+}
+func (p *TakedownGameRequest) SetOperator(val string) {
+	p.Operator = val
+}
+
+func (p *TakedownGameRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TakedownGameRequest(%+v)", *p)
+}
+
+var fieldIDToName_TakedownGameRequest = map[int16]string{
+	1: "GameID",
+	2: "Reason",
+	3: "Operator",
+}
+
+type TakedownGameResponse struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewTakedownGameResponse() *TakedownGameResponse {
+	return &TakedownGameResponse{}
+}
+
+func (p *TakedownGameResponse) InitDefault() {
+}
+
+var TakedownGameResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *TakedownGameResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return TakedownGameResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *TakedownGameResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *TakedownGameResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *TakedownGameResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TakedownGameResponse(%+v)", *p)
+}
+
+var fieldIDToName_TakedownGameResponse = map[int16]string{
+	255: "BaseResp",
+}
+
+type RestoreGameRequest struct {
+	GameID   int64  `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+	Reason   string `thrift:"Reason,2" frugal:"2,default,string" json:"Reason"`
+	Operator string `thrift:"Operator,3" frugal:"3,default,string" json:"Operator"`
+}
+
+func NewRestoreGameRequest() *RestoreGameRequest {
+	return &RestoreGameRequest{}
+}
+
+func (p *RestoreGameRequest) InitDefault() {
+}
+
+func (p *RestoreGameRequest) GetGameID() (v int64) {
+	return p.GameID
+}
+
+func (p *RestoreGameRequest) GetReason() (v string) {
+	return p.Reason
+}
+
+func (p *RestoreGameRequest) GetOperator() (v string) {
+	return p.Operator
+}
+func (p *RestoreGameRequest) SetGameID(val int64) {
+	p.GameID = val
+}
+func (p *RestoreGameRequest) SetReason(val string) {
+	p.Reason = val
+}
+func (p *RestoreGameRequest) SetOperator(val string) {
+	p.Operator = val
+}
+
+func (p *RestoreGameRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RestoreGameRequest(%+v)", *p)
+}
+
+var fieldIDToName_RestoreGameRequest = map[int16]string{
+	1: "GameID",
+	2: "Reason",
+	3: "Operator",
+}
+
+type RestoreGameResponse struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewRestoreGameResponse() *RestoreGameResponse {
+	return &RestoreGameResponse{}
+}
+
+func (p *RestoreGameResponse) InitDefault() {
+}
+
+var RestoreGameResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *RestoreGameResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return RestoreGameResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *RestoreGameResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *RestoreGameResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *RestoreGameResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RestoreGameResponse(%+v)", *p)
+}
+
+var fieldIDToName_RestoreGameResponse = map[int16]string{
+	255: "BaseResp",
+}
+
+type FieldDiff struct {
+	Field        string   `thrift:"Field,1" frugal:"1,default,string" json:"Field"`
+	FromValue    string   `thrift:"FromValue,2" frugal:"2,default,string" json:"FromValue"`
+	ToValue      string   `thrift:"ToValue,3" frugal:"3,default,string" json:"ToValue"`
+	AddedItems   []string `thrift:"AddedItems,4" frugal:"4,default,list<string>" json:"AddedItems"`
+	RemovedItems []string `thrift:"RemovedItems,5" frugal:"5,default,list<string>" json:"RemovedItems"`
+}
+
+func NewFieldDiff() *FieldDiff {
+	return &FieldDiff{}
+}
+
+func (p *FieldDiff) InitDefault() {
+}
+
+func (p *FieldDiff) GetField() (v string) {
+	return p.Field
+}
+
+func (p *FieldDiff) GetFromValue() (v string) {
+	return p.FromValue
+}
+
+func (p *FieldDiff) GetToValue() (v string) {
+	return p.ToValue
+}
+
+func (p *FieldDiff) GetAddedItems() (v []string) {
+	return p.AddedItems
+}
+
+func (p *FieldDiff) GetRemovedItems() (v []string) {
+	return p.RemovedItems
+}
+func (p *FieldDiff) SetField(val string) {
+	p.Field = val
+}
+func (p *FieldDiff) SetFromValue(val string) {
+	p.FromValue = val
+}
+func (p *FieldDiff) SetToValue(val string) {
+	p.ToValue = val
+}
+func (p *FieldDiff) SetAddedItems(val []string) {
+	p.AddedItems = val
+}
+func (p *FieldDiff) SetRemovedItems(val []string) {
+	p.RemovedItems = val
+}
+
+func (p *FieldDiff) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FieldDiff(%+v)", *p)
+}
+
+var fieldIDToName_FieldDiff = map[int16]string{
+	1: "Field",
+	2: "FromValue",
+	3: "ToValue",
+	4: "AddedItems",
+	5: "RemovedItems",
+}
+
+type DiffGameVersionsRequest struct {
+	GameID        int64  `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+	FromVersionID *int64 `thrift:"FromVersionID,2,optional" frugal:"2,optional,i64" json:"FromVersionID,omitempty"`
+	ToVersionID   *int64 `thrift:"ToVersionID,3,optional" frugal:"3,optional,i64" json:"ToVersionID,omitempty"`
+}
+
+func NewDiffGameVersionsRequest() *DiffGameVersionsRequest {
+	return &DiffGameVersionsRequest{}
+}
+
+func (p *DiffGameVersionsRequest) InitDefault() {
+}
+
+func (p *DiffGameVersionsRequest) GetGameID() (v int64) {
+	return p.GameID
+}
+
+var DiffGameVersionsRequest_FromVersionID_DEFAULT int64
+
+func (p *DiffGameVersionsRequest) GetFromVersionID() (v int64) {
+	if !p.IsSetFromVersionID() {
+		return DiffGameVersionsRequest_FromVersionID_DEFAULT
+	}
+	return *p.FromVersionID
+}
+
+var DiffGameVersionsRequest_ToVersionID_DEFAULT int64
+
+func (p *DiffGameVersionsRequest) GetToVersionID() (v int64) {
+	if !p.IsSetToVersionID() {
+		return DiffGameVersionsRequest_ToVersionID_DEFAULT
+	}
+	return *p.ToVersionID
+}
+func (p *DiffGameVersionsRequest) SetGameID(val int64) {
+	p.GameID = val
+}
+func (p *DiffGameVersionsRequest) SetFromVersionID(val *int64) {
+	p.FromVersionID = val
+}
+func (p *DiffGameVersionsRequest) SetToVersionID(val *int64) {
+	p.ToVersionID = val
+}
+
+func (p *DiffGameVersionsRequest) IsSetFromVersionID() bool {
+	return p.FromVersionID != nil
+}
+
+func (p *DiffGameVersionsRequest) IsSetToVersionID() bool {
+	return p.ToVersionID != nil
+}
+
+func (p *DiffGameVersionsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DiffGameVersionsRequest(%+v)", *p)
+}
+
+var fieldIDToName_DiffGameVersionsRequest = map[int16]string{
+	1: "GameID",
+	2: "FromVersionID",
+	3: "ToVersionID",
+}
+
+type DiffGameVersionsResponse struct {
+	FromVersionID int64            `thrift:"FromVersionID,1" frugal:"1,default,i64" json:"FromVersionID"`
+	ToVersionID   int64            `thrift:"ToVersionID,2" frugal:"2,default,i64" json:"ToVersionID"`
+	Diffs         []*FieldDiff     `thrift:"Diffs,3" frugal:"3,default,list<FieldDiff>" json:"Diffs"`
+	BaseResp      *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewDiffGameVersionsResponse() *DiffGameVersionsResponse {
+	return &DiffGameVersionsResponse{}
+}
+
+func (p *DiffGameVersionsResponse) InitDefault() {
+}
+
+func (p *DiffGameVersionsResponse) GetFromVersionID() (v int64) {
+	return p.FromVersionID
+}
+
+func (p *DiffGameVersionsResponse) GetToVersionID() (v int64) {
+	return p.ToVersionID
+}
+
+func (p *DiffGameVersionsResponse) GetDiffs() (v []*FieldDiff) {
+	return p.Diffs
+}
+
+var DiffGameVersionsResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *DiffGameVersionsResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return DiffGameVersionsResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *DiffGameVersionsResponse) SetFromVersionID(val int64) {
+	p.FromVersionID = val
+}
+func (p *DiffGameVersionsResponse) SetToVersionID(val int64) {
+	p.ToVersionID = val
+}
+func (p *DiffGameVersionsResponse) SetDiffs(val []*FieldDiff) {
+	p.Diffs = val
+}
+func (p *DiffGameVersionsResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *DiffGameVersionsResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *DiffGameVersionsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DiffGameVersionsResponse(%+v)", *p)
+}
+
+var fieldIDToName_DiffGameVersionsResponse = map[int16]string{
+	1:   "FromVersionID",
+	2:   "ToVersionID",
+	3:   "Diffs",
+	255: "BaseResp",
+}
+
+type SearchGamesRequest struct {
+	Query    string       `thrift:"Query,1" frugal:"1,default,string" json:"Query"`
+	CpID     *int64       `thrift:"CpID,2,optional" frugal:"2,optional,i64" json:"CpID,omitempty"`
+	Status   []GameStatus `thrift:"Status,3,optional" frugal:"3,optional,list<GameStatus>" json:"Status,omitempty"`
+	PageNum  int32        `thrift:"PageNum,4" frugal:"4,default,i32" json:"PageNum"`
+	PageSize int32        `thrift:"PageSize,5" frugal:"5,default,i32" json:"PageSize"`
+}
+
+func NewSearchGamesRequest() *SearchGamesRequest {
+	return &SearchGamesRequest{}
+}
+
+func (p *SearchGamesRequest) InitDefault() {
+}
+
+func (p *SearchGamesRequest) GetQuery() (v string) {
+	return p.Query
+}
+
+var SearchGamesRequest_CpID_DEFAULT int64
+
+func (p *SearchGamesRequest) GetCpID() (v int64) {
+	if !p.IsSetCpID() {
+		return SearchGamesRequest_CpID_DEFAULT
+	}
+	return *p.CpID
+}
+
+var SearchGamesRequest_Status_DEFAULT []GameStatus
+
+func (p *SearchGamesRequest) GetStatus() (v []GameStatus) {
+	if !p.IsSetStatus() {
+		return SearchGamesRequest_Status_DEFAULT
+	}
+	return p.Status
+}
+
+func (p *SearchGamesRequest) GetPageNum() (v int32) {
+	return p.PageNum
+}
+
+func (p *SearchGamesRequest) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *SearchGamesRequest) SetQuery(val string) {
+	p.Query = val
+}
+func (p *SearchGamesRequest) SetCpID(val *int64) {
+	p.CpID = val
+}
+func (p *SearchGamesRequest) SetStatus(val []GameStatus) {
+	p.Status = val
+}
+func (p *SearchGamesRequest) SetPageNum(val int32) {
+	p.PageNum = val
+}
+func (p *SearchGamesRequest) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *SearchGamesRequest) IsSetCpID() bool {
+	return p.CpID != nil
+}
+
+func (p *SearchGamesRequest) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *SearchGamesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchGamesRequest(%+v)", *p)
+}
+
+var fieldIDToName_SearchGamesRequest = map[int16]string{
+	1: "Query",
+	2: "CpID",
+	3: "Status",
+	4: "PageNum",
+	5: "PageSize",
+}
+
+type SearchHighlight struct {
+	Field   string `thrift:"Field,1" frugal:"1,default,string" json:"Field"`
+	Snippet string `thrift:"Snippet,2" frugal:"2,default,string" json:"Snippet"`
+}
+
+func NewSearchHighlight() *SearchHighlight {
+	return &SearchHighlight{}
+}
+
+func (p *SearchHighlight) InitDefault() {
+}
+
+func (p *SearchHighlight) GetField() (v string) {
+	return p.Field
+}
+
+func (p *SearchHighlight) GetSnippet() (v string) {
+	return p.Snippet
+}
+func (p *SearchHighlight) SetField(val string) {
+	p.Field = val
+}
+func (p *SearchHighlight) SetSnippet(val string) {
+	p.Snippet = val
+}
+
+func (p *SearchHighlight) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchHighlight(%+v)", *p)
+}
+
+var fieldIDToName_SearchHighlight = map[int16]string{
+	1: "Field",
+	2: "Snippet",
+}
+
+type SearchGameHit struct {
+	Game       *BriefGame         `thrift:"Game,1" frugal:"1,default,BriefGame" json:"Game"`
+	Score      float64            `thrift:"Score,2" frugal:"2,default,double" json:"Score"`
+	Highlights []*SearchHighlight `thrift:"Highlights,3" frugal:"3,default,list<SearchHighlight>" json:"Highlights"`
+}
+
+func NewSearchGameHit() *SearchGameHit {
+	return &SearchGameHit{}
+}
+
+func (p *SearchGameHit) InitDefault() {
+}
+
+var SearchGameHit_Game_DEFAULT *BriefGame
+
+func (p *SearchGameHit) GetGame() (v *BriefGame) {
+	if !p.IsSetGame() {
+		return SearchGameHit_Game_DEFAULT
+	}
+	return p.Game
+}
+
+func (p *SearchGameHit) GetScore() (v float64) {
+	return p.Score
+}
+
+func (p *SearchGameHit) GetHighlights() (v []*SearchHighlight) {
+	return p.Highlights
+}
+func (p *SearchGameHit) SetGame(val *BriefGame) {
+	p.Game = val
+}
+func (p *SearchGameHit) SetScore(val float64) {
+	p.Score = val
+}
+func (p *SearchGameHit) SetHighlights(val []*SearchHighlight) {
+	p.Highlights = val
+}
+
+func (p *SearchGameHit) IsSetGame() bool {
+	return p.Game != nil
+}
+
+func (p *SearchGameHit) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchGameHit(%+v)", *p)
+}
+
+var fieldIDToName_SearchGameHit = map[int16]string{
+	1: "Game",
+	2: "Score",
+	3: "Highlights",
+}
+
+type SearchGamesResponse struct {
+	Hits       []*SearchGameHit `thrift:"Hits,1" frugal:"1,default,list<SearchGameHit>" json:"Hits"`
+	TotalCount int32            `thrift:"TotalCount,2" frugal:"2,default,i32" json:"TotalCount"`
+	BaseResp   *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewSearchGamesResponse() *SearchGamesResponse {
+	return &SearchGamesResponse{}
+}
+
+func (p *SearchGamesResponse) InitDefault() {
+}
+
+func (p *SearchGamesResponse) GetHits() (v []*SearchGameHit) {
+	return p.Hits
+}
+
+func (p *SearchGamesResponse) GetTotalCount() (v int32) {
+	return p.TotalCount
+}
+
+var SearchGamesResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *SearchGamesResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return SearchGamesResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *SearchGamesResponse) SetHits(val []*SearchGameHit) {
+	p.Hits = val
+}
+func (p *SearchGamesResponse) SetTotalCount(val int32) {
+	p.TotalCount = val
+}
+func (p *SearchGamesResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *SearchGamesResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *SearchGamesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchGamesResponse(%+v)", *p)
+}
+
+var fieldIDToName_SearchGamesResponse = map[int16]string{
+	1:   "Hits",
+	2:   "TotalCount",
+	255: "BaseResp",
+}
+
+type BatchGetGameDetailsRequest struct {
+	GameIDs []int64 `thrift:"GameIDs,1" frugal:"1,default,list<i64>" json:"GameIDs"`
+}
+
+func NewBatchGetGameDetailsRequest() *BatchGetGameDetailsRequest {
+	return &BatchGetGameDetailsRequest{}
+}
+
+func (p *BatchGetGameDetailsRequest) InitDefault() {
+}
+
+func (p *BatchGetGameDetailsRequest) GetGameIDs() (v []int64) {
+	return p.GameIDs
+}
+func (p *BatchGetGameDetailsRequest) SetGameIDs(val []int64) {
+	p.GameIDs = val
+}
+
+func (p *BatchGetGameDetailsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchGetGameDetailsRequest(%+v)", *p)
+}
+
+var fieldIDToName_BatchGetGameDetailsRequest = map[int16]string{
+	1: "GameIDs",
+}
+
+type BatchGetGameDetailsResponse struct {
+	GameDetails    []*GameDetail    `thrift:"GameDetails,1" frugal:"1,default,list<GameDetail>" json:"GameDetails"`
+	MissingGameIDs []int64          `thrift:"MissingGameIDs,2" frugal:"2,default,list<i64>" json:"MissingGameIDs"`
+	BaseResp       *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewBatchGetGameDetailsResponse() *BatchGetGameDetailsResponse {
+	return &BatchGetGameDetailsResponse{}
+}
+
+func (p *BatchGetGameDetailsResponse) InitDefault() {
+}
+
+func (p *BatchGetGameDetailsResponse) GetGameDetails() (v []*GameDetail) {
+	return p.GameDetails
+}
+
+func (p *BatchGetGameDetailsResponse) GetMissingGameIDs() (v []int64) {
+	return p.MissingGameIDs
+}
+
+var BatchGetGameDetailsResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *BatchGetGameDetailsResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return BatchGetGameDetailsResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *BatchGetGameDetailsResponse) SetGameDetails(val []*GameDetail) {
+	p.GameDetails = val
+}
+func (p *BatchGetGameDetailsResponse) SetMissingGameIDs(val []int64) {
+	p.MissingGameIDs = val
+}
+func (p *BatchGetGameDetailsResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *BatchGetGameDetailsResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *BatchGetGameDetailsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchGetGameDetailsResponse(%+v)", *p)
+}
+
+var fieldIDToName_BatchGetGameDetailsResponse = map[int16]string{
+	1:   "GameDetails",
+	2:   "MissingGameIDs",
+	255: "BaseResp",
+}
+
+type ReleasePackageNameRequest struct {
+	Platform         GamePlatform `thrift:"Platform,1" frugal:"1,default,GamePlatform" json:"Platform"`
+	PackageName      string       `thrift:"PackageName,2" frugal:"2,default,string" json:"PackageName"`
+	TransferToGameID *int64       `thrift:"TransferToGameID,3,optional" frugal:"3,optional,i64" json:"TransferToGameID,omitempty"`
+	Reason           string       `thrift:"Reason,4" frugal:"4,default,string" json:"Reason"`
+	Operator         string       `thrift:"Operator,5" frugal:"5,default,string" json:"Operator"`
+}
+
+func NewReleasePackageNameRequest() *ReleasePackageNameRequest {
+	return &ReleasePackageNameRequest{}
+}
+
+func (p *ReleasePackageNameRequest) InitDefault() {
+}
+
+func (p *ReleasePackageNameRequest) GetPlatform() (v GamePlatform) {
+	return p.Platform
+}
+
+func (p *ReleasePackageNameRequest) GetPackageName() (v string) {
+	return p.PackageName
+}
+
+var ReleasePackageNameRequest_TransferToGameID_DEFAULT int64
+
+func (p *ReleasePackageNameRequest) GetTransferToGameID() (v int64) {
+	if !p.IsSetTransferToGameID() {
+		return ReleasePackageNameRequest_TransferToGameID_DEFAULT
+	}
+	return *p.TransferToGameID
+}
+
+func (p *ReleasePackageNameRequest) GetReason() (v string) {
+	return p.Reason
+}
+
+func (p *ReleasePackageNameRequest) GetOperator() (v string) {
+	return p.Operator
+}
+func (p *ReleasePackageNameRequest) SetPlatform(val GamePlatform) {
+	p.Platform = val
+}
+func (p *ReleasePackageNameRequest) SetPackageName(val string) {
+	p.PackageName = val
+}
+func (p *ReleasePackageNameRequest) SetTransferToGameID(val *int64) {
+	p.TransferToGameID = val
+}
+func (p *ReleasePackageNameRequest) SetReason(val string) {
+	p.Reason = val
+}
+func (p *ReleasePackageNameRequest) SetOperator(val string) {
+	p.Operator = val
+}
+
+func (p *ReleasePackageNameRequest) IsSetTransferToGameID() bool {
+	return p.TransferToGameID != nil
+}
+
+func (p *ReleasePackageNameRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReleasePackageNameRequest(%+v)", *p)
+}
+
+var fieldIDToName_ReleasePackageNameRequest = map[int16]string{
+	1: "Platform",
+	2: "PackageName",
+	3: "TransferToGameID",
+	4: "Reason",
+	5: "Operator",
+}
+
+type ReleasePackageNameResponse struct {
+	PreviousGameID int64            `thrift:"PreviousGameID,1" frugal:"1,default,i64" json:"PreviousGameID"`
+	BaseResp       *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewReleasePackageNameResponse() *ReleasePackageNameResponse {
+	return &ReleasePackageNameResponse{}
+}
+
+func (p *ReleasePackageNameResponse) InitDefault() {
+}
+
+func (p *ReleasePackageNameResponse) GetPreviousGameID() (v int64) {
+	return p.PreviousGameID
+}
+
+var ReleasePackageNameResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ReleasePackageNameResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ReleasePackageNameResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ReleasePackageNameResponse) SetPreviousGameID(val int64) {
+	p.PreviousGameID = val
+}
+func (p *ReleasePackageNameResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *ReleasePackageNameResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ReleasePackageNameResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReleasePackageNameResponse(%+v)", *p)
+}
+
+var fieldIDToName_ReleasePackageNameResponse = map[int16]string{
+	1:   "PreviousGameID",
+	255: "BaseResp",
+}
+
+type Category struct {
+	CategoryID int64  `thrift:"CategoryID,1" frugal:"1,default,i64" json:"CategoryID"`
+	ParentID   int64  `thrift:"ParentID,2" frugal:"2,default,i64" json:"ParentID"`
+	Name       string `thrift:"Name,3" frugal:"3,default,string" json:"Name"`
+	SortOrder  int32  `thrift:"SortOrder,4" frugal:"4,default,i32" json:"SortOrder"`
+	CreateTime int64  `thrift:"CreateTime,5" frugal:"5,default,i64" json:"CreateTime"`
+	UpdateTime int64  `thrift:"UpdateTime,6" frugal:"6,default,i64" json:"UpdateTime"`
+}
+
+func NewCategory() *Category {
+	return &Category{}
+}
+
+func (p *Category) InitDefault() {
+}
+
+func (p *Category) GetCategoryID() (v int64) {
+	return p.CategoryID
+}
+
+func (p *Category) GetParentID() (v int64) {
+	return p.ParentID
+}
+
+func (p *Category) GetName() (v string) {
+	return p.Name
+}
+
+func (p *Category) GetSortOrder() (v int32) {
+	return p.SortOrder
+}
+
+func (p *Category) GetCreateTime() (v int64) {
+	return p.CreateTime
+}
+
+func (p *Category) GetUpdateTime() (v int64) {
+	return p.UpdateTime
+}
+func (p *Category) SetCategoryID(val int64) {
+	p.CategoryID = val
+}
+func (p *Category) SetParentID(val int64) {
+	p.ParentID = val
+}
+func (p *Category) SetName(val string) {
+	p.Name = val
+}
+func (p *Category) SetSortOrder(val int32) {
+	p.SortOrder = val
+}
+func (p *Category) SetCreateTime(val int64) {
+	p.CreateTime = val
+}
+func (p *Category) SetUpdateTime(val int64) {
+	p.UpdateTime = val
+}
+
+func (p *Category) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Category(%+v)", *p)
+}
+
+var fieldIDToName_Category = map[int16]string{
+	1: "CategoryID",
+	2: "ParentID",
+	3: "Name",
+	4: "SortOrder",
+	5: "CreateTime",
+	6: "UpdateTime",
+}
+
+type Tag struct {
+	TagID      int64  `thrift:"TagID,1" frugal:"1,default,i64" json:"TagID"`
+	Name       string `thrift:"Name,2" frugal:"2,default,string" json:"Name"`
+	CreateTime int64  `thrift:"CreateTime,3" frugal:"3,default,i64" json:"CreateTime"`
+	UpdateTime int64  `thrift:"UpdateTime,4" frugal:"4,default,i64" json:"UpdateTime"`
+}
+
+func NewTag() *Tag {
+	return &Tag{}
+}
+
+func (p *Tag) InitDefault() {
+}
+
+func (p *Tag) GetTagID() (v int64) {
+	return p.TagID
+}
+
+func (p *Tag) GetName() (v string) {
+	return p.Name
+}
+
+func (p *Tag) GetCreateTime() (v int64) {
+	return p.CreateTime
+}
+
+func (p *Tag) GetUpdateTime() (v int64) {
+	return p.UpdateTime
+}
+func (p *Tag) SetTagID(val int64) {
+	p.TagID = val
+}
+func (p *Tag) SetName(val string) {
+	p.Name = val
+}
+func (p *Tag) SetCreateTime(val int64) {
+	p.CreateTime = val
+}
+func (p *Tag) SetUpdateTime(val int64) {
+	p.UpdateTime = val
+}
+
+func (p *Tag) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Tag(%+v)", *p)
+}
+
+var fieldIDToName_Tag = map[int16]string{
+	1: "TagID",
+	2: "Name",
+	3: "CreateTime",
+	4: "UpdateTime",
+}
+
+type CreateCategoryRequest struct {
+	Name      string `thrift:"Name,1" frugal:"1,default,string" json:"Name"`
+	ParentID  int64  `thrift:"ParentID,2" frugal:"2,default,i64" json:"ParentID"`
+	SortOrder int32  `thrift:"SortOrder,3" frugal:"3,default,i32" json:"SortOrder"`
+}
+
+func NewCreateCategoryRequest() *CreateCategoryRequest {
+	return &CreateCategoryRequest{}
+}
+
+func (p *CreateCategoryRequest) InitDefault() {
+}
+
+func (p *CreateCategoryRequest) GetName() (v string) {
+	return p.Name
+}
+
+func (p *CreateCategoryRequest) GetParentID() (v int64) {
+	return p.ParentID
+}
+
+func (p *CreateCategoryRequest) GetSortOrder() (v int32) {
+	return p.SortOrder
+}
+func (p *CreateCategoryRequest) SetName(val string) {
+	p.Name = val
+}
+func (p *CreateCategoryRequest) SetParentID(val int64) {
+	p.ParentID = val
+}
+func (p *CreateCategoryRequest) SetSortOrder(val int32) {
+	p.SortOrder = val
+}
+
+func (p *CreateCategoryRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateCategoryRequest(%+v)", *p)
+}
+
+var fieldIDToName_CreateCategoryRequest = map[int16]string{
+	1: "Name",
+	2: "ParentID",
+	3: "SortOrder",
+}
+
+type CreateCategoryResponse struct {
+	CategoryID int64            `thrift:"CategoryID,1" frugal:"1,default,i64" json:"CategoryID"`
+	BaseResp   *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewCreateCategoryResponse() *CreateCategoryResponse {
+	return &CreateCategoryResponse{}
+}
+
+func (p *CreateCategoryResponse) InitDefault() {
+}
+
+func (p *CreateCategoryResponse) GetCategoryID() (v int64) {
+	return p.CategoryID
+}
+
+var CreateCategoryResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *CreateCategoryResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CreateCategoryResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *CreateCategoryResponse) SetCategoryID(val int64) {
+	p.CategoryID = val
+}
+func (p *CreateCategoryResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *CreateCategoryResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CreateCategoryResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateCategoryResponse(%+v)", *p)
+}
+
+var fieldIDToName_CreateCategoryResponse = map[int16]string{
+	1:   "CategoryID",
+	255: "BaseResp",
+}
+
+type UpdateCategoryRequest struct {
+	CategoryID int64  `thrift:"CategoryID,1" frugal:"1,default,i64" json:"CategoryID"`
+	Name       string `thrift:"Name,2" frugal:"2,default,string" json:"Name"`
+	ParentID   int64  `thrift:"ParentID,3" frugal:"3,default,i64" json:"ParentID"`
+	SortOrder  int32  `thrift:"SortOrder,4" frugal:"4,default,i32" json:"SortOrder"`
+}
+
+func NewUpdateCategoryRequest() *UpdateCategoryRequest {
+	return &UpdateCategoryRequest{}
+}
+
+func (p *UpdateCategoryRequest) InitDefault() {
+}
+
+func (p *UpdateCategoryRequest) GetCategoryID() (v int64) {
+	return p.CategoryID
+}
+
+func (p *UpdateCategoryRequest) GetName() (v string) {
+	return p.Name
+}
+
+func (p *UpdateCategoryRequest) GetParentID() (v int64) {
+	return p.ParentID
+}
+
+func (p *UpdateCategoryRequest) GetSortOrder() (v int32) {
+	return p.SortOrder
+}
+func (p *UpdateCategoryRequest) SetCategoryID(val int64) {
+	p.CategoryID = val
+}
+func (p *UpdateCategoryRequest) SetName(val string) {
+	p.Name = val
+}
+func (p *UpdateCategoryRequest) SetParentID(val int64) {
+	p.ParentID = val
+}
+func (p *UpdateCategoryRequest) SetSortOrder(val int32) {
+	p.SortOrder = val
+}
+
+func (p *UpdateCategoryRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateCategoryRequest(%+v)", *p)
+}
+
+var fieldIDToName_UpdateCategoryRequest = map[int16]string{
+	1: "CategoryID",
+	2: "Name",
+	3: "ParentID",
+	4: "SortOrder",
+}
+
+type UpdateCategoryResponse struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewUpdateCategoryResponse() *UpdateCategoryResponse {
+	return &UpdateCategoryResponse{}
+}
+
+func (p *UpdateCategoryResponse) InitDefault() {
+}
+
+var UpdateCategoryResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *UpdateCategoryResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return UpdateCategoryResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *UpdateCategoryResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *UpdateCategoryResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *UpdateCategoryResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateCategoryResponse(%+v)", *p)
+}
+
+var fieldIDToName_UpdateCategoryResponse = map[int16]string{
+	255: "BaseResp",
+}
+
+type DeleteCategoryRequest struct {
+	CategoryID int64 `thrift:"CategoryID,1" frugal:"1,default,i64" json:"CategoryID"`
+}
+
+func NewDeleteCategoryRequest() *DeleteCategoryRequest {
+	return &DeleteCategoryRequest{}
+}
+
+func (p *DeleteCategoryRequest) InitDefault() {
+}
+
+func (p *DeleteCategoryRequest) GetCategoryID() (v int64) {
+	return p.CategoryID
+}
+func (p *DeleteCategoryRequest) SetCategoryID(val int64) {
+	p.CategoryID = val
+}
+
+func (p *DeleteCategoryRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteCategoryRequest(%+v)", *p)
+}
+
+var fieldIDToName_DeleteCategoryRequest = map[int16]string{
+	1: "CategoryID",
+}
+
+type DeleteCategoryResponse struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewDeleteCategoryResponse() *DeleteCategoryResponse {
+	return &DeleteCategoryResponse{}
+}
+
+func (p *DeleteCategoryResponse) InitDefault() {
+}
+
+var DeleteCategoryResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *DeleteCategoryResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return DeleteCategoryResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *DeleteCategoryResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *DeleteCategoryResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *DeleteCategoryResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteCategoryResponse(%+v)", *p)
+}
+
+var fieldIDToName_DeleteCategoryResponse = map[int16]string{
+	255: "BaseResp",
+}
+
+type ListCategoriesRequest struct {
+}
+
+func NewListCategoriesRequest() *ListCategoriesRequest {
+	return &ListCategoriesRequest{}
+}
+
+func (p *ListCategoriesRequest) InitDefault() {
+}
+
+func (p *ListCategoriesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListCategoriesRequest(%+v)", *p)
+}
+
+var fieldIDToName_ListCategoriesRequest = map[int16]string{}
+
+type ListCategoriesResponse struct {
+	Categories []*Category      `thrift:"Categories,1" frugal:"1,default,list<Category>" json:"Categories"`
+	BaseResp   *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewListCategoriesResponse() *ListCategoriesResponse {
+	return &ListCategoriesResponse{}
+}
+
+func (p *ListCategoriesResponse) InitDefault() {
+}
+
+func (p *ListCategoriesResponse) GetCategories() (v []*Category) {
+	return p.Categories
+}
+
+var ListCategoriesResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ListCategoriesResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ListCategoriesResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ListCategoriesResponse) SetCategories(val []*Category) {
+	p.Categories = val
+}
+func (p *ListCategoriesResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *ListCategoriesResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListCategoriesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListCategoriesResponse(%+v)", *p)
+}
+
+var fieldIDToName_ListCategoriesResponse = map[int16]string{
+	1:   "Categories",
+	255: "BaseResp",
+}
+
+type CreateTagRequest struct {
+	Name string `thrift:"Name,1" frugal:"1,default,string" json:"Name"`
+}
+
+func NewCreateTagRequest() *CreateTagRequest {
+	return &CreateTagRequest{}
+}
+
+func (p *CreateTagRequest) InitDefault() {
+}
+
+func (p *CreateTagRequest) GetName() (v string) {
+	return p.Name
+}
+func (p *CreateTagRequest) SetName(val string) {
+	p.Name = val
+}
+
+func (p *CreateTagRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateTagRequest(%+v)", *p)
+}
+
+var fieldIDToName_CreateTagRequest = map[int16]string{
+	1: "Name",
+}
+
+type CreateTagResponse struct {
+	TagID    int64            `thrift:"TagID,1" frugal:"1,default,i64" json:"TagID"`
+	BaseResp *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewCreateTagResponse() *CreateTagResponse {
+	return &CreateTagResponse{}
+}
+
+func (p *CreateTagResponse) InitDefault() {
+}
+
+func (p *CreateTagResponse) GetTagID() (v int64) {
+	return p.TagID
+}
+
+var CreateTagResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *CreateTagResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CreateTagResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *CreateTagResponse) SetTagID(val int64) {
+	p.TagID = val
+}
+func (p *CreateTagResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *CreateTagResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CreateTagResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateTagResponse(%+v)", *p)
+}
+
+var fieldIDToName_CreateTagResponse = map[int16]string{
+	1:   "TagID",
+	255: "BaseResp",
+}
+
+type UpdateTagRequest struct {
+	TagID int64  `thrift:"TagID,1" frugal:"1,default,i64" json:"TagID"`
+	Name  string `thrift:"Name,2" frugal:"2,default,string" json:"Name"`
+}
+
+func NewUpdateTagRequest() *UpdateTagRequest {
+	return &UpdateTagRequest{}
+}
+
+func (p *UpdateTagRequest) InitDefault() {
+}
+
+func (p *UpdateTagRequest) GetTagID() (v int64) {
+	return p.TagID
+}
+
+func (p *UpdateTagRequest) GetName() (v string) {
+	return p.Name
+}
+func (p *UpdateTagRequest) SetTagID(val int64) {
+	p.TagID = val
+}
+func (p *UpdateTagRequest) SetName(val string) {
+	p.Name = val
+}
+
+func (p *UpdateTagRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateTagRequest(%+v)", *p)
+}
+
+var fieldIDToName_UpdateTagRequest = map[int16]string{
+	1: "TagID",
+	2: "Name",
+}
+
+type UpdateTagResponse struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewUpdateTagResponse() *UpdateTagResponse {
+	return &UpdateTagResponse{}
+}
+
+func (p *UpdateTagResponse) InitDefault() {
+}
+
+var UpdateTagResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *UpdateTagResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return UpdateTagResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *UpdateTagResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *UpdateTagResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *UpdateTagResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateTagResponse(%+v)", *p)
+}
+
+var fieldIDToName_UpdateTagResponse = map[int16]string{
+	255: "BaseResp",
+}
+
+type DeleteTagRequest struct {
+	TagID int64 `thrift:"TagID,1" frugal:"1,default,i64" json:"TagID"`
+}
+
+func NewDeleteTagRequest() *DeleteTagRequest {
+	return &DeleteTagRequest{}
+}
+
+func (p *DeleteTagRequest) InitDefault() {
+}
+
+func (p *DeleteTagRequest) GetTagID() (v int64) {
+	return p.TagID
+}
+func (p *DeleteTagRequest) SetTagID(val int64) {
+	p.TagID = val
+}
+
+func (p *DeleteTagRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteTagRequest(%+v)", *p)
+}
+
+var fieldIDToName_DeleteTagRequest = map[int16]string{
+	1: "TagID",
+}
+
+type DeleteTagResponse struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewDeleteTagResponse() *DeleteTagResponse {
+	return &DeleteTagResponse{}
+}
+
+func (p *DeleteTagResponse) InitDefault() {
+}
+
+var DeleteTagResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *DeleteTagResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return DeleteTagResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *DeleteTagResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *DeleteTagResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *DeleteTagResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteTagResponse(%+v)", *p)
+}
+
+var fieldIDToName_DeleteTagResponse = map[int16]string{
+	255: "BaseResp",
+}
+
+type ListTagsRequest struct {
+}
+
+func NewListTagsRequest() *ListTagsRequest {
+	return &ListTagsRequest{}
+}
+
+func (p *ListTagsRequest) InitDefault() {
+}
+
+func (p *ListTagsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListTagsRequest(%+v)", *p)
+}
+
+var fieldIDToName_ListTagsRequest = map[int16]string{}
+
+type ListTagsResponse struct {
+	Tags     []*Tag           `thrift:"Tags,1" frugal:"1,default,list<Tag>" json:"Tags"`
+	BaseResp *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewListTagsResponse() *ListTagsResponse {
+	return &ListTagsResponse{}
+}
+
+func (p *ListTagsResponse) InitDefault() {
+}
+
+func (p *ListTagsResponse) GetTags() (v []*Tag) {
+	return p.Tags
+}
+
+var ListTagsResponse_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ListTagsResponse) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ListTagsResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ListTagsResponse) SetTags(val []*Tag) {
+	p.Tags = val
+}
+func (p *ListTagsResponse) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *ListTagsResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListTagsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListTagsResponse(%+v)", *p)
+}
+
+var fieldIDToName_ListTagsResponse = map[int16]string{
+	1:   "Tags",
+	255: "BaseResp",
+}
+
+type GameService interface {
+	GetGameList(ctx context.Context, req *GetGameListRequest) (r *GetGameListResponse, err error)
+
+	GetGameDetail(ctx context.Context, req *GetGameDetailRequest) (r *GetGameDetailResponse, err error)
+
+	UpdateGameDraft(ctx context.Context, req *UpdateGameDraftRequest) (r *UpdateGameDraftResponse, err error)
+
+	CreateGameDetail(ctx context.Context, req *CreateGameDetailRequest) (r *CreateGameDetailResponse, err error)
+
+	ReviewGameVersion(ctx context.Context, req *ReviewGameVersionRequest) (r *ReviewGameVersionResponse, err error)
+
+	DeleteGameDraft(ctx context.Context, req *DeleteGameDraftRequest) (r *DeleteGameDraftResponse, err error)
+
+	ListGameVersions(ctx context.Context, req *ListGameVersionsRequest) (r *ListGameVersionsResponse, err error)
+
+	RollbackGameVersion(ctx context.Context, req *RollbackGameVersionRequest) (r *RollbackGameVersionResponse, err error)
+
+	CancelScheduledPublish(ctx context.Context, req *CancelScheduledPublishRequest) (r *CancelScheduledPublishResponse, err error)
+
+	GetGameReviewLogs(ctx context.Context, req *GetGameReviewLogsRequest) (r *GetGameReviewLogsResponse, err error)
+
+	SubmitGameVersion(ctx context.Context, req *SubmitGameVersionRequest) (r *SubmitGameVersionResponse, err error)
+
+	WithdrawGameVersion(ctx context.Context, req *WithdrawGameVersionRequest) (r *WithdrawGameVersionResponse, err error)
+
+	DiffGameVersions(ctx context.Context, req *DiffGameVersionsRequest) (r *DiffGameVersionsResponse, err error)
+
+	TakedownGame(ctx context.Context, req *TakedownGameRequest) (r *TakedownGameResponse, err error)
+
+	RestoreGame(ctx context.Context, req *RestoreGameRequest) (r *RestoreGameResponse, err error)
+
+	ListDeletedGameDrafts(ctx context.Context, req *ListDeletedGameDraftsRequest) (r *ListDeletedGameDraftsResponse, err error)
+
+	RestoreGameDraft(ctx context.Context, req *RestoreGameDraftRequest) (r *RestoreGameDraftResponse, err error)
+
+	SearchGames(ctx context.Context, req *SearchGamesRequest) (r *SearchGamesResponse, err error)
+
+	BatchGetGameDetails(ctx context.Context, req *BatchGetGameDetailsRequest) (r *BatchGetGameDetailsResponse, err error)
+
+	ReleasePackageName(ctx context.Context, req *ReleasePackageNameRequest) (r *ReleasePackageNameResponse, err error)
+
+	CreateCategory(ctx context.Context, req *CreateCategoryRequest) (r *CreateCategoryResponse, err error)
+
+	UpdateCategory(ctx context.Context, req *UpdateCategoryRequest) (r *UpdateCategoryResponse, err error)
+
+	DeleteCategory(ctx context.Context, req *DeleteCategoryRequest) (r *DeleteCategoryResponse, err error)
+
+	ListCategories(ctx context.Context, req *ListCategoriesRequest) (r *ListCategoriesResponse, err error)
+
+	CreateTag(ctx context.Context, req *CreateTagRequest) (r *CreateTagResponse, err error)
+
+	UpdateTag(ctx context.Context, req *UpdateTagRequest) (r *UpdateTagResponse, err error)
+
+	DeleteTag(ctx context.Context, req *DeleteTagRequest) (r *DeleteTagResponse, err error)
+
+	ListTags(ctx context.Context, req *ListTagsRequest) (r *ListTagsResponse, err error)
+
+	UpdateGameRollout(ctx context.Context, req *UpdateGameRolloutRequest) (r *UpdateGameRolloutResponse, err error)
+
+	GetGameRollout(ctx context.Context, req *GetGameRolloutRequest) (r *GetGameRolloutResponse, err error)
+
+	ResolveServedVersion(ctx context.Context, req *ResolveServedVersionRequest) (r *ResolveServedVersionResponse, err error)
+
+	GetGameChangelog(ctx context.Context, req *GetGameChangelogRequest) (r *GetGameChangelogResponse, err error)
+}
+
+type GameServiceGetGameListArgs struct {
+	Req *GetGameListRequest `thrift:"req,1" frugal:"1,default,GetGameListRequest" json:"req"`
+}
+
+func NewGameServiceGetGameListArgs() *GameServiceGetGameListArgs {
+	return &GameServiceGetGameListArgs{}
+}
+
+func (p *GameServiceGetGameListArgs) InitDefault() {
+}
+
+var GameServiceGetGameListArgs_Req_DEFAULT *GetGameListRequest
+
+func (p *GameServiceGetGameListArgs) GetReq() (v *GetGameListRequest) {
+	if !p.IsSetReq() {
+		return GameServiceGetGameListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceGetGameListArgs) SetReq(val *GetGameListRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceGetGameListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceGetGameListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceGetGameListArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceGetGameListArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceGetGameListResult struct {
+	Success *GetGameListResponse `thrift:"success,0,optional" frugal:"0,optional,GetGameListResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceGetGameListResult() *GameServiceGetGameListResult {
+	return &GameServiceGetGameListResult{}
+}
+
+func (p *GameServiceGetGameListResult) InitDefault() {
+}
+
+var GameServiceGetGameListResult_Success_DEFAULT *GetGameListResponse
+
+func (p *GameServiceGetGameListResult) GetSuccess() (v *GetGameListResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceGetGameListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceGetGameListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetGameListResponse)
+}
+
+func (p *GameServiceGetGameListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceGetGameListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceGetGameListResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceGetGameListResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceGetGameDetailArgs struct {
+	Req *GetGameDetailRequest `thrift:"req,1" frugal:"1,default,GetGameDetailRequest" json:"req"`
+}
+
+func NewGameServiceGetGameDetailArgs() *GameServiceGetGameDetailArgs {
+	return &GameServiceGetGameDetailArgs{}
+}
+
+func (p *GameServiceGetGameDetailArgs) InitDefault() {
+}
+
+var GameServiceGetGameDetailArgs_Req_DEFAULT *GetGameDetailRequest
+
+func (p *GameServiceGetGameDetailArgs) GetReq() (v *GetGameDetailRequest) {
+	if !p.IsSetReq() {
+		return GameServiceGetGameDetailArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceGetGameDetailArgs) SetReq(val *GetGameDetailRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceGetGameDetailArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceGetGameDetailArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceGetGameDetailArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceGetGameDetailArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceGetGameDetailResult struct {
+	Success *GetGameDetailResponse `thrift:"success,0,optional" frugal:"0,optional,GetGameDetailResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceGetGameDetailResult() *GameServiceGetGameDetailResult {
+	return &GameServiceGetGameDetailResult{}
+}
+
+func (p *GameServiceGetGameDetailResult) InitDefault() {
+}
+
+var GameServiceGetGameDetailResult_Success_DEFAULT *GetGameDetailResponse
+
+func (p *GameServiceGetGameDetailResult) GetSuccess() (v *GetGameDetailResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceGetGameDetailResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceGetGameDetailResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetGameDetailResponse)
+}
+
+func (p *GameServiceGetGameDetailResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceGetGameDetailResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceGetGameDetailResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceGetGameDetailResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceUpdateGameDraftArgs struct {
+	Req *UpdateGameDraftRequest `thrift:"req,1" frugal:"1,default,UpdateGameDraftRequest" json:"req"`
+}
+
+func NewGameServiceUpdateGameDraftArgs() *GameServiceUpdateGameDraftArgs {
+	return &GameServiceUpdateGameDraftArgs{}
+}
+
+func (p *GameServiceUpdateGameDraftArgs) InitDefault() {
+}
+
+var GameServiceUpdateGameDraftArgs_Req_DEFAULT *UpdateGameDraftRequest
+
+func (p *GameServiceUpdateGameDraftArgs) GetReq() (v *UpdateGameDraftRequest) {
+	if !p.IsSetReq() {
+		return GameServiceUpdateGameDraftArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceUpdateGameDraftArgs) SetReq(val *UpdateGameDraftRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceUpdateGameDraftArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceUpdateGameDraftArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceUpdateGameDraftArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceUpdateGameDraftArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceUpdateGameDraftResult struct {
+	Success *UpdateGameDraftResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateGameDraftResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceUpdateGameDraftResult() *GameServiceUpdateGameDraftResult {
+	return &GameServiceUpdateGameDraftResult{}
+}
+
+func (p *GameServiceUpdateGameDraftResult) InitDefault() {
+}
+
+var GameServiceUpdateGameDraftResult_Success_DEFAULT *UpdateGameDraftResponse
+
+func (p *GameServiceUpdateGameDraftResult) GetSuccess() (v *UpdateGameDraftResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceUpdateGameDraftResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceUpdateGameDraftResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateGameDraftResponse)
+}
+
+func (p *GameServiceUpdateGameDraftResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceUpdateGameDraftResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceUpdateGameDraftResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceUpdateGameDraftResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceCreateGameDetailArgs struct {
+	Req *CreateGameDetailRequest `thrift:"req,1" frugal:"1,default,CreateGameDetailRequest" json:"req"`
+}
+
+func NewGameServiceCreateGameDetailArgs() *GameServiceCreateGameDetailArgs {
+	return &GameServiceCreateGameDetailArgs{}
+}
+
+func (p *GameServiceCreateGameDetailArgs) InitDefault() {
+}
+
+var GameServiceCreateGameDetailArgs_Req_DEFAULT *CreateGameDetailRequest
+
+func (p *GameServiceCreateGameDetailArgs) GetReq() (v *CreateGameDetailRequest) {
+	if !p.IsSetReq() {
+		return GameServiceCreateGameDetailArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceCreateGameDetailArgs) SetReq(val *CreateGameDetailRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceCreateGameDetailArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceCreateGameDetailArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceCreateGameDetailArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceCreateGameDetailArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceCreateGameDetailResult struct {
+	Success *CreateGameDetailResponse `thrift:"success,0,optional" frugal:"0,optional,CreateGameDetailResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceCreateGameDetailResult() *GameServiceCreateGameDetailResult {
+	return &GameServiceCreateGameDetailResult{}
+}
+
+func (p *GameServiceCreateGameDetailResult) InitDefault() {
+}
+
+var GameServiceCreateGameDetailResult_Success_DEFAULT *CreateGameDetailResponse
+
+func (p *GameServiceCreateGameDetailResult) GetSuccess() (v *CreateGameDetailResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceCreateGameDetailResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceCreateGameDetailResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateGameDetailResponse)
+}
+
+func (p *GameServiceCreateGameDetailResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceCreateGameDetailResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceCreateGameDetailResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceCreateGameDetailResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceReviewGameVersionArgs struct {
+	Req *ReviewGameVersionRequest `thrift:"req,1" frugal:"1,default,ReviewGameVersionRequest" json:"req"`
+}
+
+func NewGameServiceReviewGameVersionArgs() *GameServiceReviewGameVersionArgs {
+	return &GameServiceReviewGameVersionArgs{}
+}
+
+func (p *GameServiceReviewGameVersionArgs) InitDefault() {
+}
+
+var GameServiceReviewGameVersionArgs_Req_DEFAULT *ReviewGameVersionRequest
+
+func (p *GameServiceReviewGameVersionArgs) GetReq() (v *ReviewGameVersionRequest) {
+	if !p.IsSetReq() {
+		return GameServiceReviewGameVersionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceReviewGameVersionArgs) SetReq(val *ReviewGameVersionRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceReviewGameVersionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceReviewGameVersionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceReviewGameVersionArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceReviewGameVersionArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceReviewGameVersionResult struct {
+	Success *ReviewGameVersionResponse `thrift:"success,0,optional" frugal:"0,optional,ReviewGameVersionResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceReviewGameVersionResult() *GameServiceReviewGameVersionResult {
+	return &GameServiceReviewGameVersionResult{}
+}
+
+func (p *GameServiceReviewGameVersionResult) InitDefault() {
+}
+
+var GameServiceReviewGameVersionResult_Success_DEFAULT *ReviewGameVersionResponse
+
+func (p *GameServiceReviewGameVersionResult) GetSuccess() (v *ReviewGameVersionResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceReviewGameVersionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceReviewGameVersionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReviewGameVersionResponse)
+}
+
+func (p *GameServiceReviewGameVersionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceReviewGameVersionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceReviewGameVersionResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceReviewGameVersionResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceDeleteGameDraftArgs struct {
+	Req *DeleteGameDraftRequest `thrift:"req,1" frugal:"1,default,DeleteGameDraftRequest" json:"req"`
+}
+
+func NewGameServiceDeleteGameDraftArgs() *GameServiceDeleteGameDraftArgs {
+	return &GameServiceDeleteGameDraftArgs{}
+}
+
+func (p *GameServiceDeleteGameDraftArgs) InitDefault() {
+}
+
+var GameServiceDeleteGameDraftArgs_Req_DEFAULT *DeleteGameDraftRequest
+
+func (p *GameServiceDeleteGameDraftArgs) GetReq() (v *DeleteGameDraftRequest) {
+	if !p.IsSetReq() {
+		return GameServiceDeleteGameDraftArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceDeleteGameDraftArgs) SetReq(val *DeleteGameDraftRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceDeleteGameDraftArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceDeleteGameDraftArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceDeleteGameDraftArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceDeleteGameDraftArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceDeleteGameDraftResult struct {
+	Success *DeleteGameDraftResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteGameDraftResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceDeleteGameDraftResult() *GameServiceDeleteGameDraftResult {
+	return &GameServiceDeleteGameDraftResult{}
+}
+
+func (p *GameServiceDeleteGameDraftResult) InitDefault() {
+}
+
+var GameServiceDeleteGameDraftResult_Success_DEFAULT *DeleteGameDraftResponse
+
+func (p *GameServiceDeleteGameDraftResult) GetSuccess() (v *DeleteGameDraftResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceDeleteGameDraftResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceDeleteGameDraftResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteGameDraftResponse)
+}
+
+func (p *GameServiceDeleteGameDraftResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceDeleteGameDraftResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceDeleteGameDraftResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceDeleteGameDraftResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceListGameVersionsArgs struct {
+	Req *ListGameVersionsRequest `thrift:"req,1" frugal:"1,default,ListGameVersionsRequest" json:"req"`
+}
+
+func NewGameServiceListGameVersionsArgs() *GameServiceListGameVersionsArgs {
+	return &GameServiceListGameVersionsArgs{}
+}
+
+func (p *GameServiceListGameVersionsArgs) InitDefault() {
+}
+
+var GameServiceListGameVersionsArgs_Req_DEFAULT *ListGameVersionsRequest
+
+func (p *GameServiceListGameVersionsArgs) GetReq() (v *ListGameVersionsRequest) {
+	if !p.IsSetReq() {
+		return GameServiceListGameVersionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceListGameVersionsArgs) SetReq(val *ListGameVersionsRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceListGameVersionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceListGameVersionsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceListGameVersionsArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceListGameVersionsArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceListGameVersionsResult struct {
+	Success *ListGameVersionsResponse `thrift:"success,0,optional" frugal:"0,optional,ListGameVersionsResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceListGameVersionsResult() *GameServiceListGameVersionsResult {
+	return &GameServiceListGameVersionsResult{}
+}
+
+func (p *GameServiceListGameVersionsResult) InitDefault() {
+}
+
+var GameServiceListGameVersionsResult_Success_DEFAULT *ListGameVersionsResponse
+
+func (p *GameServiceListGameVersionsResult) GetSuccess() (v *ListGameVersionsResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceListGameVersionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceListGameVersionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListGameVersionsResponse)
+}
+
+func (p *GameServiceListGameVersionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceListGameVersionsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceListGameVersionsResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceListGameVersionsResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceRollbackGameVersionArgs struct {
+	Req *RollbackGameVersionRequest `thrift:"req,1" frugal:"1,default,RollbackGameVersionRequest" json:"req"`
+}
+
+func NewGameServiceRollbackGameVersionArgs() *GameServiceRollbackGameVersionArgs {
+	return &GameServiceRollbackGameVersionArgs{}
+}
+
+func (p *GameServiceRollbackGameVersionArgs) InitDefault() {
+}
+
+var GameServiceRollbackGameVersionArgs_Req_DEFAULT *RollbackGameVersionRequest
+
+func (p *GameServiceRollbackGameVersionArgs) GetReq() (v *RollbackGameVersionRequest) {
+	if !p.IsSetReq() {
+		return GameServiceRollbackGameVersionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceRollbackGameVersionArgs) SetReq(val *RollbackGameVersionRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceRollbackGameVersionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceRollbackGameVersionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceRollbackGameVersionArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceRollbackGameVersionArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceRollbackGameVersionResult struct {
+	Success *RollbackGameVersionResponse `thrift:"success,0,optional" frugal:"0,optional,RollbackGameVersionResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceRollbackGameVersionResult() *GameServiceRollbackGameVersionResult {
+	return &GameServiceRollbackGameVersionResult{}
+}
+
+func (p *GameServiceRollbackGameVersionResult) InitDefault() {
+}
+
+var GameServiceRollbackGameVersionResult_Success_DEFAULT *RollbackGameVersionResponse
+
+func (p *GameServiceRollbackGameVersionResult) GetSuccess() (v *RollbackGameVersionResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceRollbackGameVersionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceRollbackGameVersionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RollbackGameVersionResponse)
+}
+
+func (p *GameServiceRollbackGameVersionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceRollbackGameVersionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceRollbackGameVersionResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceRollbackGameVersionResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceCancelScheduledPublishArgs struct {
+	Req *CancelScheduledPublishRequest `thrift:"req,1" frugal:"1,default,CancelScheduledPublishRequest" json:"req"`
+}
+
+func NewGameServiceCancelScheduledPublishArgs() *GameServiceCancelScheduledPublishArgs {
+	return &GameServiceCancelScheduledPublishArgs{}
+}
+
+func (p *GameServiceCancelScheduledPublishArgs) InitDefault() {
+}
+
+var GameServiceCancelScheduledPublishArgs_Req_DEFAULT *CancelScheduledPublishRequest
+
+func (p *GameServiceCancelScheduledPublishArgs) GetReq() (v *CancelScheduledPublishRequest) {
+	if !p.IsSetReq() {
+		return GameServiceCancelScheduledPublishArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceCancelScheduledPublishArgs) SetReq(val *CancelScheduledPublishRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceCancelScheduledPublishArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceCancelScheduledPublishArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceCancelScheduledPublishArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceCancelScheduledPublishArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceCancelScheduledPublishResult struct {
+	Success *CancelScheduledPublishResponse `thrift:"success,0,optional" frugal:"0,optional,CancelScheduledPublishResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceCancelScheduledPublishResult() *GameServiceCancelScheduledPublishResult {
+	return &GameServiceCancelScheduledPublishResult{}
+}
+
+func (p *GameServiceCancelScheduledPublishResult) InitDefault() {
+}
+
+var GameServiceCancelScheduledPublishResult_Success_DEFAULT *CancelScheduledPublishResponse
+
+func (p *GameServiceCancelScheduledPublishResult) GetSuccess() (v *CancelScheduledPublishResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceCancelScheduledPublishResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceCancelScheduledPublishResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CancelScheduledPublishResponse)
+}
+
+func (p *GameServiceCancelScheduledPublishResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceCancelScheduledPublishResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceCancelScheduledPublishResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceCancelScheduledPublishResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceGetGameReviewLogsArgs struct {
+	Req *GetGameReviewLogsRequest `thrift:"req,1" frugal:"1,default,GetGameReviewLogsRequest" json:"req"`
+}
+
+func NewGameServiceGetGameReviewLogsArgs() *GameServiceGetGameReviewLogsArgs {
+	return &GameServiceGetGameReviewLogsArgs{}
+}
+
+func (p *GameServiceGetGameReviewLogsArgs) InitDefault() {
+}
+
+var GameServiceGetGameReviewLogsArgs_Req_DEFAULT *GetGameReviewLogsRequest
+
+func (p *GameServiceGetGameReviewLogsArgs) GetReq() (v *GetGameReviewLogsRequest) {
+	if !p.IsSetReq() {
+		return GameServiceGetGameReviewLogsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceGetGameReviewLogsArgs) SetReq(val *GetGameReviewLogsRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceGetGameReviewLogsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceGetGameReviewLogsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceGetGameReviewLogsArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceGetGameReviewLogsArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceGetGameReviewLogsResult struct {
+	Success *GetGameReviewLogsResponse `thrift:"success,0,optional" frugal:"0,optional,GetGameReviewLogsResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceGetGameReviewLogsResult() *GameServiceGetGameReviewLogsResult {
+	return &GameServiceGetGameReviewLogsResult{}
+}
+
+func (p *GameServiceGetGameReviewLogsResult) InitDefault() {
+}
+
+var GameServiceGetGameReviewLogsResult_Success_DEFAULT *GetGameReviewLogsResponse
+
+func (p *GameServiceGetGameReviewLogsResult) GetSuccess() (v *GetGameReviewLogsResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceGetGameReviewLogsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceGetGameReviewLogsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetGameReviewLogsResponse)
+}
+
+func (p *GameServiceGetGameReviewLogsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceGetGameReviewLogsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceGetGameReviewLogsResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceGetGameReviewLogsResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceSubmitGameVersionArgs struct {
+	Req *SubmitGameVersionRequest `thrift:"req,1" frugal:"1,default,SubmitGameVersionRequest" json:"req"`
+}
+
+func NewGameServiceSubmitGameVersionArgs() *GameServiceSubmitGameVersionArgs {
+	return &GameServiceSubmitGameVersionArgs{}
+}
+
+func (p *GameServiceSubmitGameVersionArgs) InitDefault() {
+}
+
+var GameServiceSubmitGameVersionArgs_Req_DEFAULT *SubmitGameVersionRequest
+
+func (p *GameServiceSubmitGameVersionArgs) GetReq() (v *SubmitGameVersionRequest) {
+	if !p.IsSetReq() {
+		return GameServiceSubmitGameVersionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceSubmitGameVersionArgs) SetReq(val *SubmitGameVersionRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceSubmitGameVersionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceSubmitGameVersionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceSubmitGameVersionArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceSubmitGameVersionArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceSubmitGameVersionResult struct {
+	Success *SubmitGameVersionResponse `thrift:"success,0,optional" frugal:"0,optional,SubmitGameVersionResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceSubmitGameVersionResult() *GameServiceSubmitGameVersionResult {
+	return &GameServiceSubmitGameVersionResult{}
+}
+
+func (p *GameServiceSubmitGameVersionResult) InitDefault() {
+}
+
+var GameServiceSubmitGameVersionResult_Success_DEFAULT *SubmitGameVersionResponse
+
+func (p *GameServiceSubmitGameVersionResult) GetSuccess() (v *SubmitGameVersionResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceSubmitGameVersionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceSubmitGameVersionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SubmitGameVersionResponse)
+}
+
+func (p *GameServiceSubmitGameVersionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceSubmitGameVersionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceSubmitGameVersionResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceSubmitGameVersionResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceWithdrawGameVersionArgs struct {
+	Req *WithdrawGameVersionRequest `thrift:"req,1" frugal:"1,default,WithdrawGameVersionRequest" json:"req"`
+}
+
+func NewGameServiceWithdrawGameVersionArgs() *GameServiceWithdrawGameVersionArgs {
+	return &GameServiceWithdrawGameVersionArgs{}
+}
+
+func (p *GameServiceWithdrawGameVersionArgs) InitDefault() {
+}
+
+var GameServiceWithdrawGameVersionArgs_Req_DEFAULT *WithdrawGameVersionRequest
+
+func (p *GameServiceWithdrawGameVersionArgs) GetReq() (v *WithdrawGameVersionRequest) {
+	if !p.IsSetReq() {
+		return GameServiceWithdrawGameVersionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceWithdrawGameVersionArgs) SetReq(val *WithdrawGameVersionRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceWithdrawGameVersionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceWithdrawGameVersionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceWithdrawGameVersionArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceWithdrawGameVersionArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceWithdrawGameVersionResult struct {
+	Success *WithdrawGameVersionResponse `thrift:"success,0,optional" frugal:"0,optional,WithdrawGameVersionResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceWithdrawGameVersionResult() *GameServiceWithdrawGameVersionResult {
+	return &GameServiceWithdrawGameVersionResult{}
+}
+
+func (p *GameServiceWithdrawGameVersionResult) InitDefault() {
+}
+
+var GameServiceWithdrawGameVersionResult_Success_DEFAULT *WithdrawGameVersionResponse
+
+func (p *GameServiceWithdrawGameVersionResult) GetSuccess() (v *WithdrawGameVersionResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceWithdrawGameVersionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceWithdrawGameVersionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*WithdrawGameVersionResponse)
+}
+
+func (p *GameServiceWithdrawGameVersionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceWithdrawGameVersionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceWithdrawGameVersionResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceWithdrawGameVersionResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceDiffGameVersionsArgs struct {
+	Req *DiffGameVersionsRequest `thrift:"req,1" frugal:"1,default,DiffGameVersionsRequest" json:"req"`
+}
+
+func NewGameServiceDiffGameVersionsArgs() *GameServiceDiffGameVersionsArgs {
+	return &GameServiceDiffGameVersionsArgs{}
+}
+
+func (p *GameServiceDiffGameVersionsArgs) InitDefault() {
+}
+
+var GameServiceDiffGameVersionsArgs_Req_DEFAULT *DiffGameVersionsRequest
+
+func (p *GameServiceDiffGameVersionsArgs) GetReq() (v *DiffGameVersionsRequest) {
+	if !p.IsSetReq() {
+		return GameServiceDiffGameVersionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceDiffGameVersionsArgs) SetReq(val *DiffGameVersionsRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceDiffGameVersionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceDiffGameVersionsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceDiffGameVersionsArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceDiffGameVersionsArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceDiffGameVersionsResult struct {
+	Success *DiffGameVersionsResponse `thrift:"success,0,optional" frugal:"0,optional,DiffGameVersionsResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceDiffGameVersionsResult() *GameServiceDiffGameVersionsResult {
+	return &GameServiceDiffGameVersionsResult{}
+}
+
+func (p *GameServiceDiffGameVersionsResult) InitDefault() {
+}
+
+var GameServiceDiffGameVersionsResult_Success_DEFAULT *DiffGameVersionsResponse
+
+func (p *GameServiceDiffGameVersionsResult) GetSuccess() (v *DiffGameVersionsResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceDiffGameVersionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceDiffGameVersionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DiffGameVersionsResponse)
+}
+
+func (p *GameServiceDiffGameVersionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceDiffGameVersionsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceDiffGameVersionsResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceDiffGameVersionsResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceTakedownGameArgs struct {
+	Req *TakedownGameRequest `thrift:"req,1" frugal:"1,default,TakedownGameRequest" json:"req"`
+}
+
+func NewGameServiceTakedownGameArgs() *GameServiceTakedownGameArgs {
+	return &GameServiceTakedownGameArgs{}
+}
+
+func (p *GameServiceTakedownGameArgs) InitDefault() {
+}
+
+var GameServiceTakedownGameArgs_Req_DEFAULT *TakedownGameRequest
+
+func (p *GameServiceTakedownGameArgs) GetReq() (v *TakedownGameRequest) {
+	if !p.IsSetReq() {
+		return GameServiceTakedownGameArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceTakedownGameArgs) SetReq(val *TakedownGameRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceTakedownGameArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceTakedownGameArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceTakedownGameArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceTakedownGameArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceTakedownGameResult struct {
+	Success *TakedownGameResponse `thrift:"success,0,optional" frugal:"0,optional,TakedownGameResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceTakedownGameResult() *GameServiceTakedownGameResult {
+	return &GameServiceTakedownGameResult{}
+}
+
+func (p *GameServiceTakedownGameResult) InitDefault() {
+}
+
+var GameServiceTakedownGameResult_Success_DEFAULT *TakedownGameResponse
+
+func (p *GameServiceTakedownGameResult) GetSuccess() (v *TakedownGameResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceTakedownGameResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceTakedownGameResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TakedownGameResponse)
+}
+
+func (p *GameServiceTakedownGameResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceTakedownGameResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceTakedownGameResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceTakedownGameResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceRestoreGameArgs struct {
+	Req *RestoreGameRequest `thrift:"req,1" frugal:"1,default,RestoreGameRequest" json:"req"`
+}
+
+func NewGameServiceRestoreGameArgs() *GameServiceRestoreGameArgs {
+	return &GameServiceRestoreGameArgs{}
+}
+
+func (p *GameServiceRestoreGameArgs) InitDefault() {
+}
+
+var GameServiceRestoreGameArgs_Req_DEFAULT *RestoreGameRequest
+
+func (p *GameServiceRestoreGameArgs) GetReq() (v *RestoreGameRequest) {
+	if !p.IsSetReq() {
+		return GameServiceRestoreGameArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceRestoreGameArgs) SetReq(val *RestoreGameRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceRestoreGameArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceRestoreGameArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceRestoreGameArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceRestoreGameArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceRestoreGameResult struct {
+	Success *RestoreGameResponse `thrift:"success,0,optional" frugal:"0,optional,RestoreGameResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceRestoreGameResult() *GameServiceRestoreGameResult {
+	return &GameServiceRestoreGameResult{}
+}
+
+func (p *GameServiceRestoreGameResult) InitDefault() {
+}
+
+var GameServiceRestoreGameResult_Success_DEFAULT *RestoreGameResponse
+
+func (p *GameServiceRestoreGameResult) GetSuccess() (v *RestoreGameResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceRestoreGameResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceRestoreGameResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RestoreGameResponse)
+}
+
+func (p *GameServiceRestoreGameResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceRestoreGameResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceRestoreGameResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceRestoreGameResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceListDeletedGameDraftsArgs struct {
+	Req *ListDeletedGameDraftsRequest `thrift:"req,1" frugal:"1,default,ListDeletedGameDraftsRequest" json:"req"`
+}
+
+func NewGameServiceListDeletedGameDraftsArgs() *GameServiceListDeletedGameDraftsArgs {
+	return &GameServiceListDeletedGameDraftsArgs{}
+}
+
+func (p *GameServiceListDeletedGameDraftsArgs) InitDefault() {
+}
+
+var GameServiceListDeletedGameDraftsArgs_Req_DEFAULT *ListDeletedGameDraftsRequest
+
+func (p *GameServiceListDeletedGameDraftsArgs) GetReq() (v *ListDeletedGameDraftsRequest) {
+	if !p.IsSetReq() {
+		return GameServiceListDeletedGameDraftsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceListDeletedGameDraftsArgs) SetReq(val *ListDeletedGameDraftsRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceListDeletedGameDraftsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceListDeletedGameDraftsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceListDeletedGameDraftsArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceListDeletedGameDraftsArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceListDeletedGameDraftsResult struct {
+	Success *ListDeletedGameDraftsResponse `thrift:"success,0,optional" frugal:"0,optional,ListDeletedGameDraftsResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceListDeletedGameDraftsResult() *GameServiceListDeletedGameDraftsResult {
+	return &GameServiceListDeletedGameDraftsResult{}
+}
+
+func (p *GameServiceListDeletedGameDraftsResult) InitDefault() {
+}
+
+var GameServiceListDeletedGameDraftsResult_Success_DEFAULT *ListDeletedGameDraftsResponse
+
+func (p *GameServiceListDeletedGameDraftsResult) GetSuccess() (v *ListDeletedGameDraftsResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceListDeletedGameDraftsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceListDeletedGameDraftsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListDeletedGameDraftsResponse)
+}
+
+func (p *GameServiceListDeletedGameDraftsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceListDeletedGameDraftsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceListDeletedGameDraftsResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceListDeletedGameDraftsResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceRestoreGameDraftArgs struct {
+	Req *RestoreGameDraftRequest `thrift:"req,1" frugal:"1,default,RestoreGameDraftRequest" json:"req"`
+}
+
+func NewGameServiceRestoreGameDraftArgs() *GameServiceRestoreGameDraftArgs {
+	return &GameServiceRestoreGameDraftArgs{}
+}
+
+func (p *GameServiceRestoreGameDraftArgs) InitDefault() {
+}
+
+var GameServiceRestoreGameDraftArgs_Req_DEFAULT *RestoreGameDraftRequest
+
+func (p *GameServiceRestoreGameDraftArgs) GetReq() (v *RestoreGameDraftRequest) {
+	if !p.IsSetReq() {
+		return GameServiceRestoreGameDraftArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceRestoreGameDraftArgs) SetReq(val *RestoreGameDraftRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceRestoreGameDraftArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceRestoreGameDraftArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceRestoreGameDraftArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceRestoreGameDraftArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceRestoreGameDraftResult struct {
+	Success *RestoreGameDraftResponse `thrift:"success,0,optional" frugal:"0,optional,RestoreGameDraftResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceRestoreGameDraftResult() *GameServiceRestoreGameDraftResult {
+	return &GameServiceRestoreGameDraftResult{}
+}
+
+func (p *GameServiceRestoreGameDraftResult) InitDefault() {
+}
+
+var GameServiceRestoreGameDraftResult_Success_DEFAULT *RestoreGameDraftResponse
+
+func (p *GameServiceRestoreGameDraftResult) GetSuccess() (v *RestoreGameDraftResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceRestoreGameDraftResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceRestoreGameDraftResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RestoreGameDraftResponse)
+}
+
+func (p *GameServiceRestoreGameDraftResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceRestoreGameDraftResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceRestoreGameDraftResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceRestoreGameDraftResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceSearchGamesArgs struct {
+	Req *SearchGamesRequest `thrift:"req,1" frugal:"1,default,SearchGamesRequest" json:"req"`
+}
+
+func NewGameServiceSearchGamesArgs() *GameServiceSearchGamesArgs {
+	return &GameServiceSearchGamesArgs{}
+}
+
+func (p *GameServiceSearchGamesArgs) InitDefault() {
+}
+
+var GameServiceSearchGamesArgs_Req_DEFAULT *SearchGamesRequest
+
+func (p *GameServiceSearchGamesArgs) GetReq() (v *SearchGamesRequest) {
+	if !p.IsSetReq() {
+		return GameServiceSearchGamesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceSearchGamesArgs) SetReq(val *SearchGamesRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceSearchGamesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceSearchGamesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceSearchGamesArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceSearchGamesArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceSearchGamesResult struct {
+	Success *SearchGamesResponse `thrift:"success,0,optional" frugal:"0,optional,SearchGamesResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceSearchGamesResult() *GameServiceSearchGamesResult {
+	return &GameServiceSearchGamesResult{}
+}
+
+func (p *GameServiceSearchGamesResult) InitDefault() {
+}
+
+var GameServiceSearchGamesResult_Success_DEFAULT *SearchGamesResponse
+
+func (p *GameServiceSearchGamesResult) GetSuccess() (v *SearchGamesResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceSearchGamesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceSearchGamesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SearchGamesResponse)
+}
+
+func (p *GameServiceSearchGamesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceSearchGamesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceSearchGamesResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceSearchGamesResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceBatchGetGameDetailsArgs struct {
+	Req *BatchGetGameDetailsRequest `thrift:"req,1" frugal:"1,default,BatchGetGameDetailsRequest" json:"req"`
+}
+
+func NewGameServiceBatchGetGameDetailsArgs() *GameServiceBatchGetGameDetailsArgs {
+	return &GameServiceBatchGetGameDetailsArgs{}
+}
+
+func (p *GameServiceBatchGetGameDetailsArgs) InitDefault() {
+}
+
+var GameServiceBatchGetGameDetailsArgs_Req_DEFAULT *BatchGetGameDetailsRequest
+
+func (p *GameServiceBatchGetGameDetailsArgs) GetReq() (v *BatchGetGameDetailsRequest) {
+	if !p.IsSetReq() {
+		return GameServiceBatchGetGameDetailsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceBatchGetGameDetailsArgs) SetReq(val *BatchGetGameDetailsRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceBatchGetGameDetailsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceBatchGetGameDetailsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceBatchGetGameDetailsArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceBatchGetGameDetailsArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceBatchGetGameDetailsResult struct {
+	Success *BatchGetGameDetailsResponse `thrift:"success,0,optional" frugal:"0,optional,BatchGetGameDetailsResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceBatchGetGameDetailsResult() *GameServiceBatchGetGameDetailsResult {
+	return &GameServiceBatchGetGameDetailsResult{}
+}
+
+func (p *GameServiceBatchGetGameDetailsResult) InitDefault() {
+}
+
+var GameServiceBatchGetGameDetailsResult_Success_DEFAULT *BatchGetGameDetailsResponse
+
+func (p *GameServiceBatchGetGameDetailsResult) GetSuccess() (v *BatchGetGameDetailsResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceBatchGetGameDetailsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceBatchGetGameDetailsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchGetGameDetailsResponse)
+}
+
+func (p *GameServiceBatchGetGameDetailsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceBatchGetGameDetailsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceBatchGetGameDetailsResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceBatchGetGameDetailsResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceReleasePackageNameArgs struct {
+	Req *ReleasePackageNameRequest `thrift:"req,1" frugal:"1,default,ReleasePackageNameRequest" json:"req"`
+}
+
+func NewGameServiceReleasePackageNameArgs() *GameServiceReleasePackageNameArgs {
+	return &GameServiceReleasePackageNameArgs{}
+}
+
+func (p *GameServiceReleasePackageNameArgs) InitDefault() {
+}
+
+var GameServiceReleasePackageNameArgs_Req_DEFAULT *ReleasePackageNameRequest
+
+func (p *GameServiceReleasePackageNameArgs) GetReq() (v *ReleasePackageNameRequest) {
+	if !p.IsSetReq() {
+		return GameServiceReleasePackageNameArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceReleasePackageNameArgs) SetReq(val *ReleasePackageNameRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceReleasePackageNameArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceReleasePackageNameArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceReleasePackageNameArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceReleasePackageNameArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceReleasePackageNameResult struct {
+	Success *ReleasePackageNameResponse `thrift:"success,0,optional" frugal:"0,optional,ReleasePackageNameResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceReleasePackageNameResult() *GameServiceReleasePackageNameResult {
+	return &GameServiceReleasePackageNameResult{}
+}
+
+func (p *GameServiceReleasePackageNameResult) InitDefault() {
+}
+
+var GameServiceReleasePackageNameResult_Success_DEFAULT *ReleasePackageNameResponse
+
+func (p *GameServiceReleasePackageNameResult) GetSuccess() (v *ReleasePackageNameResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceReleasePackageNameResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceReleasePackageNameResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReleasePackageNameResponse)
+}
+
+func (p *GameServiceReleasePackageNameResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceReleasePackageNameResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceReleasePackageNameResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceReleasePackageNameResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceCreateCategoryArgs struct {
+	Req *CreateCategoryRequest `thrift:"req,1" frugal:"1,default,CreateCategoryRequest" json:"req"`
+}
+
+func NewGameServiceCreateCategoryArgs() *GameServiceCreateCategoryArgs {
+	return &GameServiceCreateCategoryArgs{}
+}
+
+func (p *GameServiceCreateCategoryArgs) InitDefault() {
+}
+
+var GameServiceCreateCategoryArgs_Req_DEFAULT *CreateCategoryRequest
+
+func (p *GameServiceCreateCategoryArgs) GetReq() (v *CreateCategoryRequest) {
+	if !p.IsSetReq() {
+		return GameServiceCreateCategoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceCreateCategoryArgs) SetReq(val *CreateCategoryRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceCreateCategoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceCreateCategoryArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceCreateCategoryArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceCreateCategoryArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceCreateCategoryResult struct {
+	Success *CreateCategoryResponse `thrift:"success,0,optional" frugal:"0,optional,CreateCategoryResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceCreateCategoryResult() *GameServiceCreateCategoryResult {
+	return &GameServiceCreateCategoryResult{}
+}
+
+func (p *GameServiceCreateCategoryResult) InitDefault() {
+}
+
+var GameServiceCreateCategoryResult_Success_DEFAULT *CreateCategoryResponse
+
+func (p *GameServiceCreateCategoryResult) GetSuccess() (v *CreateCategoryResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceCreateCategoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceCreateCategoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateCategoryResponse)
+}
+
+func (p *GameServiceCreateCategoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceCreateCategoryResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceCreateCategoryResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceCreateCategoryResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceUpdateCategoryArgs struct {
+	Req *UpdateCategoryRequest `thrift:"req,1" frugal:"1,default,UpdateCategoryRequest" json:"req"`
+}
+
+func NewGameServiceUpdateCategoryArgs() *GameServiceUpdateCategoryArgs {
+	return &GameServiceUpdateCategoryArgs{}
+}
+
+func (p *GameServiceUpdateCategoryArgs) InitDefault() {
+}
+
+var GameServiceUpdateCategoryArgs_Req_DEFAULT *UpdateCategoryRequest
+
+func (p *GameServiceUpdateCategoryArgs) GetReq() (v *UpdateCategoryRequest) {
+	if !p.IsSetReq() {
+		return GameServiceUpdateCategoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceUpdateCategoryArgs) SetReq(val *UpdateCategoryRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceUpdateCategoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceUpdateCategoryArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceUpdateCategoryArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceUpdateCategoryArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceUpdateCategoryResult struct {
+	Success *UpdateCategoryResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateCategoryResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceUpdateCategoryResult() *GameServiceUpdateCategoryResult {
+	return &GameServiceUpdateCategoryResult{}
+}
+
+func (p *GameServiceUpdateCategoryResult) InitDefault() {
+}
+
+var GameServiceUpdateCategoryResult_Success_DEFAULT *UpdateCategoryResponse
+
+func (p *GameServiceUpdateCategoryResult) GetSuccess() (v *UpdateCategoryResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceUpdateCategoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceUpdateCategoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateCategoryResponse)
+}
+
+func (p *GameServiceUpdateCategoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceUpdateCategoryResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceUpdateCategoryResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceUpdateCategoryResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceDeleteCategoryArgs struct {
+	Req *DeleteCategoryRequest `thrift:"req,1" frugal:"1,default,DeleteCategoryRequest" json:"req"`
+}
+
+func NewGameServiceDeleteCategoryArgs() *GameServiceDeleteCategoryArgs {
+	return &GameServiceDeleteCategoryArgs{}
+}
+
+func (p *GameServiceDeleteCategoryArgs) InitDefault() {
+}
+
+var GameServiceDeleteCategoryArgs_Req_DEFAULT *DeleteCategoryRequest
+
+func (p *GameServiceDeleteCategoryArgs) GetReq() (v *DeleteCategoryRequest) {
+	if !p.IsSetReq() {
+		return GameServiceDeleteCategoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceDeleteCategoryArgs) SetReq(val *DeleteCategoryRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceDeleteCategoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceDeleteCategoryArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceDeleteCategoryArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceDeleteCategoryArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceDeleteCategoryResult struct {
+	Success *DeleteCategoryResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteCategoryResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceDeleteCategoryResult() *GameServiceDeleteCategoryResult {
+	return &GameServiceDeleteCategoryResult{}
+}
+
+func (p *GameServiceDeleteCategoryResult) InitDefault() {
+}
+
+var GameServiceDeleteCategoryResult_Success_DEFAULT *DeleteCategoryResponse
+
+func (p *GameServiceDeleteCategoryResult) GetSuccess() (v *DeleteCategoryResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceDeleteCategoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceDeleteCategoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteCategoryResponse)
+}
+
+func (p *GameServiceDeleteCategoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceDeleteCategoryResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceDeleteCategoryResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceDeleteCategoryResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceListCategoriesArgs struct {
+	Req *ListCategoriesRequest `thrift:"req,1" frugal:"1,default,ListCategoriesRequest" json:"req"`
+}
+
+func NewGameServiceListCategoriesArgs() *GameServiceListCategoriesArgs {
+	return &GameServiceListCategoriesArgs{}
+}
+
+func (p *GameServiceListCategoriesArgs) InitDefault() {
+}
+
+var GameServiceListCategoriesArgs_Req_DEFAULT *ListCategoriesRequest
+
+func (p *GameServiceListCategoriesArgs) GetReq() (v *ListCategoriesRequest) {
+	if !p.IsSetReq() {
+		return GameServiceListCategoriesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceListCategoriesArgs) SetReq(val *ListCategoriesRequest) {
+	p.Req = val
+}
+
+func (p *GameServiceListCategoriesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceListCategoriesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceListCategoriesArgs(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceListCategoriesArgs = map[int16]string{
+	1: "req",
+}
+
+type GameServiceListCategoriesResult struct {
+	Success *ListCategoriesResponse `thrift:"success,0,optional" frugal:"0,optional,ListCategoriesResponse" json:"success,omitempty"`
+}
+
+func NewGameServiceListCategoriesResult() *GameServiceListCategoriesResult {
+	return &GameServiceListCategoriesResult{}
+}
+
+func (p *GameServiceListCategoriesResult) InitDefault() {
+}
+
+var GameServiceListCategoriesResult_Success_DEFAULT *ListCategoriesResponse
+
+func (p *GameServiceListCategoriesResult) GetSuccess() (v *ListCategoriesResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceListCategoriesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *GameServiceListCategoriesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListCategoriesResponse)
+}
+
+func (p *GameServiceListCategoriesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GameServiceListCategoriesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GameServiceListCategoriesResult(%+v)", *p)
+}
+
+var fieldIDToName_GameServiceListCategoriesResult = map[int16]string{
+	0: "success",
+}
+
+type GameServiceCreateTagArgs struct {
+	Req *CreateTagRequest `thrift:"req,1" frugal:"1,default,CreateTagRequest" json:"req"`
+}
+
+func NewGameServiceCreateTagArgs() *GameServiceCreateTagArgs {
+	return &GameServiceCreateTagArgs{}
+}
+
+func (p *GameServiceCreateTagArgs) InitDefault() {
+}
+
+var GameServiceCreateTagArgs_Req_DEFAULT *CreateTagRequest
+
+func (p *GameServiceCreateTagArgs) GetReq() (v *CreateTagRequest) {
+	if !p.IsSetReq() {
+		return GameServiceCreateTagArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *GameServiceCreateTagArgs) SetReq(val *CreateTagRequest) {
+	p.Req = val
 }
-func (p *ReviewGameVersionRequest) SetReviewResult_(val ReviewResult_) {
-	p.ReviewResult_ = val
+
+func (p *GameServiceCreateTagArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *ReviewGameVersionRequest) String() string {
+func (p *GameServiceCreateTagArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ReviewGameVersionRequest(%+v)", *p)
+	return fmt.Sprintf("GameServiceCreateTagArgs(%+v)", *p)
 }
 
-var fieldIDToName_ReviewGameVersionRequest = map[int16]string{
-	1: "GameID",
-	2: "GameVersionID",
-	3: "ReviewResult",
+var fieldIDToName_GameServiceCreateTagArgs = map[int16]string{
+	1: "req",
 }
 
-type ReviewGameVersionResponse struct {
-	BaseResp *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+type GameServiceCreateTagResult struct {
+	Success *CreateTagResponse `thrift:"success,0,optional" frugal:"0,optional,CreateTagResponse" json:"success,omitempty"`
 }
 
-func NewReviewGameVersionResponse() *ReviewGameVersionResponse {
-	return &ReviewGameVersionResponse{}
+func NewGameServiceCreateTagResult() *GameServiceCreateTagResult {
+	return &GameServiceCreateTagResult{}
 }
 
-func (p *ReviewGameVersionResponse) InitDefault() {
+func (p *GameServiceCreateTagResult) InitDefault() {
 }
 
-var ReviewGameVersionResponse_BaseResp_DEFAULT *common.BaseResp
+var GameServiceCreateTagResult_Success_DEFAULT *CreateTagResponse
 
-func (p *ReviewGameVersionResponse) GetBaseResp() (v *common.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return ReviewGameVersionResponse_BaseResp_DEFAULT
+func (p *GameServiceCreateTagResult) GetSuccess() (v *CreateTagResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceCreateTagResult_Success_DEFAULT
 	}
-	return p.BaseResp
+	return p.Success
 }
-func (p *ReviewGameVersionResponse) SetBaseResp(val *common.BaseResp) {
-	p.BaseResp = val
+func (p *GameServiceCreateTagResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateTagResponse)
 }
 
-func (p *ReviewGameVersionResponse) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+func (p *GameServiceCreateTagResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func (p *ReviewGameVersionResponse) String() string {
+func (p *GameServiceCreateTagResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ReviewGameVersionResponse(%+v)", *p)
+	return fmt.Sprintf("GameServiceCreateTagResult(%+v)", *p)
 }
 
-var fieldIDToName_ReviewGameVersionResponse = map[int16]string{
-	255: "BaseResp",
+var fieldIDToName_GameServiceCreateTagResult = map[int16]string{
+	0: "success",
 }
 
-type DeleteGameDraftRequest struct {
-	GameID int64 `thrift:"GameID,1" frugal:"1,default,i64" json:"GameID"`
+type GameServiceUpdateTagArgs struct {
+	Req *UpdateTagRequest `thrift:"req,1" frugal:"1,default,UpdateTagRequest" json:"req"`
 }
 
-func NewDeleteGameDraftRequest() *DeleteGameDraftRequest {
-	return &DeleteGameDraftRequest{}
+func NewGameServiceUpdateTagArgs() *GameServiceUpdateTagArgs {
+	return &GameServiceUpdateTagArgs{}
 }
 
-func (p *DeleteGameDraftRequest) InitDefault() {
+func (p *GameServiceUpdateTagArgs) InitDefault() {
 }
 
-func (p *DeleteGameDraftRequest) GetGameID() (v int64) {
-	return p.GameID
+var GameServiceUpdateTagArgs_Req_DEFAULT *UpdateTagRequest
+
+func (p *GameServiceUpdateTagArgs) GetReq() (v *UpdateTagRequest) {
+	if !p.IsSetReq() {
+		return GameServiceUpdateTagArgs_Req_DEFAULT
+	}
+	return p.Req
 }
-func (p *DeleteGameDraftRequest) SetGameID(val int64) {
-	p.GameID = val
+func (p *GameServiceUpdateTagArgs) SetReq(val *UpdateTagRequest) {
+	p.Req = val
 }
 
-func (p *DeleteGameDraftRequest) String() string {
+func (p *GameServiceUpdateTagArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GameServiceUpdateTagArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteGameDraftRequest(%+v)", *p)
+	return fmt.Sprintf("GameServiceUpdateTagArgs(%+v)", *p)
 }
 
-var fieldIDToName_DeleteGameDraftRequest = map[int16]string{
-	1: "GameID",
+var fieldIDToName_GameServiceUpdateTagArgs = map[int16]string{
+	1: "req",
 }
 
-type DeleteGameDraftResponse struct {
-	BaseResp *common.BaseResp `thrift:"BaseResp,255" frugal:"255,default,common.BaseResp" json:"BaseResp"`
+type GameServiceUpdateTagResult struct {
+	Success *UpdateTagResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateTagResponse" json:"success,omitempty"`
 }
 
-func NewDeleteGameDraftResponse() *DeleteGameDraftResponse {
-	return &DeleteGameDraftResponse{}
+func NewGameServiceUpdateTagResult() *GameServiceUpdateTagResult {
+	return &GameServiceUpdateTagResult{}
 }
 
-func (p *DeleteGameDraftResponse) InitDefault() {
+func (p *GameServiceUpdateTagResult) InitDefault() {
 }
 
-var DeleteGameDraftResponse_BaseResp_DEFAULT *common.BaseResp
+var GameServiceUpdateTagResult_Success_DEFAULT *UpdateTagResponse
 
-func (p *DeleteGameDraftResponse) GetBaseResp() (v *common.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return DeleteGameDraftResponse_BaseResp_DEFAULT
+func (p *GameServiceUpdateTagResult) GetSuccess() (v *UpdateTagResponse) {
+	if !p.IsSetSuccess() {
+		return GameServiceUpdateTagResult_Success_DEFAULT
 	}
-	return p.BaseResp
+	return p.Success
 }
-func (p *DeleteGameDraftResponse) SetBaseResp(val *common.BaseResp) {
-	p.BaseResp = val
+func (p *GameServiceUpdateTagResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateTagResponse)
 }
 
-func (p *DeleteGameDraftResponse) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+func (p *GameServiceUpdateTagResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func (p *DeleteGameDraftResponse) String() string {
+func (p *GameServiceUpdateTagResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteGameDraftResponse(%+v)", *p)
-}
-
-var fieldIDToName_DeleteGameDraftResponse = map[int16]string{
-	255: "BaseResp",
+	return fmt.Sprintf("GameServiceUpdateTagResult(%+v)", *p)
 }
 
-type GameService interface {
-	GetGameList(ctx context.Context, req *GetGameListRequest) (r *GetGameListResponse, err error)
-
-	GetGameDetail(ctx context.Context, req *GetGameDetailRequest) (r *GetGameDetailResponse, err error)
-
-	UpdateGameDraft(ctx context.Context, req *UpdateGameDraftRequest) (r *UpdateGameDraftResponse, err error)
-
-	CreateGameDetail(ctx context.Context, req *CreateGameDetailRequest) (r *CreateGameDetailResponse, err error)
-
-	ReviewGameVersion(ctx context.Context, req *ReviewGameVersionRequest) (r *ReviewGameVersionResponse, err error)
-
-	DeleteGameDraft(ctx context.Context, req *DeleteGameDraftRequest) (r *DeleteGameDraftResponse, err error)
+var fieldIDToName_GameServiceUpdateTagResult = map[int16]string{
+	0: "success",
 }
 
-type GameServiceGetGameListArgs struct {
-	Req *GetGameListRequest `thrift:"req,1" frugal:"1,default,GetGameListRequest" json:"req"`
+type GameServiceDeleteTagArgs struct {
+	Req *DeleteTagRequest `thrift:"req,1" frugal:"1,default,DeleteTagRequest" json:"req"`
 }
 
-func NewGameServiceGetGameListArgs() *GameServiceGetGameListArgs {
-	return &GameServiceGetGameListArgs{}
+func NewGameServiceDeleteTagArgs() *GameServiceDeleteTagArgs {
+	return &GameServiceDeleteTagArgs{}
 }
 
-func (p *GameServiceGetGameListArgs) InitDefault() {
+func (p *GameServiceDeleteTagArgs) InitDefault() {
 }
 
-var GameServiceGetGameListArgs_Req_DEFAULT *GetGameListRequest
+var GameServiceDeleteTagArgs_Req_DEFAULT *DeleteTagRequest
 
-func (p *GameServiceGetGameListArgs) GetReq() (v *GetGameListRequest) {
+func (p *GameServiceDeleteTagArgs) GetReq() (v *DeleteTagRequest) {
 	if !p.IsSetReq() {
-		return GameServiceGetGameListArgs_Req_DEFAULT
+		return GameServiceDeleteTagArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *GameServiceGetGameListArgs) SetReq(val *GetGameListRequest) {
+func (p *GameServiceDeleteTagArgs) SetReq(val *DeleteTagRequest) {
 	p.Req = val
 }
 
-func (p *GameServiceGetGameListArgs) IsSetReq() bool {
+func (p *GameServiceDeleteTagArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GameServiceGetGameListArgs) String() string {
+func (p *GameServiceDeleteTagArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GameServiceGetGameListArgs(%+v)", *p)
+	return fmt.Sprintf("GameServiceDeleteTagArgs(%+v)", *p)
 }
 
-var fieldIDToName_GameServiceGetGameListArgs = map[int16]string{
+var fieldIDToName_GameServiceDeleteTagArgs = map[int16]string{
 	1: "req",
 }
 
-type GameServiceGetGameListResult struct {
-	Success *GetGameListResponse `thrift:"success,0,optional" frugal:"0,optional,GetGameListResponse" json:"success,omitempty"`
+type GameServiceDeleteTagResult struct {
+	Success *DeleteTagResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteTagResponse" json:"success,omitempty"`
 }
 
-func NewGameServiceGetGameListResult() *GameServiceGetGameListResult {
-	return &GameServiceGetGameListResult{}
+func NewGameServiceDeleteTagResult() *GameServiceDeleteTagResult {
+	return &GameServiceDeleteTagResult{}
 }
 
-func (p *GameServiceGetGameListResult) InitDefault() {
+func (p *GameServiceDeleteTagResult) InitDefault() {
 }
 
-var GameServiceGetGameListResult_Success_DEFAULT *GetGameListResponse
+var GameServiceDeleteTagResult_Success_DEFAULT *DeleteTagResponse
 
-func (p *GameServiceGetGameListResult) GetSuccess() (v *GetGameListResponse) {
+func (p *GameServiceDeleteTagResult) GetSuccess() (v *DeleteTagResponse) {
 	if !p.IsSetSuccess() {
-		return GameServiceGetGameListResult_Success_DEFAULT
+		return GameServiceDeleteTagResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *GameServiceGetGameListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetGameListResponse)
+func (p *GameServiceDeleteTagResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteTagResponse)
 }
 
-func (p *GameServiceGetGameListResult) IsSetSuccess() bool {
+func (p *GameServiceDeleteTagResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GameServiceGetGameListResult) String() string {
+func (p *GameServiceDeleteTagResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GameServiceGetGameListResult(%+v)", *p)
+	return fmt.Sprintf("GameServiceDeleteTagResult(%+v)", *p)
 }
 
-var fieldIDToName_GameServiceGetGameListResult = map[int16]string{
+var fieldIDToName_GameServiceDeleteTagResult = map[int16]string{
 	0: "success",
 }
 
-type GameServiceGetGameDetailArgs struct {
-	Req *GetGameDetailRequest `thrift:"req,1" frugal:"1,default,GetGameDetailRequest" json:"req"`
+type GameServiceListTagsArgs struct {
+	Req *ListTagsRequest `thrift:"req,1" frugal:"1,default,ListTagsRequest" json:"req"`
 }
 
-func NewGameServiceGetGameDetailArgs() *GameServiceGetGameDetailArgs {
-	return &GameServiceGetGameDetailArgs{}
+func NewGameServiceListTagsArgs() *GameServiceListTagsArgs {
+	return &GameServiceListTagsArgs{}
 }
 
-func (p *GameServiceGetGameDetailArgs) InitDefault() {
+func (p *GameServiceListTagsArgs) InitDefault() {
 }
 
-var GameServiceGetGameDetailArgs_Req_DEFAULT *GetGameDetailRequest
+var GameServiceListTagsArgs_Req_DEFAULT *ListTagsRequest
 
-func (p *GameServiceGetGameDetailArgs) GetReq() (v *GetGameDetailRequest) {
+func (p *GameServiceListTagsArgs) GetReq() (v *ListTagsRequest) {
 	if !p.IsSetReq() {
-		return GameServiceGetGameDetailArgs_Req_DEFAULT
+		return GameServiceListTagsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *GameServiceGetGameDetailArgs) SetReq(val *GetGameDetailRequest) {
+func (p *GameServiceListTagsArgs) SetReq(val *ListTagsRequest) {
 	p.Req = val
 }
 
-func (p *GameServiceGetGameDetailArgs) IsSetReq() bool {
+func (p *GameServiceListTagsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GameServiceGetGameDetailArgs) String() string {
+func (p *GameServiceListTagsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GameServiceGetGameDetailArgs(%+v)", *p)
+	return fmt.Sprintf("GameServiceListTagsArgs(%+v)", *p)
 }
 
-var fieldIDToName_GameServiceGetGameDetailArgs = map[int16]string{
+var fieldIDToName_GameServiceListTagsArgs = map[int16]string{
 	1: "req",
 }
 
-type GameServiceGetGameDetailResult struct {
-	Success *GetGameDetailResponse `thrift:"success,0,optional" frugal:"0,optional,GetGameDetailResponse" json:"success,omitempty"`
+type GameServiceListTagsResult struct {
+	Success *ListTagsResponse `thrift:"success,0,optional" frugal:"0,optional,ListTagsResponse" json:"success,omitempty"`
 }
 
-func NewGameServiceGetGameDetailResult() *GameServiceGetGameDetailResult {
-	return &GameServiceGetGameDetailResult{}
+func NewGameServiceListTagsResult() *GameServiceListTagsResult {
+	return &GameServiceListTagsResult{}
 }
 
-func (p *GameServiceGetGameDetailResult) InitDefault() {
+func (p *GameServiceListTagsResult) InitDefault() {
 }
 
-var GameServiceGetGameDetailResult_Success_DEFAULT *GetGameDetailResponse
+var GameServiceListTagsResult_Success_DEFAULT *ListTagsResponse
 
-func (p *GameServiceGetGameDetailResult) GetSuccess() (v *GetGameDetailResponse) {
+func (p *GameServiceListTagsResult) GetSuccess() (v *ListTagsResponse) {
 	if !p.IsSetSuccess() {
-		return GameServiceGetGameDetailResult_Success_DEFAULT
+		return GameServiceListTagsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *GameServiceGetGameDetailResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetGameDetailResponse)
+func (p *GameServiceListTagsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListTagsResponse)
 }
 
-func (p *GameServiceGetGameDetailResult) IsSetSuccess() bool {
+func (p *GameServiceListTagsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GameServiceGetGameDetailResult) String() string {
+func (p *GameServiceListTagsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GameServiceGetGameDetailResult(%+v)", *p)
+	return fmt.Sprintf("GameServiceListTagsResult(%+v)", *p)
 }
 
-var fieldIDToName_GameServiceGetGameDetailResult = map[int16]string{
+var fieldIDToName_GameServiceListTagsResult = map[int16]string{
 	0: "success",
 }
 
-type GameServiceUpdateGameDraftArgs struct {
-	Req *UpdateGameDraftRequest `thrift:"req,1" frugal:"1,default,UpdateGameDraftRequest" json:"req"`
+type GameServiceUpdateGameRolloutArgs struct {
+	Req *UpdateGameRolloutRequest `thrift:"req,1" frugal:"1,default,UpdateGameRolloutRequest" json:"req"`
 }
 
-func NewGameServiceUpdateGameDraftArgs() *GameServiceUpdateGameDraftArgs {
-	return &GameServiceUpdateGameDraftArgs{}
+func NewGameServiceUpdateGameRolloutArgs() *GameServiceUpdateGameRolloutArgs {
+	return &GameServiceUpdateGameRolloutArgs{}
 }
 
-func (p *GameServiceUpdateGameDraftArgs) InitDefault() {
+func (p *GameServiceUpdateGameRolloutArgs) InitDefault() {
 }
 
-var GameServiceUpdateGameDraftArgs_Req_DEFAULT *UpdateGameDraftRequest
+var GameServiceUpdateGameRolloutArgs_Req_DEFAULT *UpdateGameRolloutRequest
 
-func (p *GameServiceUpdateGameDraftArgs) GetReq() (v *UpdateGameDraftRequest) {
+func (p *GameServiceUpdateGameRolloutArgs) GetReq() (v *UpdateGameRolloutRequest) {
 	if !p.IsSetReq() {
-		return GameServiceUpdateGameDraftArgs_Req_DEFAULT
+		return GameServiceUpdateGameRolloutArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *GameServiceUpdateGameDraftArgs) SetReq(val *UpdateGameDraftRequest) {
+func (p *GameServiceUpdateGameRolloutArgs) SetReq(val *UpdateGameRolloutRequest) {
 	p.Req = val
 }
 
-func (p *GameServiceUpdateGameDraftArgs) IsSetReq() bool {
+func (p *GameServiceUpdateGameRolloutArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GameServiceUpdateGameDraftArgs) String() string {
+func (p *GameServiceUpdateGameRolloutArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GameServiceUpdateGameDraftArgs(%+v)", *p)
+	return fmt.Sprintf("GameServiceUpdateGameRolloutArgs(%+v)", *p)
 }
 
-var fieldIDToName_GameServiceUpdateGameDraftArgs = map[int16]string{
+var fieldIDToName_GameServiceUpdateGameRolloutArgs = map[int16]string{
 	1: "req",
 }
 
-type GameServiceUpdateGameDraftResult struct {
-	Success *UpdateGameDraftResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateGameDraftResponse" json:"success,omitempty"`
+type GameServiceUpdateGameRolloutResult struct {
+	Success *UpdateGameRolloutResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateGameRolloutResponse" json:"success,omitempty"`
 }
 
-func NewGameServiceUpdateGameDraftResult() *GameServiceUpdateGameDraftResult {
-	return &GameServiceUpdateGameDraftResult{}
+func NewGameServiceUpdateGameRolloutResult() *GameServiceUpdateGameRolloutResult {
+	return &GameServiceUpdateGameRolloutResult{}
 }
 
-func (p *GameServiceUpdateGameDraftResult) InitDefault() {
+func (p *GameServiceUpdateGameRolloutResult) InitDefault() {
 }
 
-var GameServiceUpdateGameDraftResult_Success_DEFAULT *UpdateGameDraftResponse
+var GameServiceUpdateGameRolloutResult_Success_DEFAULT *UpdateGameRolloutResponse
 
-func (p *GameServiceUpdateGameDraftResult) GetSuccess() (v *UpdateGameDraftResponse) {
+func (p *GameServiceUpdateGameRolloutResult) GetSuccess() (v *UpdateGameRolloutResponse) {
 	if !p.IsSetSuccess() {
-		return GameServiceUpdateGameDraftResult_Success_DEFAULT
+		return GameServiceUpdateGameRolloutResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *GameServiceUpdateGameDraftResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UpdateGameDraftResponse)
+func (p *GameServiceUpdateGameRolloutResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateGameRolloutResponse)
 }
 
-func (p *GameServiceUpdateGameDraftResult) IsSetSuccess() bool {
+func (p *GameServiceUpdateGameRolloutResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GameServiceUpdateGameDraftResult) String() string {
+func (p *GameServiceUpdateGameRolloutResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GameServiceUpdateGameDraftResult(%+v)", *p)
+	return fmt.Sprintf("GameServiceUpdateGameRolloutResult(%+v)", *p)
 }
 
-var fieldIDToName_GameServiceUpdateGameDraftResult = map[int16]string{
+var fieldIDToName_GameServiceUpdateGameRolloutResult = map[int16]string{
 	0: "success",
 }
 
-type GameServiceCreateGameDetailArgs struct {
-	Req *CreateGameDetailRequest `thrift:"req,1" frugal:"1,default,CreateGameDetailRequest" json:"req"`
+type GameServiceGetGameRolloutArgs struct {
+	Req *GetGameRolloutRequest `thrift:"req,1" frugal:"1,default,GetGameRolloutRequest" json:"req"`
 }
 
-func NewGameServiceCreateGameDetailArgs() *GameServiceCreateGameDetailArgs {
-	return &GameServiceCreateGameDetailArgs{}
+func NewGameServiceGetGameRolloutArgs() *GameServiceGetGameRolloutArgs {
+	return &GameServiceGetGameRolloutArgs{}
 }
 
-func (p *GameServiceCreateGameDetailArgs) InitDefault() {
+func (p *GameServiceGetGameRolloutArgs) InitDefault() {
 }
 
-var GameServiceCreateGameDetailArgs_Req_DEFAULT *CreateGameDetailRequest
+var GameServiceGetGameRolloutArgs_Req_DEFAULT *GetGameRolloutRequest
 
-func (p *GameServiceCreateGameDetailArgs) GetReq() (v *CreateGameDetailRequest) {
+func (p *GameServiceGetGameRolloutArgs) GetReq() (v *GetGameRolloutRequest) {
 	if !p.IsSetReq() {
-		return GameServiceCreateGameDetailArgs_Req_DEFAULT
+		return GameServiceGetGameRolloutArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *GameServiceCreateGameDetailArgs) SetReq(val *CreateGameDetailRequest) {
+func (p *GameServiceGetGameRolloutArgs) SetReq(val *GetGameRolloutRequest) {
 	p.Req = val
 }
 
-func (p *GameServiceCreateGameDetailArgs) IsSetReq() bool {
+func (p *GameServiceGetGameRolloutArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GameServiceCreateGameDetailArgs) String() string {
+func (p *GameServiceGetGameRolloutArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GameServiceCreateGameDetailArgs(%+v)", *p)
+	return fmt.Sprintf("GameServiceGetGameRolloutArgs(%+v)", *p)
 }
 
-var fieldIDToName_GameServiceCreateGameDetailArgs = map[int16]string{
+var fieldIDToName_GameServiceGetGameRolloutArgs = map[int16]string{
 	1: "req",
 }
 
-type GameServiceCreateGameDetailResult struct {
-	Success *CreateGameDetailResponse `thrift:"success,0,optional" frugal:"0,optional,CreateGameDetailResponse" json:"success,omitempty"`
+type GameServiceGetGameRolloutResult struct {
+	Success *GetGameRolloutResponse `thrift:"success,0,optional" frugal:"0,optional,GetGameRolloutResponse" json:"success,omitempty"`
 }
 
-func NewGameServiceCreateGameDetailResult() *GameServiceCreateGameDetailResult {
-	return &GameServiceCreateGameDetailResult{}
+func NewGameServiceGetGameRolloutResult() *GameServiceGetGameRolloutResult {
+	return &GameServiceGetGameRolloutResult{}
 }
 
-func (p *GameServiceCreateGameDetailResult) InitDefault() {
+func (p *GameServiceGetGameRolloutResult) InitDefault() {
 }
 
-var GameServiceCreateGameDetailResult_Success_DEFAULT *CreateGameDetailResponse
+var GameServiceGetGameRolloutResult_Success_DEFAULT *GetGameRolloutResponse
 
-func (p *GameServiceCreateGameDetailResult) GetSuccess() (v *CreateGameDetailResponse) {
+func (p *GameServiceGetGameRolloutResult) GetSuccess() (v *GetGameRolloutResponse) {
 	if !p.IsSetSuccess() {
-		return GameServiceCreateGameDetailResult_Success_DEFAULT
+		return GameServiceGetGameRolloutResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *GameServiceCreateGameDetailResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CreateGameDetailResponse)
+func (p *GameServiceGetGameRolloutResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetGameRolloutResponse)
 }
 
-func (p *GameServiceCreateGameDetailResult) IsSetSuccess() bool {
+func (p *GameServiceGetGameRolloutResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GameServiceCreateGameDetailResult) String() string {
+func (p *GameServiceGetGameRolloutResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GameServiceCreateGameDetailResult(%+v)", *p)
+	return fmt.Sprintf("GameServiceGetGameRolloutResult(%+v)", *p)
 }
 
-var fieldIDToName_GameServiceCreateGameDetailResult = map[int16]string{
+var fieldIDToName_GameServiceGetGameRolloutResult = map[int16]string{
 	0: "success",
 }
 
-type GameServiceReviewGameVersionArgs struct {
-	Req *ReviewGameVersionRequest `thrift:"req,1" frugal:"1,default,ReviewGameVersionRequest" json:"req"`
+type GameServiceResolveServedVersionArgs struct {
+	Req *ResolveServedVersionRequest `thrift:"req,1" frugal:"1,default,ResolveServedVersionRequest" json:"req"`
 }
 
-func NewGameServiceReviewGameVersionArgs() *GameServiceReviewGameVersionArgs {
-	return &GameServiceReviewGameVersionArgs{}
+func NewGameServiceResolveServedVersionArgs() *GameServiceResolveServedVersionArgs {
+	return &GameServiceResolveServedVersionArgs{}
 }
 
-func (p *GameServiceReviewGameVersionArgs) InitDefault() {
+func (p *GameServiceResolveServedVersionArgs) InitDefault() {
 }
 
-var GameServiceReviewGameVersionArgs_Req_DEFAULT *ReviewGameVersionRequest
+var GameServiceResolveServedVersionArgs_Req_DEFAULT *ResolveServedVersionRequest
 
-func (p *GameServiceReviewGameVersionArgs) GetReq() (v *ReviewGameVersionRequest) {
+func (p *GameServiceResolveServedVersionArgs) GetReq() (v *ResolveServedVersionRequest) {
 	if !p.IsSetReq() {
-		return GameServiceReviewGameVersionArgs_Req_DEFAULT
+		return GameServiceResolveServedVersionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *GameServiceReviewGameVersionArgs) SetReq(val *ReviewGameVersionRequest) {
+func (p *GameServiceResolveServedVersionArgs) SetReq(val *ResolveServedVersionRequest) {
 	p.Req = val
 }
 
-func (p *GameServiceReviewGameVersionArgs) IsSetReq() bool {
+func (p *GameServiceResolveServedVersionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GameServiceReviewGameVersionArgs) String() string {
+func (p *GameServiceResolveServedVersionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GameServiceReviewGameVersionArgs(%+v)", *p)
+	return fmt.Sprintf("GameServiceResolveServedVersionArgs(%+v)", *p)
 }
 
-var fieldIDToName_GameServiceReviewGameVersionArgs = map[int16]string{
+var fieldIDToName_GameServiceResolveServedVersionArgs = map[int16]string{
 	1: "req",
 }
 
-type GameServiceReviewGameVersionResult struct {
-	Success *ReviewGameVersionResponse `thrift:"success,0,optional" frugal:"0,optional,ReviewGameVersionResponse" json:"success,omitempty"`
+type GameServiceResolveServedVersionResult struct {
+	Success *ResolveServedVersionResponse `thrift:"success,0,optional" frugal:"0,optional,ResolveServedVersionResponse" json:"success,omitempty"`
 }
 
-func NewGameServiceReviewGameVersionResult() *GameServiceReviewGameVersionResult {
-	return &GameServiceReviewGameVersionResult{}
+func NewGameServiceResolveServedVersionResult() *GameServiceResolveServedVersionResult {
+	return &GameServiceResolveServedVersionResult{}
 }
 
-func (p *GameServiceReviewGameVersionResult) InitDefault() {
+func (p *GameServiceResolveServedVersionResult) InitDefault() {
 }
 
-var GameServiceReviewGameVersionResult_Success_DEFAULT *ReviewGameVersionResponse
+var GameServiceResolveServedVersionResult_Success_DEFAULT *ResolveServedVersionResponse
 
-func (p *GameServiceReviewGameVersionResult) GetSuccess() (v *ReviewGameVersionResponse) {
+func (p *GameServiceResolveServedVersionResult) GetSuccess() (v *ResolveServedVersionResponse) {
 	if !p.IsSetSuccess() {
-		return GameServiceReviewGameVersionResult_Success_DEFAULT
+		return GameServiceResolveServedVersionResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *GameServiceReviewGameVersionResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ReviewGameVersionResponse)
+func (p *GameServiceResolveServedVersionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ResolveServedVersionResponse)
 }
 
-func (p *GameServiceReviewGameVersionResult) IsSetSuccess() bool {
+func (p *GameServiceResolveServedVersionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GameServiceReviewGameVersionResult) String() string {
+func (p *GameServiceResolveServedVersionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GameServiceReviewGameVersionResult(%+v)", *p)
+	return fmt.Sprintf("GameServiceResolveServedVersionResult(%+v)", *p)
 }
 
-var fieldIDToName_GameServiceReviewGameVersionResult = map[int16]string{
+var fieldIDToName_GameServiceResolveServedVersionResult = map[int16]string{
 	0: "success",
 }
 
-type GameServiceDeleteGameDraftArgs struct {
-	Req *DeleteGameDraftRequest `thrift:"req,1" frugal:"1,default,DeleteGameDraftRequest" json:"req"`
+type GameServiceGetGameChangelogArgs struct {
+	Req *GetGameChangelogRequest `thrift:"req,1" frugal:"1,default,GetGameChangelogRequest" json:"req"`
 }
 
-func NewGameServiceDeleteGameDraftArgs() *GameServiceDeleteGameDraftArgs {
-	return &GameServiceDeleteGameDraftArgs{}
+func NewGameServiceGetGameChangelogArgs() *GameServiceGetGameChangelogArgs {
+	return &GameServiceGetGameChangelogArgs{}
 }
 
-func (p *GameServiceDeleteGameDraftArgs) InitDefault() {
+func (p *GameServiceGetGameChangelogArgs) InitDefault() {
 }
 
-var GameServiceDeleteGameDraftArgs_Req_DEFAULT *DeleteGameDraftRequest
+var GameServiceGetGameChangelogArgs_Req_DEFAULT *GetGameChangelogRequest
 
-func (p *GameServiceDeleteGameDraftArgs) GetReq() (v *DeleteGameDraftRequest) {
+func (p *GameServiceGetGameChangelogArgs) GetReq() (v *GetGameChangelogRequest) {
 	if !p.IsSetReq() {
-		return GameServiceDeleteGameDraftArgs_Req_DEFAULT
+		return GameServiceGetGameChangelogArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *GameServiceDeleteGameDraftArgs) SetReq(val *DeleteGameDraftRequest) {
+func (p *GameServiceGetGameChangelogArgs) SetReq(val *GetGameChangelogRequest) {
 	p.Req = val
 }
 
-func (p *GameServiceDeleteGameDraftArgs) IsSetReq() bool {
+func (p *GameServiceGetGameChangelogArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GameServiceDeleteGameDraftArgs) String() string {
+func (p *GameServiceGetGameChangelogArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GameServiceDeleteGameDraftArgs(%+v)", *p)
+	return fmt.Sprintf("GameServiceGetGameChangelogArgs(%+v)", *p)
 }
 
-var fieldIDToName_GameServiceDeleteGameDraftArgs = map[int16]string{
+var fieldIDToName_GameServiceGetGameChangelogArgs = map[int16]string{
 	1: "req",
 }
 
-type GameServiceDeleteGameDraftResult struct {
-	Success *DeleteGameDraftResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteGameDraftResponse" json:"success,omitempty"`
+type GameServiceGetGameChangelogResult struct {
+	Success *GetGameChangelogResponse `thrift:"success,0,optional" frugal:"0,optional,GetGameChangelogResponse" json:"success,omitempty"`
 }
 
-func NewGameServiceDeleteGameDraftResult() *GameServiceDeleteGameDraftResult {
-	return &GameServiceDeleteGameDraftResult{}
+func NewGameServiceGetGameChangelogResult() *GameServiceGetGameChangelogResult {
+	return &GameServiceGetGameChangelogResult{}
 }
 
-func (p *GameServiceDeleteGameDraftResult) InitDefault() {
+func (p *GameServiceGetGameChangelogResult) InitDefault() {
 }
 
-var GameServiceDeleteGameDraftResult_Success_DEFAULT *DeleteGameDraftResponse
+var GameServiceGetGameChangelogResult_Success_DEFAULT *GetGameChangelogResponse
 
-func (p *GameServiceDeleteGameDraftResult) GetSuccess() (v *DeleteGameDraftResponse) {
+func (p *GameServiceGetGameChangelogResult) GetSuccess() (v *GetGameChangelogResponse) {
 	if !p.IsSetSuccess() {
-		return GameServiceDeleteGameDraftResult_Success_DEFAULT
+		return GameServiceGetGameChangelogResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *GameServiceDeleteGameDraftResult) SetSuccess(x interface{}) {
-	p.Success = x.(*DeleteGameDraftResponse)
+func (p *GameServiceGetGameChangelogResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetGameChangelogResponse)
 }
 
-func (p *GameServiceDeleteGameDraftResult) IsSetSuccess() bool {
+func (p *GameServiceGetGameChangelogResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GameServiceDeleteGameDraftResult) String() string {
+func (p *GameServiceGetGameChangelogResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GameServiceDeleteGameDraftResult(%+v)", *p)
+	return fmt.Sprintf("GameServiceGetGameChangelogResult(%+v)", *p)
 }
 
-var fieldIDToName_GameServiceDeleteGameDraftResult = map[int16]string{
+var fieldIDToName_GameServiceGetGameChangelogResult = map[int16]string{
 	0: "success",
 }
@@ -17,6 +17,32 @@ type Client interface {
 	CreateGameDetail(ctx context.Context, req *game.CreateGameDetailRequest, callOptions ...callopt.Option) (r *game.CreateGameDetailResponse, err error)
 	ReviewGameVersion(ctx context.Context, req *game.ReviewGameVersionRequest, callOptions ...callopt.Option) (r *game.ReviewGameVersionResponse, err error)
 	DeleteGameDraft(ctx context.Context, req *game.DeleteGameDraftRequest, callOptions ...callopt.Option) (r *game.DeleteGameDraftResponse, err error)
+	ListGameVersions(ctx context.Context, req *game.ListGameVersionsRequest, callOptions ...callopt.Option) (r *game.ListGameVersionsResponse, err error)
+	RollbackGameVersion(ctx context.Context, req *game.RollbackGameVersionRequest, callOptions ...callopt.Option) (r *game.RollbackGameVersionResponse, err error)
+	CancelScheduledPublish(ctx context.Context, req *game.CancelScheduledPublishRequest, callOptions ...callopt.Option) (r *game.CancelScheduledPublishResponse, err error)
+	GetGameReviewLogs(ctx context.Context, req *game.GetGameReviewLogsRequest, callOptions ...callopt.Option) (r *game.GetGameReviewLogsResponse, err error)
+	SubmitGameVersion(ctx context.Context, req *game.SubmitGameVersionRequest, callOptions ...callopt.Option) (r *game.SubmitGameVersionResponse, err error)
+	WithdrawGameVersion(ctx context.Context, req *game.WithdrawGameVersionRequest, callOptions ...callopt.Option) (r *game.WithdrawGameVersionResponse, err error)
+	DiffGameVersions(ctx context.Context, req *game.DiffGameVersionsRequest, callOptions ...callopt.Option) (r *game.DiffGameVersionsResponse, err error)
+	TakedownGame(ctx context.Context, req *game.TakedownGameRequest, callOptions ...callopt.Option) (r *game.TakedownGameResponse, err error)
+	RestoreGame(ctx context.Context, req *game.RestoreGameRequest, callOptions ...callopt.Option) (r *game.RestoreGameResponse, err error)
+	ListDeletedGameDrafts(ctx context.Context, req *game.ListDeletedGameDraftsRequest, callOptions ...callopt.Option) (r *game.ListDeletedGameDraftsResponse, err error)
+	RestoreGameDraft(ctx context.Context, req *game.RestoreGameDraftRequest, callOptions ...callopt.Option) (r *game.RestoreGameDraftResponse, err error)
+	SearchGames(ctx context.Context, req *game.SearchGamesRequest, callOptions ...callopt.Option) (r *game.SearchGamesResponse, err error)
+	BatchGetGameDetails(ctx context.Context, req *game.BatchGetGameDetailsRequest, callOptions ...callopt.Option) (r *game.BatchGetGameDetailsResponse, err error)
+	ReleasePackageName(ctx context.Context, req *game.ReleasePackageNameRequest, callOptions ...callopt.Option) (r *game.ReleasePackageNameResponse, err error)
+	CreateCategory(ctx context.Context, req *game.CreateCategoryRequest, callOptions ...callopt.Option) (r *game.CreateCategoryResponse, err error)
+	UpdateCategory(ctx context.Context, req *game.UpdateCategoryRequest, callOptions ...callopt.Option) (r *game.UpdateCategoryResponse, err error)
+	DeleteCategory(ctx context.Context, req *game.DeleteCategoryRequest, callOptions ...callopt.Option) (r *game.DeleteCategoryResponse, err error)
+	ListCategories(ctx context.Context, req *game.ListCategoriesRequest, callOptions ...callopt.Option) (r *game.ListCategoriesResponse, err error)
+	CreateTag(ctx context.Context, req *game.CreateTagRequest, callOptions ...callopt.Option) (r *game.CreateTagResponse, err error)
+	UpdateTag(ctx context.Context, req *game.UpdateTagRequest, callOptions ...callopt.Option) (r *game.UpdateTagResponse, err error)
+	DeleteTag(ctx context.Context, req *game.DeleteTagRequest, callOptions ...callopt.Option) (r *game.DeleteTagResponse, err error)
+	ListTags(ctx context.Context, req *game.ListTagsRequest, callOptions ...callopt.Option) (r *game.ListTagsResponse, err error)
+	UpdateGameRollout(ctx context.Context, req *game.UpdateGameRolloutRequest, callOptions ...callopt.Option) (r *game.UpdateGameRolloutResponse, err error)
+	GetGameRollout(ctx context.Context, req *game.GetGameRolloutRequest, callOptions ...callopt.Option) (r *game.GetGameRolloutResponse, err error)
+	ResolveServedVersion(ctx context.Context, req *game.ResolveServedVersionRequest, callOptions ...callopt.Option) (r *game.ResolveServedVersionResponse, err error)
+	GetGameChangelog(ctx context.Context, req *game.GetGameChangelogRequest, callOptions ...callopt.Option) (r *game.GetGameChangelogResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -77,3 +103,133 @@ func (p *kGameServiceClient) DeleteGameDraft(ctx context.Context, req *game.Dele
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.DeleteGameDraft(ctx, req)
 }
+
+func (p *kGameServiceClient) ListGameVersions(ctx context.Context, req *game.ListGameVersionsRequest, callOptions ...callopt.Option) (r *game.ListGameVersionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListGameVersions(ctx, req)
+}
+
+func (p *kGameServiceClient) RollbackGameVersion(ctx context.Context, req *game.RollbackGameVersionRequest, callOptions ...callopt.Option) (r *game.RollbackGameVersionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RollbackGameVersion(ctx, req)
+}
+
+func (p *kGameServiceClient) CancelScheduledPublish(ctx context.Context, req *game.CancelScheduledPublishRequest, callOptions ...callopt.Option) (r *game.CancelScheduledPublishResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CancelScheduledPublish(ctx, req)
+}
+
+func (p *kGameServiceClient) GetGameReviewLogs(ctx context.Context, req *game.GetGameReviewLogsRequest, callOptions ...callopt.Option) (r *game.GetGameReviewLogsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetGameReviewLogs(ctx, req)
+}
+
+func (p *kGameServiceClient) SubmitGameVersion(ctx context.Context, req *game.SubmitGameVersionRequest, callOptions ...callopt.Option) (r *game.SubmitGameVersionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SubmitGameVersion(ctx, req)
+}
+
+func (p *kGameServiceClient) WithdrawGameVersion(ctx context.Context, req *game.WithdrawGameVersionRequest, callOptions ...callopt.Option) (r *game.WithdrawGameVersionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.WithdrawGameVersion(ctx, req)
+}
+
+func (p *kGameServiceClient) DiffGameVersions(ctx context.Context, req *game.DiffGameVersionsRequest, callOptions ...callopt.Option) (r *game.DiffGameVersionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DiffGameVersions(ctx, req)
+}
+
+func (p *kGameServiceClient) TakedownGame(ctx context.Context, req *game.TakedownGameRequest, callOptions ...callopt.Option) (r *game.TakedownGameResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TakedownGame(ctx, req)
+}
+
+func (p *kGameServiceClient) RestoreGame(ctx context.Context, req *game.RestoreGameRequest, callOptions ...callopt.Option) (r *game.RestoreGameResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RestoreGame(ctx, req)
+}
+
+func (p *kGameServiceClient) ListDeletedGameDrafts(ctx context.Context, req *game.ListDeletedGameDraftsRequest, callOptions ...callopt.Option) (r *game.ListDeletedGameDraftsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListDeletedGameDrafts(ctx, req)
+}
+
+func (p *kGameServiceClient) RestoreGameDraft(ctx context.Context, req *game.RestoreGameDraftRequest, callOptions ...callopt.Option) (r *game.RestoreGameDraftResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RestoreGameDraft(ctx, req)
+}
+
+func (p *kGameServiceClient) SearchGames(ctx context.Context, req *game.SearchGamesRequest, callOptions ...callopt.Option) (r *game.SearchGamesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SearchGames(ctx, req)
+}
+
+func (p *kGameServiceClient) BatchGetGameDetails(ctx context.Context, req *game.BatchGetGameDetailsRequest, callOptions ...callopt.Option) (r *game.BatchGetGameDetailsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BatchGetGameDetails(ctx, req)
+}
+
+func (p *kGameServiceClient) ReleasePackageName(ctx context.Context, req *game.ReleasePackageNameRequest, callOptions ...callopt.Option) (r *game.ReleasePackageNameResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReleasePackageName(ctx, req)
+}
+
+func (p *kGameServiceClient) CreateCategory(ctx context.Context, req *game.CreateCategoryRequest, callOptions ...callopt.Option) (r *game.CreateCategoryResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateCategory(ctx, req)
+}
+
+func (p *kGameServiceClient) UpdateCategory(ctx context.Context, req *game.UpdateCategoryRequest, callOptions ...callopt.Option) (r *game.UpdateCategoryResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateCategory(ctx, req)
+}
+
+func (p *kGameServiceClient) DeleteCategory(ctx context.Context, req *game.DeleteCategoryRequest, callOptions ...callopt.Option) (r *game.DeleteCategoryResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteCategory(ctx, req)
+}
+
+func (p *kGameServiceClient) ListCategories(ctx context.Context, req *game.ListCategoriesRequest, callOptions ...callopt.Option) (r *game.ListCategoriesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListCategories(ctx, req)
+}
+
+func (p *kGameServiceClient) CreateTag(ctx context.Context, req *game.CreateTagRequest, callOptions ...callopt.Option) (r *game.CreateTagResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateTag(ctx, req)
+}
+
+func (p *kGameServiceClient) UpdateTag(ctx context.Context, req *game.UpdateTagRequest, callOptions ...callopt.Option) (r *game.UpdateTagResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateTag(ctx, req)
+}
+
+func (p *kGameServiceClient) DeleteTag(ctx context.Context, req *game.DeleteTagRequest, callOptions ...callopt.Option) (r *game.DeleteTagResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteTag(ctx, req)
+}
+
+func (p *kGameServiceClient) ListTags(ctx context.Context, req *game.ListTagsRequest, callOptions ...callopt.Option) (r *game.ListTagsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListTags(ctx, req)
+}
+
+func (p *kGameServiceClient) UpdateGameRollout(ctx context.Context, req *game.UpdateGameRolloutRequest, callOptions ...callopt.Option) (r *game.UpdateGameRolloutResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateGameRollout(ctx, req)
+}
+
+func (p *kGameServiceClient) GetGameRollout(ctx context.Context, req *game.GetGameRolloutRequest, callOptions ...callopt.Option) (r *game.GetGameRolloutResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetGameRollout(ctx, req)
+}
+
+func (p *kGameServiceClient) ResolveServedVersion(ctx context.Context, req *game.ResolveServedVersionRequest, callOptions ...callopt.Option) (r *game.ResolveServedVersionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ResolveServedVersion(ctx, req)
+}
+
+func (p *kGameServiceClient) GetGameChangelog(ctx context.Context, req *game.GetGameChangelogRequest, callOptions ...callopt.Option) (r *game.GetGameChangelogResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetGameChangelog(ctx, req)
+}
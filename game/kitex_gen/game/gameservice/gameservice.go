@@ -55,6 +55,188 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"ListGameVersions": kitex.NewMethodInfo(
+		listGameVersionsHandler,
+		newGameServiceListGameVersionsArgs,
+		newGameServiceListGameVersionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RollbackGameVersion": kitex.NewMethodInfo(
+		rollbackGameVersionHandler,
+		newGameServiceRollbackGameVersionArgs,
+		newGameServiceRollbackGameVersionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CancelScheduledPublish": kitex.NewMethodInfo(
+		cancelScheduledPublishHandler,
+		newGameServiceCancelScheduledPublishArgs,
+		newGameServiceCancelScheduledPublishResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetGameReviewLogs": kitex.NewMethodInfo(
+		getGameReviewLogsHandler,
+		newGameServiceGetGameReviewLogsArgs,
+		newGameServiceGetGameReviewLogsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SubmitGameVersion": kitex.NewMethodInfo(
+		submitGameVersionHandler,
+		newGameServiceSubmitGameVersionArgs,
+		newGameServiceSubmitGameVersionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"WithdrawGameVersion": kitex.NewMethodInfo(
+		withdrawGameVersionHandler,
+		newGameServiceWithdrawGameVersionArgs,
+		newGameServiceWithdrawGameVersionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DiffGameVersions": kitex.NewMethodInfo(
+		diffGameVersionsHandler,
+		newGameServiceDiffGameVersionsArgs,
+		newGameServiceDiffGameVersionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"TakedownGame": kitex.NewMethodInfo(
+		takedownGameHandler,
+		newGameServiceTakedownGameArgs,
+		newGameServiceTakedownGameResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RestoreGame": kitex.NewMethodInfo(
+		restoreGameHandler,
+		newGameServiceRestoreGameArgs,
+		newGameServiceRestoreGameResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListDeletedGameDrafts": kitex.NewMethodInfo(
+		listDeletedGameDraftsHandler,
+		newGameServiceListDeletedGameDraftsArgs,
+		newGameServiceListDeletedGameDraftsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RestoreGameDraft": kitex.NewMethodInfo(
+		restoreGameDraftHandler,
+		newGameServiceRestoreGameDraftArgs,
+		newGameServiceRestoreGameDraftResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SearchGames": kitex.NewMethodInfo(
+		searchGamesHandler,
+		newGameServiceSearchGamesArgs,
+		newGameServiceSearchGamesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"BatchGetGameDetails": kitex.NewMethodInfo(
+		batchGetGameDetailsHandler,
+		newGameServiceBatchGetGameDetailsArgs,
+		newGameServiceBatchGetGameDetailsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ReleasePackageName": kitex.NewMethodInfo(
+		releasePackageNameHandler,
+		newGameServiceReleasePackageNameArgs,
+		newGameServiceReleasePackageNameResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateCategory": kitex.NewMethodInfo(
+		createCategoryHandler,
+		newGameServiceCreateCategoryArgs,
+		newGameServiceCreateCategoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateCategory": kitex.NewMethodInfo(
+		updateCategoryHandler,
+		newGameServiceUpdateCategoryArgs,
+		newGameServiceUpdateCategoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteCategory": kitex.NewMethodInfo(
+		deleteCategoryHandler,
+		newGameServiceDeleteCategoryArgs,
+		newGameServiceDeleteCategoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListCategories": kitex.NewMethodInfo(
+		listCategoriesHandler,
+		newGameServiceListCategoriesArgs,
+		newGameServiceListCategoriesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateTag": kitex.NewMethodInfo(
+		createTagHandler,
+		newGameServiceCreateTagArgs,
+		newGameServiceCreateTagResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateTag": kitex.NewMethodInfo(
+		updateTagHandler,
+		newGameServiceUpdateTagArgs,
+		newGameServiceUpdateTagResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteTag": kitex.NewMethodInfo(
+		deleteTagHandler,
+		newGameServiceDeleteTagArgs,
+		newGameServiceDeleteTagResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListTags": kitex.NewMethodInfo(
+		listTagsHandler,
+		newGameServiceListTagsArgs,
+		newGameServiceListTagsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateGameRollout": kitex.NewMethodInfo(
+		updateGameRolloutHandler,
+		newGameServiceUpdateGameRolloutArgs,
+		newGameServiceUpdateGameRolloutResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetGameRollout": kitex.NewMethodInfo(
+		getGameRolloutHandler,
+		newGameServiceGetGameRolloutArgs,
+		newGameServiceGetGameRolloutResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ResolveServedVersion": kitex.NewMethodInfo(
+		resolveServedVersionHandler,
+		newGameServiceResolveServedVersionArgs,
+		newGameServiceResolveServedVersionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetGameChangelog": kitex.NewMethodInfo(
+		getGameChangelogHandler,
+		newGameServiceGetGameChangelogArgs,
+		newGameServiceGetGameChangelogResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -229,6 +411,474 @@ func newGameServiceDeleteGameDraftResult() interface{} {
 	return game.NewGameServiceDeleteGameDraftResult()
 }
 
+func listGameVersionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceListGameVersionsArgs)
+	realResult := result.(*game.GameServiceListGameVersionsResult)
+	success, err := handler.(game.GameService).ListGameVersions(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceListGameVersionsArgs() interface{} {
+	return game.NewGameServiceListGameVersionsArgs()
+}
+
+func newGameServiceListGameVersionsResult() interface{} {
+	return game.NewGameServiceListGameVersionsResult()
+}
+
+func rollbackGameVersionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceRollbackGameVersionArgs)
+	realResult := result.(*game.GameServiceRollbackGameVersionResult)
+	success, err := handler.(game.GameService).RollbackGameVersion(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceRollbackGameVersionArgs() interface{} {
+	return game.NewGameServiceRollbackGameVersionArgs()
+}
+
+func newGameServiceRollbackGameVersionResult() interface{} {
+	return game.NewGameServiceRollbackGameVersionResult()
+}
+
+func cancelScheduledPublishHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceCancelScheduledPublishArgs)
+	realResult := result.(*game.GameServiceCancelScheduledPublishResult)
+	success, err := handler.(game.GameService).CancelScheduledPublish(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceCancelScheduledPublishArgs() interface{} {
+	return game.NewGameServiceCancelScheduledPublishArgs()
+}
+
+func newGameServiceCancelScheduledPublishResult() interface{} {
+	return game.NewGameServiceCancelScheduledPublishResult()
+}
+
+func getGameReviewLogsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceGetGameReviewLogsArgs)
+	realResult := result.(*game.GameServiceGetGameReviewLogsResult)
+	success, err := handler.(game.GameService).GetGameReviewLogs(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceGetGameReviewLogsArgs() interface{} {
+	return game.NewGameServiceGetGameReviewLogsArgs()
+}
+
+func newGameServiceGetGameReviewLogsResult() interface{} {
+	return game.NewGameServiceGetGameReviewLogsResult()
+}
+
+func submitGameVersionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceSubmitGameVersionArgs)
+	realResult := result.(*game.GameServiceSubmitGameVersionResult)
+	success, err := handler.(game.GameService).SubmitGameVersion(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceSubmitGameVersionArgs() interface{} {
+	return game.NewGameServiceSubmitGameVersionArgs()
+}
+
+func newGameServiceSubmitGameVersionResult() interface{} {
+	return game.NewGameServiceSubmitGameVersionResult()
+}
+
+func withdrawGameVersionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceWithdrawGameVersionArgs)
+	realResult := result.(*game.GameServiceWithdrawGameVersionResult)
+	success, err := handler.(game.GameService).WithdrawGameVersion(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceWithdrawGameVersionArgs() interface{} {
+	return game.NewGameServiceWithdrawGameVersionArgs()
+}
+
+func newGameServiceWithdrawGameVersionResult() interface{} {
+	return game.NewGameServiceWithdrawGameVersionResult()
+}
+
+func diffGameVersionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceDiffGameVersionsArgs)
+	realResult := result.(*game.GameServiceDiffGameVersionsResult)
+	success, err := handler.(game.GameService).DiffGameVersions(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceDiffGameVersionsArgs() interface{} {
+	return game.NewGameServiceDiffGameVersionsArgs()
+}
+
+func newGameServiceDiffGameVersionsResult() interface{} {
+	return game.NewGameServiceDiffGameVersionsResult()
+}
+
+func takedownGameHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceTakedownGameArgs)
+	realResult := result.(*game.GameServiceTakedownGameResult)
+	success, err := handler.(game.GameService).TakedownGame(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceTakedownGameArgs() interface{} {
+	return game.NewGameServiceTakedownGameArgs()
+}
+
+func newGameServiceTakedownGameResult() interface{} {
+	return game.NewGameServiceTakedownGameResult()
+}
+
+func restoreGameHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceRestoreGameArgs)
+	realResult := result.(*game.GameServiceRestoreGameResult)
+	success, err := handler.(game.GameService).RestoreGame(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceRestoreGameArgs() interface{} {
+	return game.NewGameServiceRestoreGameArgs()
+}
+
+func newGameServiceRestoreGameResult() interface{} {
+	return game.NewGameServiceRestoreGameResult()
+}
+
+func listDeletedGameDraftsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceListDeletedGameDraftsArgs)
+	realResult := result.(*game.GameServiceListDeletedGameDraftsResult)
+	success, err := handler.(game.GameService).ListDeletedGameDrafts(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceListDeletedGameDraftsArgs() interface{} {
+	return game.NewGameServiceListDeletedGameDraftsArgs()
+}
+
+func newGameServiceListDeletedGameDraftsResult() interface{} {
+	return game.NewGameServiceListDeletedGameDraftsResult()
+}
+
+func restoreGameDraftHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceRestoreGameDraftArgs)
+	realResult := result.(*game.GameServiceRestoreGameDraftResult)
+	success, err := handler.(game.GameService).RestoreGameDraft(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceRestoreGameDraftArgs() interface{} {
+	return game.NewGameServiceRestoreGameDraftArgs()
+}
+
+func newGameServiceRestoreGameDraftResult() interface{} {
+	return game.NewGameServiceRestoreGameDraftResult()
+}
+
+func searchGamesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceSearchGamesArgs)
+	realResult := result.(*game.GameServiceSearchGamesResult)
+	success, err := handler.(game.GameService).SearchGames(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceSearchGamesArgs() interface{} {
+	return game.NewGameServiceSearchGamesArgs()
+}
+
+func newGameServiceSearchGamesResult() interface{} {
+	return game.NewGameServiceSearchGamesResult()
+}
+
+func batchGetGameDetailsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceBatchGetGameDetailsArgs)
+	realResult := result.(*game.GameServiceBatchGetGameDetailsResult)
+	success, err := handler.(game.GameService).BatchGetGameDetails(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceBatchGetGameDetailsArgs() interface{} {
+	return game.NewGameServiceBatchGetGameDetailsArgs()
+}
+
+func newGameServiceBatchGetGameDetailsResult() interface{} {
+	return game.NewGameServiceBatchGetGameDetailsResult()
+}
+
+func releasePackageNameHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceReleasePackageNameArgs)
+	realResult := result.(*game.GameServiceReleasePackageNameResult)
+	success, err := handler.(game.GameService).ReleasePackageName(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceReleasePackageNameArgs() interface{} {
+	return game.NewGameServiceReleasePackageNameArgs()
+}
+
+func newGameServiceReleasePackageNameResult() interface{} {
+	return game.NewGameServiceReleasePackageNameResult()
+}
+
+func createCategoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceCreateCategoryArgs)
+	realResult := result.(*game.GameServiceCreateCategoryResult)
+	success, err := handler.(game.GameService).CreateCategory(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceCreateCategoryArgs() interface{} {
+	return game.NewGameServiceCreateCategoryArgs()
+}
+
+func newGameServiceCreateCategoryResult() interface{} {
+	return game.NewGameServiceCreateCategoryResult()
+}
+
+func updateCategoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceUpdateCategoryArgs)
+	realResult := result.(*game.GameServiceUpdateCategoryResult)
+	success, err := handler.(game.GameService).UpdateCategory(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceUpdateCategoryArgs() interface{} {
+	return game.NewGameServiceUpdateCategoryArgs()
+}
+
+func newGameServiceUpdateCategoryResult() interface{} {
+	return game.NewGameServiceUpdateCategoryResult()
+}
+
+func deleteCategoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceDeleteCategoryArgs)
+	realResult := result.(*game.GameServiceDeleteCategoryResult)
+	success, err := handler.(game.GameService).DeleteCategory(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceDeleteCategoryArgs() interface{} {
+	return game.NewGameServiceDeleteCategoryArgs()
+}
+
+func newGameServiceDeleteCategoryResult() interface{} {
+	return game.NewGameServiceDeleteCategoryResult()
+}
+
+func listCategoriesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceListCategoriesArgs)
+	realResult := result.(*game.GameServiceListCategoriesResult)
+	success, err := handler.(game.GameService).ListCategories(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceListCategoriesArgs() interface{} {
+	return game.NewGameServiceListCategoriesArgs()
+}
+
+func newGameServiceListCategoriesResult() interface{} {
+	return game.NewGameServiceListCategoriesResult()
+}
+
+func createTagHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceCreateTagArgs)
+	realResult := result.(*game.GameServiceCreateTagResult)
+	success, err := handler.(game.GameService).CreateTag(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceCreateTagArgs() interface{} {
+	return game.NewGameServiceCreateTagArgs()
+}
+
+func newGameServiceCreateTagResult() interface{} {
+	return game.NewGameServiceCreateTagResult()
+}
+
+func updateTagHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceUpdateTagArgs)
+	realResult := result.(*game.GameServiceUpdateTagResult)
+	success, err := handler.(game.GameService).UpdateTag(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceUpdateTagArgs() interface{} {
+	return game.NewGameServiceUpdateTagArgs()
+}
+
+func newGameServiceUpdateTagResult() interface{} {
+	return game.NewGameServiceUpdateTagResult()
+}
+
+func deleteTagHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceDeleteTagArgs)
+	realResult := result.(*game.GameServiceDeleteTagResult)
+	success, err := handler.(game.GameService).DeleteTag(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceDeleteTagArgs() interface{} {
+	return game.NewGameServiceDeleteTagArgs()
+}
+
+func newGameServiceDeleteTagResult() interface{} {
+	return game.NewGameServiceDeleteTagResult()
+}
+
+func listTagsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceListTagsArgs)
+	realResult := result.(*game.GameServiceListTagsResult)
+	success, err := handler.(game.GameService).ListTags(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceListTagsArgs() interface{} {
+	return game.NewGameServiceListTagsArgs()
+}
+
+func newGameServiceListTagsResult() interface{} {
+	return game.NewGameServiceListTagsResult()
+}
+
+func updateGameRolloutHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceUpdateGameRolloutArgs)
+	realResult := result.(*game.GameServiceUpdateGameRolloutResult)
+	success, err := handler.(game.GameService).UpdateGameRollout(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceUpdateGameRolloutArgs() interface{} {
+	return game.NewGameServiceUpdateGameRolloutArgs()
+}
+
+func newGameServiceUpdateGameRolloutResult() interface{} {
+	return game.NewGameServiceUpdateGameRolloutResult()
+}
+
+func getGameRolloutHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceGetGameRolloutArgs)
+	realResult := result.(*game.GameServiceGetGameRolloutResult)
+	success, err := handler.(game.GameService).GetGameRollout(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceGetGameRolloutArgs() interface{} {
+	return game.NewGameServiceGetGameRolloutArgs()
+}
+
+func newGameServiceGetGameRolloutResult() interface{} {
+	return game.NewGameServiceGetGameRolloutResult()
+}
+
+func resolveServedVersionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceResolveServedVersionArgs)
+	realResult := result.(*game.GameServiceResolveServedVersionResult)
+	success, err := handler.(game.GameService).ResolveServedVersion(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceResolveServedVersionArgs() interface{} {
+	return game.NewGameServiceResolveServedVersionArgs()
+}
+
+func newGameServiceResolveServedVersionResult() interface{} {
+	return game.NewGameServiceResolveServedVersionResult()
+}
+
+func getGameChangelogHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*game.GameServiceGetGameChangelogArgs)
+	realResult := result.(*game.GameServiceGetGameChangelogResult)
+	success, err := handler.(game.GameService).GetGameChangelog(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newGameServiceGetGameChangelogArgs() interface{} {
+	return game.NewGameServiceGetGameChangelogArgs()
+}
+
+func newGameServiceGetGameChangelogResult() interface{} {
+	return game.NewGameServiceGetGameChangelogResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -298,3 +948,263 @@ func (p *kClient) DeleteGameDraft(ctx context.Context, req *game.DeleteGameDraft
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) ListGameVersions(ctx context.Context, req *game.ListGameVersionsRequest) (r *game.ListGameVersionsResponse, err error) {
+	var _args game.GameServiceListGameVersionsArgs
+	_args.Req = req
+	var _result game.GameServiceListGameVersionsResult
+	if err = p.c.Call(ctx, "ListGameVersions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RollbackGameVersion(ctx context.Context, req *game.RollbackGameVersionRequest) (r *game.RollbackGameVersionResponse, err error) {
+	var _args game.GameServiceRollbackGameVersionArgs
+	_args.Req = req
+	var _result game.GameServiceRollbackGameVersionResult
+	if err = p.c.Call(ctx, "RollbackGameVersion", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CancelScheduledPublish(ctx context.Context, req *game.CancelScheduledPublishRequest) (r *game.CancelScheduledPublishResponse, err error) {
+	var _args game.GameServiceCancelScheduledPublishArgs
+	_args.Req = req
+	var _result game.GameServiceCancelScheduledPublishResult
+	if err = p.c.Call(ctx, "CancelScheduledPublish", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetGameReviewLogs(ctx context.Context, req *game.GetGameReviewLogsRequest) (r *game.GetGameReviewLogsResponse, err error) {
+	var _args game.GameServiceGetGameReviewLogsArgs
+	_args.Req = req
+	var _result game.GameServiceGetGameReviewLogsResult
+	if err = p.c.Call(ctx, "GetGameReviewLogs", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SubmitGameVersion(ctx context.Context, req *game.SubmitGameVersionRequest) (r *game.SubmitGameVersionResponse, err error) {
+	var _args game.GameServiceSubmitGameVersionArgs
+	_args.Req = req
+	var _result game.GameServiceSubmitGameVersionResult
+	if err = p.c.Call(ctx, "SubmitGameVersion", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) WithdrawGameVersion(ctx context.Context, req *game.WithdrawGameVersionRequest) (r *game.WithdrawGameVersionResponse, err error) {
+	var _args game.GameServiceWithdrawGameVersionArgs
+	_args.Req = req
+	var _result game.GameServiceWithdrawGameVersionResult
+	if err = p.c.Call(ctx, "WithdrawGameVersion", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DiffGameVersions(ctx context.Context, req *game.DiffGameVersionsRequest) (r *game.DiffGameVersionsResponse, err error) {
+	var _args game.GameServiceDiffGameVersionsArgs
+	_args.Req = req
+	var _result game.GameServiceDiffGameVersionsResult
+	if err = p.c.Call(ctx, "DiffGameVersions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TakedownGame(ctx context.Context, req *game.TakedownGameRequest) (r *game.TakedownGameResponse, err error) {
+	var _args game.GameServiceTakedownGameArgs
+	_args.Req = req
+	var _result game.GameServiceTakedownGameResult
+	if err = p.c.Call(ctx, "TakedownGame", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RestoreGame(ctx context.Context, req *game.RestoreGameRequest) (r *game.RestoreGameResponse, err error) {
+	var _args game.GameServiceRestoreGameArgs
+	_args.Req = req
+	var _result game.GameServiceRestoreGameResult
+	if err = p.c.Call(ctx, "RestoreGame", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListDeletedGameDrafts(ctx context.Context, req *game.ListDeletedGameDraftsRequest) (r *game.ListDeletedGameDraftsResponse, err error) {
+	var _args game.GameServiceListDeletedGameDraftsArgs
+	_args.Req = req
+	var _result game.GameServiceListDeletedGameDraftsResult
+	if err = p.c.Call(ctx, "ListDeletedGameDrafts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RestoreGameDraft(ctx context.Context, req *game.RestoreGameDraftRequest) (r *game.RestoreGameDraftResponse, err error) {
+	var _args game.GameServiceRestoreGameDraftArgs
+	_args.Req = req
+	var _result game.GameServiceRestoreGameDraftResult
+	if err = p.c.Call(ctx, "RestoreGameDraft", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SearchGames(ctx context.Context, req *game.SearchGamesRequest) (r *game.SearchGamesResponse, err error) {
+	var _args game.GameServiceSearchGamesArgs
+	_args.Req = req
+	var _result game.GameServiceSearchGamesResult
+	if err = p.c.Call(ctx, "SearchGames", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BatchGetGameDetails(ctx context.Context, req *game.BatchGetGameDetailsRequest) (r *game.BatchGetGameDetailsResponse, err error) {
+	var _args game.GameServiceBatchGetGameDetailsArgs
+	_args.Req = req
+	var _result game.GameServiceBatchGetGameDetailsResult
+	if err = p.c.Call(ctx, "BatchGetGameDetails", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReleasePackageName(ctx context.Context, req *game.ReleasePackageNameRequest) (r *game.ReleasePackageNameResponse, err error) {
+	var _args game.GameServiceReleasePackageNameArgs
+	_args.Req = req
+	var _result game.GameServiceReleasePackageNameResult
+	if err = p.c.Call(ctx, "ReleasePackageName", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateCategory(ctx context.Context, req *game.CreateCategoryRequest) (r *game.CreateCategoryResponse, err error) {
+	var _args game.GameServiceCreateCategoryArgs
+	_args.Req = req
+	var _result game.GameServiceCreateCategoryResult
+	if err = p.c.Call(ctx, "CreateCategory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateCategory(ctx context.Context, req *game.UpdateCategoryRequest) (r *game.UpdateCategoryResponse, err error) {
+	var _args game.GameServiceUpdateCategoryArgs
+	_args.Req = req
+	var _result game.GameServiceUpdateCategoryResult
+	if err = p.c.Call(ctx, "UpdateCategory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteCategory(ctx context.Context, req *game.DeleteCategoryRequest) (r *game.DeleteCategoryResponse, err error) {
+	var _args game.GameServiceDeleteCategoryArgs
+	_args.Req = req
+	var _result game.GameServiceDeleteCategoryResult
+	if err = p.c.Call(ctx, "DeleteCategory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListCategories(ctx context.Context, req *game.ListCategoriesRequest) (r *game.ListCategoriesResponse, err error) {
+	var _args game.GameServiceListCategoriesArgs
+	_args.Req = req
+	var _result game.GameServiceListCategoriesResult
+	if err = p.c.Call(ctx, "ListCategories", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateTag(ctx context.Context, req *game.CreateTagRequest) (r *game.CreateTagResponse, err error) {
+	var _args game.GameServiceCreateTagArgs
+	_args.Req = req
+	var _result game.GameServiceCreateTagResult
+	if err = p.c.Call(ctx, "CreateTag", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateTag(ctx context.Context, req *game.UpdateTagRequest) (r *game.UpdateTagResponse, err error) {
+	var _args game.GameServiceUpdateTagArgs
+	_args.Req = req
+	var _result game.GameServiceUpdateTagResult
+	if err = p.c.Call(ctx, "UpdateTag", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteTag(ctx context.Context, req *game.DeleteTagRequest) (r *game.DeleteTagResponse, err error) {
+	var _args game.GameServiceDeleteTagArgs
+	_args.Req = req
+	var _result game.GameServiceDeleteTagResult
+	if err = p.c.Call(ctx, "DeleteTag", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListTags(ctx context.Context, req *game.ListTagsRequest) (r *game.ListTagsResponse, err error) {
+	var _args game.GameServiceListTagsArgs
+	_args.Req = req
+	var _result game.GameServiceListTagsResult
+	if err = p.c.Call(ctx, "ListTags", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateGameRollout(ctx context.Context, req *game.UpdateGameRolloutRequest) (r *game.UpdateGameRolloutResponse, err error) {
+	var _args game.GameServiceUpdateGameRolloutArgs
+	_args.Req = req
+	var _result game.GameServiceUpdateGameRolloutResult
+	if err = p.c.Call(ctx, "UpdateGameRollout", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetGameRollout(ctx context.Context, req *game.GetGameRolloutRequest) (r *game.GetGameRolloutResponse, err error) {
+	var _args game.GameServiceGetGameRolloutArgs
+	_args.Req = req
+	var _result game.GameServiceGetGameRolloutResult
+	if err = p.c.Call(ctx, "GetGameRollout", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ResolveServedVersion(ctx context.Context, req *game.ResolveServedVersionRequest) (r *game.ResolveServedVersionResponse, err error) {
+	var _args game.GameServiceResolveServedVersionArgs
+	_args.Req = req
+	var _result game.GameServiceResolveServedVersionResult
+	if err = p.c.Call(ctx, "ResolveServedVersion", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetGameChangelog(ctx context.Context, req *game.GetGameChangelogRequest) (r *game.GetGameChangelogResponse, err error) {
+	var _args game.GameServiceGetGameChangelogArgs
+	_args.Req = req
+	var _result game.GameServiceGetGameChangelogResult
+	if err = p.c.Call(ctx, "GetGameChangelog", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -0,0 +1,173 @@
+// Package locale picks the store listing of a game version to show in a language.
+//
+// A version keeps the listing of its default locale in its top-level fields and the listings of other
+// locales in GameVersion.Listings. Readers ask for a list of preferred locales, typically parsed from
+// Accept-Language; the first one the version has wins, matching on the language alone when the exact tag
+// is missing, and the default locale is used when none match. Fields left empty in a listing fall back to
+// the default locale one by one.
+package locale
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+)
+
+// Default is the locale of versions written before listings existed.
+const Default = "zh-CN"
+
+// tagPattern is the subset of BCP 47 used for listings: a language, then optional script and region
+// subtags, e.g. "en", "en-US", "zh-Hant-TW".
+var tagPattern = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z]{4})?(-([A-Za-z]{2}|[0-9]{3}))?$`)
+
+// Normalize returns the canonical form of a locale tag: lowercase language, title-case script and
+// uppercase region. ok is false when tag is not a supported tag.
+func Normalize(tag string) (normalized string, ok bool) {
+	tag = strings.ReplaceAll(strings.TrimSpace(tag), "_", "-")
+	if !tagPattern.MatchString(tag) {
+		return "", false
+	}
+	parts := strings.Split(tag, "-")
+	parts[0] = strings.ToLower(parts[0])
+	for i := 1; i < len(parts); i++ {
+		if len(parts[i]) == 4 {
+			parts[i] = strings.ToUpper(parts[i][:1]) + strings.ToLower(parts[i][1:])
+		} else {
+			parts[i] = strings.ToUpper(parts[i])
+		}
+	}
+	return strings.Join(parts, "-"), true
+}
+
+// language returns the language subtag of a normalized tag.
+func language(tag string) string {
+	if i := strings.IndexByte(tag, '-'); i >= 0 {
+		return tag[:i]
+	}
+	return tag
+}
+
+// ParseAcceptLanguage returns the locales of an Accept-Language header ordered by preference. Invalid
+// tags, the "*" wildcard and tags with q=0 are skipped.
+func ParseAcceptLanguage(header string) []string {
+	type weighted struct {
+		tag     string
+		quality float64
+	}
+	var items []weighted
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		tag, ok := Normalize(fields[0])
+		if !ok {
+			continue
+		}
+		quality := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				q, err := strconv.ParseFloat(param[2:], 64)
+				if err != nil {
+					q = 0
+				}
+				quality = q
+			}
+		}
+		if quality > 0 {
+			items = append(items, weighted{tag: tag, quality: quality})
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool { return items[i].quality > items[j].quality })
+
+	tags := make([]string, 0, len(items))
+	for _, item := range items {
+		tags = append(tags, item.tag)
+	}
+	return tags
+}
+
+// Match returns the locale of available to show a reader preferring preferred, or defaultLocale when
+// none of them is available. For each preferred locale an exact match wins over a match on the language,
+// and among several locales of the same language the smallest tag is taken, so the result is stable.
+func Match(available []string, preferred []string, defaultLocale string) string {
+	byTag := make(map[string]string, len(available))
+	for _, tag := range available {
+		if normalized, ok := Normalize(tag); ok {
+			byTag[normalized] = tag
+		}
+	}
+	for _, pref := range preferred {
+		pref, ok := Normalize(pref)
+		if !ok {
+			continue
+		}
+		if tag, ok := byTag[pref]; ok {
+			return tag
+		}
+		var candidates []string
+		for normalized, tag := range byTag {
+			if language(normalized) == language(pref) {
+				candidates = append(candidates, tag)
+			}
+		}
+		if len(candidates) > 0 {
+			sort.Strings(candidates)
+			return candidates[0]
+		}
+	}
+	return defaultLocale
+}
+
+// DefaultLocaleOf returns the default locale of a version, which is Default for versions that have none.
+func DefaultLocaleOf(version *game.GameVersion) string {
+	if version.DefaultLocale == "" {
+		return Default
+	}
+	return version.DefaultLocale
+}
+
+// Resolve returns the locale of version best matching preferred and its listing in that locale, with
+// empty fields taken from the default locale.
+func Resolve(version *game.GameVersion, preferred []string) (string, *game.LocalizedListing) {
+	defaultLocale := DefaultLocaleOf(version)
+	listing := &game.LocalizedListing{
+		GameName:               version.GameName,
+		GameIntroduction:       version.GameIntroduction,
+		GameIntroductionImages: version.GameIntroductionImages,
+		HeaderImage:            version.HeaderImage,
+		ReleaseNotes:           version.ReleaseNotes,
+	}
+
+	available := make([]string, 0, len(version.Listings)+1)
+	available = append(available, defaultLocale)
+	for tag := range version.Listings {
+		available = append(available, tag)
+	}
+	tag := Match(available, preferred, defaultLocale)
+	if tag == defaultLocale {
+		return tag, listing
+	}
+
+	localized := version.Listings[tag]
+	if localized == nil {
+		return defaultLocale, listing
+	}
+	if localized.GameName != "" {
+		listing.GameName = localized.GameName
+	}
+	if localized.GameIntroduction != "" {
+		listing.GameIntroduction = localized.GameIntroduction
+	}
+	if len(localized.GameIntroductionImages) > 0 {
+		listing.GameIntroductionImages = localized.GameIntroductionImages
+	}
+	if localized.HeaderImage != "" {
+		listing.HeaderImage = localized.HeaderImage
+	}
+	if localized.ReleaseNotes != "" {
+		listing.ReleaseNotes = localized.ReleaseNotes
+	}
+	return tag, listing
+}
@@ -0,0 +1,77 @@
+package locale
+
+import (
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNormalize tests the canonical form of supported tags and the rejection of others
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		tag    string
+		want   string
+		wantOK bool
+	}{
+		{"en", "en", true},
+		{"en_us", "en-US", true},
+		{" ZH-hant-tw ", "zh-Hant-TW", true},
+		{"es-419", "es-419", true},
+		{"english", "", false},
+		{"*", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := Normalize(tt.tag)
+		assert.Equal(t, tt.want, got, tt.tag)
+		assert.Equal(t, tt.wantOK, ok, tt.tag)
+	}
+}
+
+// TestParseAcceptLanguage tests that locales are ordered by quality, skipping wildcards, invalid tags and q=0
+func TestParseAcceptLanguage(t *testing.T) {
+	header := "fr;q=0.5, en-us, *;q=0.1, ja;q=0, de-DE;q=0.8, not a tag"
+
+	assert.Equal(t, []string{"en-US", "de-DE", "fr"}, ParseAcceptLanguage(header))
+	assert.Empty(t, ParseAcceptLanguage(""))
+}
+
+// TestMatch tests exact matches, matches on the language and the fallback to the default locale
+func TestMatch(t *testing.T) {
+	available := []string{"zh-CN", "en-US", "en-GB", "ja-JP"}
+
+	assert.Equal(t, "en-GB", Match(available, []string{"en-gb"}, "zh-CN"))
+	assert.Equal(t, "en-GB", Match(available, []string{"en"}, "zh-CN"))
+	assert.Equal(t, "ja-JP", Match(available, []string{"fr", "ja"}, "zh-CN"))
+	assert.Equal(t, "zh-CN", Match(available, []string{"fr"}, "zh-CN"))
+	assert.Equal(t, "zh-CN", Match(available, nil, "zh-CN"))
+}
+
+// TestResolve tests that empty fields of a listing fall back to the default locale one by one
+func TestResolve(t *testing.T) {
+	version := &game.GameVersion{
+		GameName:               "星际农场",
+		GameIntroduction:       "种星星。",
+		GameIntroductionImages: []string{"zh.png"},
+		HeaderImage:            "zh_header.png",
+		ReleaseNotes:           "新增雨季。",
+		Listings: map[string]*game.LocalizedListing{
+			"en-US": {GameName: "Star Farm", GameIntroductionImages: []string{"en.png"}, ReleaseNotes: "Adds the rainy season."},
+		},
+	}
+
+	tag, listing := Resolve(version, []string{"en"})
+	assert.Equal(t, "en-US", tag)
+	assert.Equal(t, &game.LocalizedListing{
+		GameName:               "Star Farm",
+		GameIntroduction:       "种星星。",
+		GameIntroductionImages: []string{"en.png"},
+		HeaderImage:            "zh_header.png",
+		ReleaseNotes:           "Adds the rainy season.",
+	}, listing)
+
+	tag, listing = Resolve(version, []string{"fr"})
+	assert.Equal(t, Default, tag)
+	assert.Equal(t, "星际农场", listing.GameName)
+}
@@ -5,10 +5,16 @@ import (
 	"log"
 
 	"github.com/GameLaunchPad/game_management_project/game/config"
+	"github.com/GameLaunchPad/game_management_project/game/constdef"
 	"github.com/GameLaunchPad/game_management_project/game/dal"
 	"github.com/GameLaunchPad/game_management_project/game/dao"
 	"github.com/GameLaunchPad/game_management_project/game/handler"
 	game "github.com/GameLaunchPad/game_management_project/game/kitex_gen/game/gameservice"
+	"github.com/GameLaunchPad/game_management_project/game/scheduler"
+	"github.com/GameLaunchPad/game_management_project/game/search"
+	"github.com/GameLaunchPad/game_management_project/game/service"
+	"github.com/cloudwego/kitex/pkg/transmeta"
+	"github.com/cloudwego/kitex/server"
 )
 
 const configPath = "script/config.yaml"
@@ -21,9 +27,37 @@ func main() {
 	dal.InitClient(context.Background())
 	handler.GameDao = dao.NewGameDAO()
 
-	svr := game.NewServer(new(GameServiceImpl))
-	err := svr.Run()
+	searchIndex, err := search.Open(config.SearchIndexPath())
+	if err != nil {
+		log.Fatalf("failed to open search index, run cmd/rebuild_search_index to recreate it: %v", err)
+	}
+	// first start, the index file was removed, or the service did not shut down cleanly and the file may
+	// miss the writes made after its last background save: build it from the database
+	if searchIndex.Len() == 0 || searchIndex.Stale() {
+		if searchIndex.Len() != 0 {
+			log.Printf("search index was not saved by a clean shutdown, rebuilding it")
+		}
+		count, err := service.RebuildSearchIndex(context.Background(), handler.GameDao, searchIndex)
+		if err != nil {
+			log.Fatalf("failed to build search index: %v", err)
+		}
+		log.Printf("search index built with %d games", count)
+	}
+	handler.SearchIndex = searchIndex
+	searchIndex.AutoSave(context.Background(), constdef.SearchIndexSaveInterval)
+
+	scheduler.NewPublishScheduler(handler.GameDao, constdef.PublishSchedulerInterval).
+		OnPublished(handler.RefreshSearchIndex).
+		Start(context.Background())
+
+	// the gateway sends the calling CP in TTHeader metadata, read back by the auth package
+	svr := game.NewServer(new(GameServiceImpl), server.WithMetaHandler(transmeta.ServerTTHeaderHandler))
+	err = svr.Run()
 	if err != nil {
 		log.Println(err.Error())
 	}
+	// writes since the last background save are only in memory
+	if err := searchIndex.Close(); err != nil {
+		log.Printf("failed to save search index: %v", err)
+	}
 }
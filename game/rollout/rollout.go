@@ -0,0 +1,48 @@
+// Package rollout decides which version of a game a player is served while a new version is rolled out
+// to a percentage of players.
+//
+// Players are hashed into Buckets buckets by game, rolling-out version and player ID, and a rollout at N%
+// serves its version to the players of the first N buckets. The hash is stable, so raising the percentage
+// only adds players and a player never flips back and forth; it includes the version, so each rollout of a
+// game samples a different set of players instead of always putting the same ones first.
+package rollout
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"strconv"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+)
+
+// Buckets is the number of buckets players are hashed into, one per percentage point.
+const Buckets = 100
+
+// Bucket returns the bucket of a player for the rollout of a version, in [0, Buckets).
+func Bucket(gameID, versionID uint64, playerID string) int {
+	h := sha256.New()
+	h.Write([]byte(strconv.FormatUint(gameID, 10)))
+	h.Write([]byte{':'})
+	h.Write([]byte(strconv.FormatUint(versionID, 10)))
+	h.Write([]byte{':'})
+	h.Write([]byte(playerID))
+	sum := h.Sum(nil)
+	return int(binary.BigEndian.Uint64(sum) % Buckets)
+}
+
+// Includes reports whether a rollout of a version at percentage reaches the player.
+func Includes(gameID, versionID uint64, percentage int, playerID string) bool {
+	return Bucket(gameID, versionID, playerID) < percentage
+}
+
+// ServedVersion returns the version a player gets for a game and whether it is the rolling-out version.
+// Pausing a rollout only stops it from growing: the players already in it keep the rolling-out version.
+// Players outside the rollout get the online version, which is 0 for a game that is not online.
+func ServedVersion(gameRecord *ddl.GpGame, playerID string) (uint64, bool) {
+	if gameRecord.RolloutVersionId != 0 && gameRecord.RolloutState != int(game.RolloutState_Unset) &&
+		Includes(gameRecord.Id, gameRecord.RolloutVersionId, gameRecord.RolloutPercentage, playerID) {
+		return gameRecord.RolloutVersionId, true
+	}
+	return gameRecord.OnlineGameVersionId, false
+}
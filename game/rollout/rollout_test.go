@@ -0,0 +1,89 @@
+package rollout
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBucket tests that buckets are stable, in range and spread evenly over players
+func TestBucket(t *testing.T) {
+	assert.Equal(t, Bucket(101, 201, "player-1"), Bucket(101, 201, "player-1"))
+
+	counts := make([]int, Buckets)
+	for i := 0; i < 100000; i++ {
+		bucket := Bucket(101, 201, fmt.Sprintf("player-%d", i))
+		assert.True(t, bucket >= 0 && bucket < Buckets)
+		counts[bucket]++
+	}
+	// 1000 players per bucket on average
+	for bucket, count := range counts {
+		assert.InDelta(t, 1000, count, 200, "bucket %d", bucket)
+	}
+}
+
+// TestIncludes tests that raising the percentage only adds players and that each version samples its own players
+func TestIncludes(t *testing.T) {
+	included := 0
+	sameForNextVersion := 0
+	for i := 0; i < 10000; i++ {
+		playerID := fmt.Sprintf("player-%d", i)
+		if Includes(101, 201, 10, playerID) {
+			included++
+			assert.True(t, Includes(101, 201, 50, playerID), "a player in a 10%% rollout must stay in at 50%%")
+			if Includes(101, 202, 10, playerID) {
+				sameForNextVersion++
+			}
+		}
+		assert.False(t, Includes(101, 201, 0, playerID))
+		assert.True(t, Includes(101, 201, 100, playerID))
+	}
+	assert.InDelta(t, 1000, included, 150)
+	// independent samples share about 10% of their players, not all of them
+	assert.Less(t, sameForNextVersion, included/2)
+}
+
+// TestServedVersion tests which version players get during an active, paused and no rollout
+func TestServedVersion(t *testing.T) {
+	gameRecord := &ddl.GpGame{
+		Id:                  101,
+		OnlineGameVersionId: 200,
+		RolloutVersionId:    201,
+		RolloutPercentage:   30,
+		RolloutState:        int(game.RolloutState_Active),
+	}
+
+	var inRollout, outOfRollout string
+	for i := 0; inRollout == "" || outOfRollout == ""; i++ {
+		playerID := fmt.Sprintf("player-%d", i)
+		if Includes(101, 201, 30, playerID) {
+			inRollout = playerID
+		} else {
+			outOfRollout = playerID
+		}
+	}
+
+	versionID, rolledOut := ServedVersion(gameRecord, inRollout)
+	assert.Equal(t, uint64(201), versionID)
+	assert.True(t, rolledOut)
+	versionID, rolledOut = ServedVersion(gameRecord, outOfRollout)
+	assert.Equal(t, uint64(200), versionID)
+	assert.False(t, rolledOut)
+
+	// a paused rollout stops growing but keeps its players
+	gameRecord.RolloutState = int(game.RolloutState_Paused)
+	versionID, rolledOut = ServedVersion(gameRecord, inRollout)
+	assert.Equal(t, uint64(201), versionID)
+	assert.True(t, rolledOut)
+	versionID, rolledOut = ServedVersion(gameRecord, outOfRollout)
+	assert.Equal(t, uint64(200), versionID)
+	assert.False(t, rolledOut)
+
+	noRollout := &ddl.GpGame{Id: 101, OnlineGameVersionId: 200}
+	versionID, rolledOut = ServedVersion(noRollout, inRollout)
+	assert.Equal(t, uint64(200), versionID)
+	assert.False(t, rolledOut)
+}
@@ -0,0 +1,86 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+)
+
+// PublishScheduler publishes game versions whose scheduled publish time has come.
+// Pending schedules live in gp_game_version (status Scheduled + publish_at), so the scheduler
+// keeps no state of its own: every tick asks the database what is due, which makes it
+// survive restarts and pick up cancellations without any extra bookkeeping.
+type PublishScheduler struct {
+	gameDao  dao.IGameDAO
+	interval time.Duration
+	now      func() time.Time
+
+	onPublished func(ctx context.Context, gameID uint64)
+}
+
+// NewPublishScheduler creates a PublishScheduler that polls the database every interval.
+func NewPublishScheduler(gameDao dao.IGameDAO, interval time.Duration) *PublishScheduler {
+	return &PublishScheduler{
+		gameDao:  gameDao,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// OnPublished registers a callback that runs after every version the scheduler publishes.
+func (s *PublishScheduler) OnPublished(fn func(ctx context.Context, gameID uint64)) *PublishScheduler {
+	s.onPublished = fn
+	return s
+}
+
+// Start runs the scheduler in the background until ctx is done.
+func (s *PublishScheduler) Start(ctx context.Context) {
+	go s.run(ctx)
+}
+
+func (s *PublishScheduler) run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		// run once right away so that schedules missed while the service was down go live on startup
+		s.RunOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce publishes every version that is due and returns how many were published.
+func (s *PublishScheduler) RunOnce(ctx context.Context) int {
+	dueVersions, err := s.gameDao.ListDueScheduledVersions(ctx, s.now().Unix())
+	if err != nil {
+		log.Printf("publish scheduler: failed to list due versions: %v", err)
+		return 0
+	}
+
+	published := 0
+	for _, version := range dueVersions {
+		err := s.gameDao.PublishScheduledVersion(ctx, version.GameId, version.Id)
+		if err != nil {
+			// the schedule was cancelled between listing and publishing, nothing to do
+			if errors.Is(err, dao.ErrVersionNotScheduled) {
+				continue
+			}
+			log.Printf("publish scheduler: failed to publish game %d version %d: %v", version.GameId, version.Id, err)
+			continue
+		}
+		log.Printf("publish scheduler: published game %d version %d", version.GameId, version.Id)
+		if s.onPublished != nil {
+			s.onPublished(ctx, version.GameId)
+		}
+		published++
+	}
+	return published
+}
@@ -0,0 +1,56 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/dao/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPublishScheduler_RunOnce tests that every due version is published and cancelled ones are skipped
+func TestPublishScheduler_RunOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	now := time.Unix(1700000000, 0)
+
+	dueVersions := []*ddl.GpGameVersion{
+		{Id: 201, GameId: 101},
+		{Id: 202, GameId: 102},
+		{Id: 203, GameId: 103},
+	}
+	mockGameDAO.EXPECT().ListDueScheduledVersions(gomock.Any(), now.Unix()).Return(dueVersions, nil).Times(1)
+	mockGameDAO.EXPECT().PublishScheduledVersion(gomock.Any(), uint64(101), uint64(201)).Return(nil).Times(1)
+	mockGameDAO.EXPECT().PublishScheduledVersion(gomock.Any(), uint64(102), uint64(202)).Return(dao.ErrVersionNotScheduled).Times(1)
+	mockGameDAO.EXPECT().PublishScheduledVersion(gomock.Any(), uint64(103), uint64(203)).Return(nil).Times(1)
+
+	var published []uint64
+	s := NewPublishScheduler(mockGameDAO, time.Minute).OnPublished(func(_ context.Context, gameID uint64) {
+		published = append(published, gameID)
+	})
+	s.now = func() time.Time { return now }
+
+	assert.Equal(t, 2, s.RunOnce(context.Background()))
+	assert.Equal(t, []uint64{101, 103}, published)
+}
+
+// TestPublishScheduler_RunOnceListError tests that a failing query publishes nothing
+func TestPublishScheduler_RunOnceListError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameDAO := mock.NewMockIGameDAO(ctrl)
+	mockGameDAO.EXPECT().ListDueScheduledVersions(gomock.Any(), gomock.Any()).Return(nil, errors.New("database connection lost")).Times(1)
+	mockGameDAO.EXPECT().PublishScheduledVersion(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	s := NewPublishScheduler(mockGameDAO, time.Minute)
+
+	assert.Equal(t, 0, s.RunOnce(context.Background()))
+}
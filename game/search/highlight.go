@@ -0,0 +1,116 @@
+package search
+
+import (
+	"html"
+	"strings"
+)
+
+// 高亮字段名，与 IDL 中的字段名保持一致
+const (
+	HighlightFieldGameName         = "game_name"
+	HighlightFieldGameIntroduction = "game_introduction"
+	HighlightFieldPackageName      = "package_name"
+)
+
+const (
+	// snippetContext 简介摘要在第一个命中位置之前保留的字数
+	snippetContext = 20
+	// snippetLength 简介摘要的最大字数
+	snippetLength   = 100
+	snippetEllipsis = "..."
+)
+
+// highlight builds snippets for the fields of doc that contributed to the match. Pinyin and
+// initials matches are highlighted on the game name characters they were derived from.
+func highlight(doc *Document, matches []termMatch) []Highlight {
+	matched := make(map[termMatch]bool, len(matches))
+	for _, m := range matches {
+		matched[m] = true
+	}
+
+	var highlights []Highlight
+	if marks := markMatches(doc, doc.GameName, matched, fieldName, fieldPinyin, fieldInitials); marks != nil {
+		highlights = append(highlights, Highlight{
+			Field:   HighlightFieldGameName,
+			Snippet: renderSnippet([]rune(doc.GameName), marks, 0, len(marks)),
+		})
+	}
+	if marks := markMatches(doc, doc.GameIntroduction, matched, fieldIntroduction); marks != nil {
+		start, end := snippetWindow(marks)
+		highlights = append(highlights, Highlight{
+			Field:   HighlightFieldGameIntroduction,
+			Snippet: renderSnippet([]rune(doc.GameIntroduction), marks, start, end),
+		})
+	}
+	if marks := markMatches(doc, doc.PackageName, matched, fieldPackageName); marks != nil {
+		highlights = append(highlights, Highlight{
+			Field:   HighlightFieldPackageName,
+			Snippet: renderSnippet([]rune(doc.PackageName), marks, 0, len(marks)),
+		})
+	}
+	return highlights
+}
+
+// markMatches flags the runes of text covered by a matched token of any of the fields, which must
+// all be tokenized from text. It returns nil when nothing matched.
+func markMatches(doc *Document, text string, matched map[termMatch]bool, fields ...field) []bool {
+	marks := make([]bool, len([]rune(text)))
+	found := false
+	for _, f := range fields {
+		for _, tok := range tokenizeField(doc, f) {
+			if !matched[termMatch{field: f, term: tok.term}] {
+				continue
+			}
+			for i := tok.start; i < tok.end; i++ {
+				marks[i] = true
+			}
+			found = true
+		}
+	}
+	if !found {
+		return nil
+	}
+	return marks
+}
+
+// snippetWindow picks the part of a long text to show, starting a little before the first match.
+func snippetWindow(marks []bool) (start, end int) {
+	first := 0
+	for first < len(marks) && !marks[first] {
+		first++
+	}
+	start = first - snippetContext
+	if start < 0 {
+		start = 0
+	}
+	end = start + snippetLength
+	if end > len(marks) {
+		end = len(marks)
+	}
+	return start, end
+}
+
+// renderSnippet escapes runes[start:end] and wraps the marked runes in <em></em>.
+func renderSnippet(runes []rune, marks []bool, start, end int) string {
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString(snippetEllipsis)
+	}
+	for i := start; i < end; {
+		j := i
+		for j < end && marks[j] == marks[i] {
+			j++
+		}
+		segment := html.EscapeString(string(runes[i:j]))
+		if marks[i] {
+			b.WriteString("<em>" + segment + "</em>")
+		} else {
+			b.WriteString(segment)
+		}
+		i = j
+	}
+	if end < len(runes) {
+		b.WriteString(snippetEllipsis)
+	}
+	return b.String()
+}
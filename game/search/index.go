@@ -0,0 +1,375 @@
+package search
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// BM25 参数
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+
+	// prefixPenalty 前缀匹配的得分折扣，完整匹配的词排在前缀匹配前面
+	prefixPenalty = 0.8
+	// minPrefixLen 参与前缀匹配的最短查询词，单个字母展开的词项太多且没有区分度
+	minPrefixLen = 2
+	// exactNameBonus 查询与游戏名完全一致时的额外得分
+	exactNameBonus = 10.0
+)
+
+// Document is what the index knows about a game: the searchable text of its newest version and
+// the fields needed to render a search result without going back to the database.
+type Document struct {
+	GameID           uint64
+	CpID             uint64
+	GameName         string
+	GameIcon         string
+	HeaderImage      string
+	GameIntroduction string
+	PackageName      string
+	Status           int
+	CreateTime       int64
+	UpdateTime       int64
+}
+
+// Query describes a search. Zero CpID and empty Statuses do not filter.
+type Query struct {
+	Text     string
+	CpID     uint64
+	Statuses []int
+	Offset   int
+	Limit    int
+}
+
+// Highlight is a snippet of a matched field with the matched parts wrapped in <em></em>.
+// The rest of the snippet is HTML-escaped.
+type Highlight struct {
+	Field   string
+	Snippet string
+}
+
+// Hit is a matched document with its relevance score.
+type Hit struct {
+	Document   Document
+	Score      float64
+	Highlights []Highlight
+}
+
+// Result is one page of hits and the total number of matched documents.
+type Result struct {
+	Hits  []*Hit
+	Total int
+}
+
+// Index is an in-memory inverted index over game documents, optionally persisted to a file.
+// Writes only change memory; Save, or the loop started by AutoSave, writes them to the file.
+// It is safe for concurrent use.
+type Index struct {
+	mu   sync.RWMutex
+	path string
+
+	// saveMu serialises saves so that an older snapshot never renames over a newer one
+	saveMu sync.Mutex
+	// changes counts writes since Open, saved is the value of changes in the last saved snapshot
+	changes uint64
+	saved   uint64
+	// beforeWrite, when set, runs between taking a snapshot and writing it, for tests
+	beforeWrite func()
+	// stale is set by Open when the file was not left behind by Close
+	stale bool
+
+	docs map[uint64]*Document
+	// postings[field][term][gameID] = term frequency
+	postings [numFields]map[string]map[uint64]int
+	// lengths[gameID][field] = number of terms of the field, for BM25 length normalisation
+	lengths  map[uint64][numFields]int
+	totalLen [numFields]int
+}
+
+func newIndex(path string) *Index {
+	ix := &Index{
+		path:    path,
+		docs:    make(map[uint64]*Document),
+		lengths: make(map[uint64][numFields]int),
+	}
+	for f := range ix.postings {
+		ix.postings[f] = make(map[string]map[uint64]int)
+	}
+	return ix
+}
+
+// Len returns the number of indexed documents.
+func (ix *Index) Len() int {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	return len(ix.docs)
+}
+
+// Put adds a document or replaces the one with the same GameID.
+func (ix *Index) Put(doc *Document) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.remove(doc.GameID)
+	ix.add(doc)
+	ix.changes++
+}
+
+// Delete removes a document; deleting an unknown game is a no-op.
+func (ix *Index) Delete(gameID uint64) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	if _, ok := ix.docs[gameID]; !ok {
+		return
+	}
+	ix.remove(gameID)
+	ix.changes++
+}
+
+// Reset replaces the whole content of the index with docs and saves it, used by a rebuild.
+func (ix *Index) Reset(docs []*Document) error {
+	fresh := newIndex(ix.path)
+	for _, doc := range docs {
+		fresh.remove(doc.GameID)
+		fresh.add(doc)
+	}
+
+	ix.mu.Lock()
+	ix.docs, ix.postings, ix.lengths, ix.totalLen = fresh.docs, fresh.postings, fresh.lengths, fresh.totalLen
+	ix.changes++
+	ix.mu.Unlock()
+
+	return ix.Save()
+}
+
+func (ix *Index) add(doc *Document) {
+	stored := *doc
+	ix.docs[doc.GameID] = &stored
+
+	var lengths [numFields]int
+	for f := field(0); f < numFields; f++ {
+		tokens := tokenizeField(&stored, f)
+		lengths[f] = len(tokens)
+		ix.totalLen[f] += len(tokens)
+		for _, tok := range tokens {
+			docsWithTerm := ix.postings[f][tok.term]
+			if docsWithTerm == nil {
+				docsWithTerm = make(map[uint64]int)
+				ix.postings[f][tok.term] = docsWithTerm
+			}
+			docsWithTerm[doc.GameID]++
+		}
+	}
+	ix.lengths[doc.GameID] = lengths
+}
+
+// remove drops a document's postings. Tokenizing is deterministic, so the stored document tells
+// exactly which postings it added.
+func (ix *Index) remove(gameID uint64) {
+	doc, ok := ix.docs[gameID]
+	if !ok {
+		return
+	}
+	for f := field(0); f < numFields; f++ {
+		for _, tok := range tokenizeField(doc, f) {
+			docsWithTerm := ix.postings[f][tok.term]
+			delete(docsWithTerm, gameID)
+			if len(docsWithTerm) == 0 {
+				delete(ix.postings[f], tok.term)
+			}
+		}
+		ix.totalLen[f] -= ix.lengths[gameID][f]
+	}
+	delete(ix.docs, gameID)
+	delete(ix.lengths, gameID)
+}
+
+// termMatch records that a document matched an indexed term in a field, for highlighting.
+type termMatch struct {
+	field field
+	term  string
+}
+
+type docMatch struct {
+	score   float64
+	matches []termMatch
+}
+
+// Search returns the documents matching every word of the query, ordered by relevance and then
+// by update time, newest first.
+func (ix *Index) Search(q *Query) *Result {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	clauses := parseQuery(q.Text)
+	if len(clauses) == 0 {
+		return &Result{}
+	}
+
+	var candidates map[uint64]*docMatch
+	for _, c := range clauses {
+		matched := ix.matchClause(c)
+		if candidates == nil {
+			candidates = matched
+			continue
+		}
+		for gameID, m := range candidates {
+			other, ok := matched[gameID]
+			if !ok {
+				delete(candidates, gameID)
+				continue
+			}
+			m.score += other.score
+			m.matches = append(m.matches, other.matches...)
+		}
+	}
+
+	normalizedQuery := strings.TrimSpace(string(normalize(q.Text)))
+	hits := make([]*Hit, 0, len(candidates))
+	for gameID, m := range candidates {
+		doc := ix.docs[gameID]
+		if !q.accepts(doc) {
+			continue
+		}
+		score := m.score
+		if strings.TrimSpace(string(normalize(doc.GameName))) == normalizedQuery {
+			score += exactNameBonus
+		}
+		hits = append(hits, &Hit{Document: *doc, Score: score})
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		if hits[i].Document.UpdateTime != hits[j].Document.UpdateTime {
+			return hits[i].Document.UpdateTime > hits[j].Document.UpdateTime
+		}
+		return hits[i].Document.GameID > hits[j].Document.GameID
+	})
+
+	result := &Result{Total: len(hits)}
+	if q.Offset >= len(hits) {
+		return result
+	}
+	end := len(hits)
+	if q.Limit > 0 && q.Offset+q.Limit < end {
+		end = q.Offset + q.Limit
+	}
+	result.Hits = hits[q.Offset:end]
+	// highlighting re-tokenizes the matched fields, so only do it for the requested page
+	for _, hit := range result.Hits {
+		hit.Highlights = highlight(&hit.Document, candidates[hit.Document.GameID].matches)
+	}
+	return result
+}
+
+func (q *Query) accepts(doc *Document) bool {
+	if q.CpID != 0 && doc.CpID != q.CpID {
+		return false
+	}
+	if len(q.Statuses) == 0 {
+		return true
+	}
+	for _, status := range q.Statuses {
+		if doc.Status == status {
+			return true
+		}
+	}
+	return false
+}
+
+// matchClause scores the documents matching any alternative of a clause, keeping the best one.
+func (ix *Index) matchClause(c clause) map[uint64]*docMatch {
+	matched := make(map[uint64]*docMatch)
+	for _, alt := range c.alternatives {
+		for gameID, m := range ix.matchAlternative(alt) {
+			best, ok := matched[gameID]
+			if !ok {
+				matched[gameID] = m
+				continue
+			}
+			// keep every matched term for highlighting, but score by the best alternative only
+			best.matches = append(best.matches, m.matches...)
+			best.score = math.Max(best.score, m.score)
+		}
+	}
+	return matched
+}
+
+// matchAlternative scores the documents containing every term of the alternative.
+func (ix *Index) matchAlternative(alt alternative) map[uint64]*docMatch {
+	var result map[uint64]*docMatch
+	for i, term := range alt.terms {
+		prefix := alt.prefix && i == len(alt.terms)-1
+		termResult := make(map[uint64]*docMatch)
+		for _, f := range alt.fields {
+			for indexedTerm, docsWithTerm := range ix.expand(f, term, prefix) {
+				weight := 1.0
+				if indexedTerm != term {
+					weight = prefixPenalty
+				}
+				idf := ix.idf(len(docsWithTerm))
+				for gameID, tf := range docsWithTerm {
+					score := weight * fieldBoosts[f] * idf * ix.tfNorm(gameID, f, tf)
+					m, ok := termResult[gameID]
+					if !ok {
+						m = &docMatch{}
+						termResult[gameID] = m
+					}
+					m.score = math.Max(m.score, score)
+					m.matches = append(m.matches, termMatch{field: f, term: indexedTerm})
+				}
+			}
+		}
+
+		if result == nil {
+			result = termResult
+			continue
+		}
+		for gameID, m := range result {
+			other, ok := termResult[gameID]
+			if !ok {
+				delete(result, gameID)
+				continue
+			}
+			m.score += other.score
+			m.matches = append(m.matches, other.matches...)
+		}
+	}
+	return result
+}
+
+// expand returns the postings of term, or of every indexed term starting with it when prefix is set.
+func (ix *Index) expand(f field, term string, prefix bool) map[string]map[uint64]int {
+	expanded := make(map[string]map[uint64]int)
+	if docsWithTerm, ok := ix.postings[f][term]; ok {
+		expanded[term] = docsWithTerm
+	}
+	if !prefix || len(term) < minPrefixLen {
+		return expanded
+	}
+	for indexedTerm, docsWithTerm := range ix.postings[f] {
+		if indexedTerm != term && strings.HasPrefix(indexedTerm, term) {
+			expanded[indexedTerm] = docsWithTerm
+		}
+	}
+	return expanded
+}
+
+func (ix *Index) idf(docFreq int) float64 {
+	n := float64(len(ix.docs))
+	df := float64(docFreq)
+	return math.Log(1 + (n-df+0.5)/(df+0.5))
+}
+
+func (ix *Index) tfNorm(gameID uint64, f field, tf int) float64 {
+	avgLen := float64(ix.totalLen[f]) / float64(len(ix.docs))
+	docLen := float64(ix.lengths[gameID][f])
+	freq := float64(tf)
+	return freq * (bm25K1 + 1) / (freq + bm25K1*(1-bm25B+bm25B*docLen/avgLen))
+}
@@ -0,0 +1,200 @@
+package search
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestIndex(t *testing.T, path string) *Index {
+	ix, err := Open(path)
+	assert.NoError(t, err)
+	docs := []*Document{
+		{GameID: 1, CpID: 10, GameName: "王者荣耀", GameIntroduction: "5V5 英雄公平对战手游，经典 MOBA 玩法", PackageName: "com.tencent.tmgp.sgame", Status: 3, UpdateTime: 100},
+		{GameID: 2, CpID: 10, GameName: "和平精英", GameIntroduction: "战术竞技手游，百人同场对战", PackageName: "com.tencent.tmgp.pubgmhd", Status: 3, UpdateTime: 200},
+		{GameID: 3, CpID: 20, GameName: "原神", GameIntroduction: "开放世界冒险游戏", PackageName: "com.miHoYo.Yuanshen", Status: 1, UpdateTime: 300},
+		{GameID: 4, CpID: 20, GameName: "荣耀大陆", GameIntroduction: "魔幻题材的 <策略> 游戏", PackageName: "com.example.glory", Status: 3, UpdateTime: 400},
+	}
+	for _, doc := range docs {
+		ix.Put(doc)
+	}
+	return ix
+}
+
+func hitIDs(result *Result) []uint64 {
+	ids := make([]uint64, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		ids = append(ids, hit.Document.GameID)
+	}
+	return ids
+}
+
+// TestSearch_Chinese tests that Chinese queries match substrings of names and introductions
+func TestSearch_Chinese(t *testing.T) {
+	ix := newTestIndex(t, "")
+
+	// "荣耀" is equally relevant to both names, the more recently updated game comes first
+	assert.Equal(t, []uint64{4, 1}, hitIDs(ix.Search(&Query{Text: "荣耀"})))
+	assert.Equal(t, []uint64{1}, hitIDs(ix.Search(&Query{Text: "王者荣耀"})))
+
+	// words only found in the introduction
+	assert.Equal(t, []uint64{3}, hitIDs(ix.Search(&Query{Text: "开放世界"})))
+	assert.ElementsMatch(t, []uint64{1, 2}, hitIDs(ix.Search(&Query{Text: "手游 对战"})))
+	assert.Empty(t, ix.Search(&Query{Text: "赛车"}).Hits)
+}
+
+// TestSearch_Pinyin tests full pinyin, pinyin initials and search-as-you-type prefixes
+func TestSearch_Pinyin(t *testing.T) {
+	ix := newTestIndex(t, "")
+
+	assert.Equal(t, []uint64{1}, hitIDs(ix.Search(&Query{Text: "wangzherongyao"})))
+	assert.Equal(t, []uint64{2}, hitIDs(ix.Search(&Query{Text: "hepingjingying"})))
+	assert.Equal(t, []uint64{1}, hitIDs(ix.Search(&Query{Text: "wzry"})))
+	assert.Equal(t, []uint64{2}, hitIDs(ix.Search(&Query{Text: "hpjy"})))
+	assert.Equal(t, []uint64{3}, hitIDs(ix.Search(&Query{Text: "yuans"})))
+	assert.Equal(t, []uint64{2}, hitIDs(ix.Search(&Query{Text: "pubg"})))
+}
+
+// TestSearch_PackageName tests matching package names as a whole and by their parts
+func TestSearch_PackageName(t *testing.T) {
+	ix := newTestIndex(t, "")
+
+	assert.Equal(t, []uint64{1}, hitIDs(ix.Search(&Query{Text: "com.tencent.tmgp.sgame"})))
+	assert.Equal(t, []uint64{2, 1}, hitIDs(ix.Search(&Query{Text: "tencent"})))
+	assert.Equal(t, []uint64{3}, hitIDs(ix.Search(&Query{Text: "MIHOYO"})))
+}
+
+// TestSearch_FiltersAndPaging tests the cp and status filters and offset/limit paging
+func TestSearch_FiltersAndPaging(t *testing.T) {
+	ix := newTestIndex(t, "")
+
+	assert.Equal(t, []uint64{2, 1}, hitIDs(ix.Search(&Query{Text: "com", CpID: 10})))
+	assert.Equal(t, []uint64{3}, hitIDs(ix.Search(&Query{Text: "com", Statuses: []int{1}})))
+
+	page := ix.Search(&Query{Text: "游戏", Offset: 1, Limit: 1})
+	assert.Equal(t, 2, page.Total)
+	assert.Len(t, page.Hits, 1)
+	assert.Empty(t, ix.Search(&Query{Text: "游戏", Offset: 5, Limit: 1}).Hits)
+}
+
+// TestSearch_Highlights tests snippets for direct, pinyin and escaped matches
+func TestSearch_Highlights(t *testing.T) {
+	ix := newTestIndex(t, "")
+
+	hit := ix.Search(&Query{Text: "荣耀", CpID: 10}).Hits[0]
+	assert.Equal(t, []Highlight{{Field: HighlightFieldGameName, Snippet: "王者<em>荣耀</em>"}}, hit.Highlights)
+
+	hit = ix.Search(&Query{Text: "wzry"}).Hits[0]
+	assert.Equal(t, []Highlight{{Field: HighlightFieldGameName, Snippet: "<em>王者荣耀</em>"}}, hit.Highlights)
+
+	hit = ix.Search(&Query{Text: "策略"}).Hits[0]
+	assert.Equal(t, []Highlight{{Field: HighlightFieldGameIntroduction, Snippet: "魔幻题材的 &lt;<em>策略</em>&gt; 游戏"}}, hit.Highlights)
+}
+
+// TestIndex_UpdateAndDelete tests that replacing or deleting a document drops its old terms
+func TestIndex_UpdateAndDelete(t *testing.T) {
+	ix := newTestIndex(t, "")
+
+	ix.Put(&Document{GameID: 3, CpID: 20, GameName: "崩坏星穹铁道", PackageName: "com.miHoYo.hkrpg", Status: 1})
+	assert.Empty(t, ix.Search(&Query{Text: "原神"}).Hits)
+	assert.Equal(t, []uint64{3}, hitIDs(ix.Search(&Query{Text: "星穹铁道"})))
+
+	ix.Delete(3)
+	ix.Delete(3)
+	assert.Empty(t, ix.Search(&Query{Text: "星穹铁道"}).Hits)
+	assert.Equal(t, 3, ix.Len())
+}
+
+// TestIndex_Persistence tests that an index reopened from its file answers the same queries
+func TestIndex_Persistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "search", "games.idx")
+	ix := newTestIndex(t, path)
+	before := ix.Search(&Query{Text: "荣耀"})
+
+	// writes stay in memory until saved
+	reopened, err := Open(path)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, reopened.Len())
+
+	assert.NoError(t, ix.Save())
+	reopened, err = Open(path)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, reopened.Len())
+	assert.Equal(t, before, reopened.Search(&Query{Text: "荣耀"}))
+
+	assert.NoError(t, reopened.Reset([]*Document{{GameID: 9, GameName: "开心消消乐"}}))
+	reopened, err = Open(path)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{9}, hitIDs(reopened.Search(&Query{Text: "xiaoxiaole"})))
+	assert.Empty(t, reopened.Search(&Query{Text: "荣耀"}).Hits)
+}
+
+// TestIndex_SearchDuringSave tests that searches and writes go on while a save is writing the file
+func TestIndex_SearchDuringSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "games.idx")
+	ix := newTestIndex(t, path)
+
+	writing, release := make(chan struct{}), make(chan struct{})
+	ix.beforeWrite = func() {
+		close(writing)
+		<-release
+	}
+	saved := make(chan error)
+	go func() { saved <- ix.Save() }()
+	<-writing
+
+	searched := make(chan *Result)
+	go func() {
+		ix.Put(&Document{GameID: 5, GameName: "荣耀战魂"})
+		searched <- ix.Search(&Query{Text: "荣耀"})
+	}()
+	select {
+	case result := <-searched:
+		assert.ElementsMatch(t, []uint64{1, 4, 5}, hitIDs(result))
+	case <-time.After(5 * time.Second):
+		t.Fatal("search blocked by a running save")
+	}
+
+	close(release)
+	assert.NoError(t, <-saved)
+
+	// the save wrote the snapshot taken before the concurrent write, which is still pending
+	reopened, err := Open(path)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, reopened.Len())
+	ix.beforeWrite = nil
+	assert.NoError(t, ix.Save())
+	reopened, err = Open(path)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, reopened.Len())
+}
+
+// TestIndex_StaleUnlessClosed tests that only a file left behind by Close is trusted on the next Open
+func TestIndex_StaleUnlessClosed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "games.idx")
+	ix := newTestIndex(t, path)
+	assert.NoError(t, ix.Save())
+
+	// saved in the background only, as after a crash
+	reopened, err := Open(path)
+	assert.NoError(t, err)
+	assert.True(t, reopened.Stale())
+
+	assert.NoError(t, reopened.Close())
+	reopened, err = Open(path)
+	assert.NoError(t, err)
+	assert.False(t, reopened.Stale())
+	assert.Equal(t, 4, reopened.Len())
+
+	// the marker is used up by Open, a crash of this process leaves the file stale again
+	reopened, err = Open(path)
+	assert.NoError(t, err)
+	assert.True(t, reopened.Stale())
+
+	inMemory, err := Open("")
+	assert.NoError(t, err)
+	assert.False(t, inMemory.Stale())
+	assert.NoError(t, inMemory.Close())
+}
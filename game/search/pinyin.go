@@ -0,0 +1,95 @@
+package search
+
+// maxSyllableLen 最长拼音音节的长度（如 "zhuang"、"shuang"）
+const maxSyllableLen = 6
+
+var (
+	// readings 汉字 -> 读音列表，由 pinyinTable 反转得到
+	readings = make(map[rune][]string)
+	// syllables 全部合法的拼音音节
+	syllables = make(map[string]bool)
+)
+
+func init() {
+	for syllable, chars := range pinyinTable {
+		syllables[syllable] = true
+		for _, r := range chars {
+			readings[r] = append(readings[r], syllable)
+		}
+	}
+	// map iteration order is random, keep readings stable so that tokens and snapshots are deterministic
+	for r, rs := range readings {
+		sortStrings(rs)
+		readings[r] = rs
+	}
+}
+
+// pinyinOf returns the toneless readings of a Han character, or nil if it is not in the table.
+func pinyinOf(r rune) []string {
+	return readings[r]
+}
+
+// initialsOf returns the distinct first letters of a Han character's readings.
+func initialsOf(r rune) []byte {
+	var initials []byte
+	for _, reading := range readings[r] {
+		initial := reading[0]
+		seen := false
+		for _, existing := range initials {
+			if existing == initial {
+				seen = true
+				break
+			}
+		}
+		if !seen {
+			initials = append(initials, initial)
+		}
+	}
+	return initials
+}
+
+// segmentPinyin splits a run of latin letters into pinyin syllables, e.g. "wangzhe" -> [wang zhe].
+// It prefers the longest syllable at each position and backtracks when the rest cannot be split,
+// so "xian" stays one syllable while "xianggang" becomes [xiang gang]. ok is false when the
+// word is not made entirely of syllables.
+func segmentPinyin(word string) (parts []string, ok bool) {
+	if word == "" {
+		return nil, false
+	}
+	// memo[i] records that word[i:] cannot be segmented
+	failed := make([]bool, len(word)+1)
+	var walk func(start int) bool
+	walk = func(start int) bool {
+		if start == len(word) {
+			return true
+		}
+		if failed[start] {
+			return false
+		}
+		for size := maxSyllableLen; size >= 1; size-- {
+			end := start + size
+			if end > len(word) || !syllables[word[start:end]] {
+				continue
+			}
+			parts = append(parts, word[start:end])
+			if walk(end) {
+				return true
+			}
+			parts = parts[:len(parts)-1]
+		}
+		failed[start] = true
+		return false
+	}
+	if !walk(0) {
+		return nil, false
+	}
+	return parts, true
+}
+
+func sortStrings(values []string) {
+	for i := 1; i < len(values); i++ {
+		for j := i; j > 0 && values[j] < values[j-1]; j-- {
+			values[j], values[j-1] = values[j-1], values[j]
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package search
+
+import "strings"
+
+// alternative is one way a query word can match: every term must be found in one of the fields.
+type alternative struct {
+	fields []field
+	terms  []string
+	// prefix lets the last term match any indexed term it is a prefix of, for search-as-you-type
+	prefix bool
+}
+
+// clause is one word of the query. A document matches a clause when any alternative matches,
+// and must match every clause of the query.
+type clause struct {
+	alternatives []alternative
+}
+
+// parseQuery turns the query text into clauses. A Chinese run is matched by its bigrams (or the
+// single character), a latin word by itself, by the pinyin syllables it spells, or by the
+// initials of a game name.
+func parseQuery(text string) []clause {
+	runes := normalize(text)
+	runs := splitRuns(runes)
+	var clauses []clause
+	for i, rn := range runs {
+		last := i == len(runs)-1
+		if rn.han {
+			var terms []string
+			if rn.end-rn.start == 1 {
+				terms = []string{string(runes[rn.start])}
+			}
+			for j := rn.start; j+1 < rn.end; j++ {
+				terms = append(terms, string(runes[j:j+2]))
+			}
+			clauses = append(clauses, clause{alternatives: []alternative{{fields: textFields, terms: terms}}})
+			continue
+		}
+
+		word := string(runes[rn.start:rn.end])
+		c := clause{alternatives: []alternative{{fields: textFields, terms: []string{word}, prefix: last}}}
+		if parts, ok := segmentPinyin(word); ok {
+			terms := parts
+			if len(parts) > 1 {
+				terms = nil
+				for j := 0; j+1 < len(parts); j++ {
+					terms = append(terms, parts[j]+parts[j+1])
+				}
+			}
+			c.alternatives = append(c.alternatives, alternative{fields: []field{fieldPinyin}, terms: terms})
+		}
+		if len(word) >= 2 && len(word) <= maxInitialsLen && isLowerASCII(word) {
+			c.alternatives = append(c.alternatives, alternative{fields: []field{fieldInitials}, terms: []string{word}, prefix: last})
+		}
+		clauses = append(clauses, c)
+	}
+	return clauses
+}
+
+func isLowerASCII(word string) bool {
+	return strings.Trim(word, "abcdefghijklmnopqrstuvwxyz") == ""
+}
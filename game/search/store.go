@@ -0,0 +1,211 @@
+package search
+
+import (
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// snapshotVersion 索引文件格式版本。分词规则变化时递增，旧版本的文件会按文档重新建立倒排表。
+const snapshotVersion = 1
+
+// snapshot is the on-disk form of an Index.
+type snapshot struct {
+	Version  int
+	Docs     map[uint64]*Document
+	Postings [numFields]map[string]map[uint64]int
+	Lengths  map[uint64][numFields]int
+}
+
+// cleanSuffix names the marker file Close leaves next to the index file.
+const cleanSuffix = ".clean"
+
+// Open loads the index persisted at path, or returns an empty index if the file does not exist
+// yet. Save writes the index back to path. An empty path keeps the index in memory only.
+func Open(path string) (*Index, error) {
+	ix := newIndex(path)
+	if path == "" {
+		return ix, nil
+	}
+
+	// the marker is consumed here: from now on the file may fall behind memory again until Close
+	ix.stale = true
+	err := os.Remove(path + cleanSuffix)
+	if err == nil {
+		ix.stale = false
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return ix, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var snap snapshot
+	if err := gob.NewDecoder(file).Decode(&snap); err != nil {
+		return nil, fmt.Errorf("failed to decode search index %s: %w", path, err)
+	}
+
+	if snap.Version != snapshotVersion {
+		// the documents are still good, only the terms they produce changed
+		for _, doc := range snap.Docs {
+			ix.add(doc)
+		}
+		return ix, nil
+	}
+
+	ix.docs = snap.Docs
+	ix.lengths = snap.Lengths
+	for f := field(0); f < numFields; f++ {
+		if snap.Postings[f] != nil {
+			ix.postings[f] = snap.Postings[f]
+		}
+	}
+	if ix.docs == nil {
+		ix.docs = make(map[uint64]*Document)
+	}
+	if ix.lengths == nil {
+		ix.lengths = make(map[uint64][numFields]int)
+	}
+	for _, lengths := range ix.lengths {
+		for f := range lengths {
+			ix.totalLen[f] += lengths[f]
+		}
+	}
+	return ix, nil
+}
+
+// AutoSave saves the index every interval until ctx is done, and once more on the way out so
+// that writes made since the last tick are not lost on a clean shutdown.
+func (ix *Index) AutoSave(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				if err := ix.Save(); err != nil {
+					log.Printf("search index: failed to save: %v", err)
+				}
+				return
+			case <-ticker.C:
+				if err := ix.Save(); err != nil {
+					log.Printf("search index: failed to save: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// Stale reports whether the file the index was opened from may miss writes: it was saved in the
+// background rather than by Close, so the process that wrote it may have died with unsaved changes.
+func (ix *Index) Stale() bool {
+	return ix.stale
+}
+
+// Close saves the index and marks its file as complete, so that the next Open does not report it as
+// stale. The index must not be written to afterwards.
+func (ix *Index) Close() error {
+	if ix.path == "" {
+		return nil
+	}
+	if err := ix.Save(); err != nil {
+		return err
+	}
+	return os.WriteFile(ix.path+cleanSuffix, nil, 0o644)
+}
+
+// Save writes the index to its file if it changed since the last save. The snapshot is copied
+// under the read lock and encoded after releasing it, so searches and writes are never held up
+// by the disk.
+func (ix *Index) Save() error {
+	if ix.path == "" {
+		return nil
+	}
+	ix.saveMu.Lock()
+	defer ix.saveMu.Unlock()
+
+	ix.mu.RLock()
+	if ix.changes == ix.saved {
+		ix.mu.RUnlock()
+		return nil
+	}
+	snap, changes := ix.snapshot(), ix.changes
+	ix.mu.RUnlock()
+
+	if ix.beforeWrite != nil {
+		ix.beforeWrite()
+	}
+	if err := writeSnapshot(ix.path, snap); err != nil {
+		return err
+	}
+
+	ix.mu.Lock()
+	ix.saved = changes
+	ix.mu.Unlock()
+	return nil
+}
+
+// snapshot copies the maps that add and remove change in place. Stored documents are never
+// modified, only replaced, so they are shared with the copy. Callers hold the read lock.
+func (ix *Index) snapshot() *snapshot {
+	snap := &snapshot{
+		Version: snapshotVersion,
+		Docs:    make(map[uint64]*Document, len(ix.docs)),
+		Lengths: make(map[uint64][numFields]int, len(ix.lengths)),
+	}
+	for gameID, doc := range ix.docs {
+		snap.Docs[gameID] = doc
+	}
+	for gameID, lengths := range ix.lengths {
+		snap.Lengths[gameID] = lengths
+	}
+	for f := range ix.postings {
+		snap.Postings[f] = make(map[string]map[uint64]int, len(ix.postings[f]))
+		for term, docsWithTerm := range ix.postings[f] {
+			copied := make(map[uint64]int, len(docsWithTerm))
+			for gameID, freq := range docsWithTerm {
+				copied[gameID] = freq
+			}
+			snap.Postings[f][term] = copied
+		}
+	}
+	return snap
+}
+
+// writeSnapshot writes snap to a temporary file and renames it over path, so a crash while
+// saving never leaves a truncated index behind.
+func writeSnapshot(path string, snap *snapshot) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := gob.NewEncoder(tmp).Encode(snap); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to encode search index: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,207 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// field 词项所在的字段
+type field uint8
+
+const (
+	fieldName         field = iota // 游戏名
+	fieldIntroduction              // 游戏简介
+	fieldPackageName               // 包名
+	fieldPinyin                    // 游戏名的全拼
+	fieldInitials                  // 游戏名的拼音首字母
+	numFields
+)
+
+// fieldBoosts 各字段的权重：命中游戏名比命中简介更相关
+var fieldBoosts = [numFields]float64{
+	fieldName:         3.0,
+	fieldIntroduction: 1.0,
+	fieldPackageName:  2.0,
+	fieldPinyin:       2.0,
+	fieldInitials:     1.5,
+}
+
+// textFields 中文与英文单词查询匹配的字段
+var textFields = []field{fieldName, fieldIntroduction, fieldPackageName}
+
+const (
+	// maxInitialsLen 首字母词项的最大长度，游戏名中超过这个长度的汉字串只索引其子串
+	maxInitialsLen = 8
+	// maxReadingCombos 多音字组合展开的上限，防止生僻名字产生过多词项
+	maxReadingCombos = 8
+)
+
+// token is a term together with the rune span [start, end) of the original text it came from,
+// the span is what highlighting wraps.
+type token struct {
+	term       string
+	start, end int
+}
+
+// normalize lower-cases text and folds full-width ASCII to half-width. It maps rune to rune,
+// so offsets into the result are offsets into the original text as well.
+func normalize(text string) []rune {
+	runes := []rune(text)
+	for i, r := range runes {
+		switch {
+		case r >= 0xFF01 && r <= 0xFF5E:
+			r -= 0xFEE0
+		case r == 0x3000:
+			r = ' '
+		}
+		runes[i] = unicode.ToLower(r)
+	}
+	return runes
+}
+
+func isHan(r rune) bool {
+	return unicode.Is(unicode.Han, r)
+}
+
+func isWordRune(r rune) bool {
+	return (unicode.IsLetter(r) || unicode.IsDigit(r)) && !isHan(r)
+}
+
+// run is a maximal stretch of Han characters or of letters and digits.
+type run struct {
+	han        bool
+	start, end int
+}
+
+func splitRuns(runes []rune) []run {
+	var runs []run
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		if !isHan(r) && !isWordRune(r) {
+			i++
+			continue
+		}
+		han := isHan(r)
+		start := i
+		for i < len(runes) && isHan(runes[i]) == han && (han || isWordRune(runes[i])) {
+			i++
+		}
+		runs = append(runs, run{han: han, start: start, end: i})
+	}
+	return runs
+}
+
+// tokenizeText splits free text into terms. Latin letters and digits form words; Chinese has no
+// word boundaries, so every Han character is indexed on its own and together with its
+// neighbour (unigrams + bigrams), which lets a query match any substring without a dictionary.
+func tokenizeText(text string) []token {
+	runes := normalize(text)
+	var tokens []token
+	for _, rn := range splitRuns(runes) {
+		if !rn.han {
+			tokens = append(tokens, token{term: string(runes[rn.start:rn.end]), start: rn.start, end: rn.end})
+			continue
+		}
+		for i := rn.start; i < rn.end; i++ {
+			tokens = append(tokens, token{term: string(runes[i]), start: i, end: i + 1})
+			if i+1 < rn.end {
+				tokens = append(tokens, token{term: string(runes[i : i+2]), start: i, end: i + 2})
+			}
+		}
+	}
+	return tokens
+}
+
+// tokenizePackageName indexes the parts of a package name and the package name as a whole,
+// so both "tencent" and "com.tencent.tmgp.sgame" find it.
+func tokenizePackageName(packageName string) []token {
+	tokens := tokenizeText(packageName)
+	whole := strings.TrimSpace(string(normalize(packageName)))
+	if whole != "" && len(tokens) > 1 {
+		tokens = append(tokens, token{term: whole, start: 0, end: len([]rune(packageName))})
+	}
+	return tokens
+}
+
+// tokenizePinyin turns the Han characters of a game name into pinyin syllables and syllable
+// bigrams ("王者荣耀" -> wang, wangzhe, zhe, zherong, ...). Polyphonic characters contribute
+// every reading.
+func tokenizePinyin(name string) []token {
+	runes := normalize(name)
+	var tokens []token
+	for _, rn := range splitRuns(runes) {
+		if !rn.han {
+			continue
+		}
+		for i := rn.start; i < rn.end; i++ {
+			current := pinyinOf(runes[i])
+			for _, syllable := range current {
+				tokens = append(tokens, token{term: syllable, start: i, end: i + 1})
+			}
+			if i+1 >= rn.end {
+				continue
+			}
+			next := pinyinOf(runes[i+1])
+			for _, a := range current {
+				for _, b := range next {
+					tokens = append(tokens, token{term: a + b, start: i, end: i + 2})
+				}
+			}
+		}
+	}
+	return tokens
+}
+
+// tokenizeInitials indexes the pinyin initials of every substring of at least two characters of
+// each Han run in a game name, so "wzry" and "ry" both find "王者荣耀".
+func tokenizeInitials(name string) []token {
+	runes := normalize(name)
+	var tokens []token
+	for _, rn := range splitRuns(runes) {
+		if !rn.han {
+			continue
+		}
+		for i := rn.start; i < rn.end; i++ {
+			prefixes := []string{""}
+			for j := i; j < rn.end && j-i < maxInitialsLen; j++ {
+				initials := initialsOf(runes[j])
+				if len(initials) == 0 {
+					break
+				}
+				var extended []string
+				for _, prefix := range prefixes {
+					for _, initial := range initials {
+						if len(extended) < maxReadingCombos {
+							extended = append(extended, prefix+string(initial))
+						}
+					}
+				}
+				prefixes = extended
+				if j == i {
+					continue
+				}
+				for _, term := range prefixes {
+					tokens = append(tokens, token{term: term, start: i, end: j + 1})
+				}
+			}
+		}
+	}
+	return tokens
+}
+
+// tokenizeField tokenizes a document field.
+func tokenizeField(doc *Document, f field) []token {
+	switch f {
+	case fieldName:
+		return tokenizeText(doc.GameName)
+	case fieldIntroduction:
+		return tokenizeText(doc.GameIntroduction)
+	case fieldPackageName:
+		return tokenizePackageName(doc.PackageName)
+	case fieldPinyin:
+		return tokenizePinyin(doc.GameName)
+	case fieldInitials:
+		return tokenizeInitials(doc.GameName)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func terms(tokens []token) []string {
+	result := make([]string, 0, len(tokens))
+	for _, tok := range tokens {
+		result = append(result, tok.term)
+	}
+	return result
+}
+
+// TestTokenizeText tests unigram/bigram splitting of Chinese and word splitting of latin text
+func TestTokenizeText(t *testing.T) {
+	assert.Equal(t, []string{"原", "原神", "神", "v2", "pc"}, terms(tokenizeText("原神 V2.ＰＣ")))
+	assert.Equal(t, []string{"com", "tencent", "sgame", "com.tencent.sgame"}, terms(tokenizePackageName("com.tencent.sgame")))
+}
+
+// TestTokenizePinyin tests syllables, syllable bigrams and every reading of polyphonic characters
+func TestTokenizePinyin(t *testing.T) {
+	assert.Equal(t, []string{"yuan", "yuanshen", "shen"}, terms(tokenizePinyin("原神")))
+	assert.ElementsMatch(t, []string{"kuai", "kuaile", "kuaiyue", "le", "yue"}, terms(tokenizePinyin("快乐")))
+	assert.ElementsMatch(t, []string{"yd", "ydm", "dm"}, terms(tokenizeInitials("原动面")))
+}
+
+// TestSegmentPinyin tests splitting latin words into pinyin syllables
+func TestSegmentPinyin(t *testing.T) {
+	parts, ok := segmentPinyin("xianggang")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"xiang", "gang"}, parts)
+
+	parts, ok = segmentPinyin("xian")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"xian"}, parts)
+
+	_, ok = segmentPinyin("pubg")
+	assert.False(t, ok)
+}
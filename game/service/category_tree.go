@@ -0,0 +1,33 @@
+package service
+
+import (
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+)
+
+// CategorySubtreeIDs returns rootID followed by the IDs of all its descendants, or nil when rootID is not
+// one of categories.
+func CategorySubtreeIDs(categories []*ddl.GpCategory, rootID uint64) []uint64 {
+	children := make(map[uint64][]uint64, len(categories))
+	found := false
+	for _, category := range categories {
+		if category.Id == rootID {
+			found = true
+		}
+		children[category.ParentId] = append(children[category.ParentId], category.Id)
+	}
+	if !found {
+		return nil
+	}
+
+	ids := []uint64{rootID}
+	visited := map[uint64]bool{rootID: true}
+	for i := 0; i < len(ids); i++ {
+		for _, child := range children[ids[i]] {
+			if !visited[child] {
+				visited[child] = true
+				ids = append(ids, child)
+			}
+		}
+	}
+	return ids
+}
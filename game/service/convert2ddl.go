@@ -3,12 +3,48 @@ package service
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/GameLaunchPad/game_management_project/game/apk"
 	"github.com/GameLaunchPad/game_management_project/game/dao"
 	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
 	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/locale"
+	"github.com/GameLaunchPad/game_management_project/game/validation"
 )
 
+// localizedListingJSON is a listing as stored in gp_game_version.listings.
+type localizedListingJSON struct {
+	GameName               string   `json:"game_name,omitempty"`
+	GameIntroduction       string   `json:"game_introduction,omitempty"`
+	GameIntroductionImages []string `json:"game_introduction_images,omitempty"`
+	HeaderImage            string   `json:"header_image,omitempty"`
+	ReleaseNotes           string   `json:"release_notes,omitempty"`
+}
+
+// mediaItemJSON is a gallery item as stored in gp_game_version.media.
+type mediaItemJSON struct {
+	Type         int    `json:"type"`
+	URI          string `json:"uri"`
+	ThumbnailURI string `json:"thumbnail_uri,omitempty"`
+	Orientation  int    `json:"orientation,omitempty"`
+	AspectRatio  string `json:"aspect_ratio,omitempty"`
+	Caption      string `json:"caption,omitempty"`
+	Position     int32  `json:"position"`
+}
+
+// apkManifestJSON is an APK inspection as stored in gp_game_version.apk_manifest.
+type apkManifestJSON struct {
+	PackageName       string `json:"package_name"`
+	VersionCode       int64  `json:"version_code"`
+	VersionName       string `json:"version_name"`
+	MinSdkVersion     int32  `json:"min_sdk_version"`
+	CertificateSHA256 string `json:"certificate_sha256"`
+	InspectTime       int64  `json:"inspect_time"`
+}
+
 func ConvertGameVersionToDdl(version *game.GameVersion) (*ddl.GpGameVersion, error) {
 	if version == nil {
 		return nil, fmt.Errorf("game version is nil")
@@ -18,10 +54,36 @@ func ConvertGameVersionToDdl(version *game.GameVersion) (*ddl.GpGameVersion, err
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal game platforms: %v", err)
 	}
-	images, err := json.Marshal(version.GameIntroductionImages)
+	media, screenshots, err := marshalMedia(version.Media)
+	if err != nil {
+		return nil, err
+	}
+	// the gallery replaces the bare image list, which keeps its screenshots for readers that only know it
+	introductionImages := version.GameIntroductionImages
+	if media != "" {
+		introductionImages = screenshots
+	}
+	images, err := json.Marshal(introductionImages)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal game introduction images: %v", err)
 	}
+	tagIDs := version.TagIDs
+	if tagIDs == nil {
+		// tag_ids is searched with JSON_CONTAINS, keep it a JSON array
+		tagIDs = []int64{}
+	}
+	tags, err := json.Marshal(tagIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tag ids: %v", err)
+	}
+	defaultLocale, listings, err := marshalListings(version)
+	if err != nil {
+		return nil, err
+	}
+	builds, err := marshalBuilds(version.Builds)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ddl.GpGameVersion{
 		GameName:               version.GameName,
@@ -32,6 +94,13 @@ func ConvertGameVersionToDdl(version *game.GameVersion) (*ddl.GpGameVersion, err
 		Platform:               string(platforms),
 		PackageName:            version.PackageName,
 		DownloadUrl:            version.DownloadURL,
+		Builds:                 builds,
+		CategoryId:             uint64(version.CategoryID),
+		TagIds:                 string(tags),
+		DefaultLocale:          defaultLocale,
+		Listings:               listings,
+		ReleaseNotes:           version.ReleaseNotes,
+		Media:                  media,
 		Status:                 int(version.GameStatus),
 	}, nil
 }
@@ -40,15 +109,25 @@ func ConvertDdlToBriefGame(gameWithStatus *dao.GameWithVersionStatus) (*game.Bri
 	if gameWithStatus == nil {
 		return nil, fmt.Errorf("game is nil")
 	}
+	var platforms []game.GamePlatform
+	if gameWithStatus.Platform != "" {
+		if err := json.Unmarshal([]byte(gameWithStatus.Platform), &platforms); err != nil {
+			return nil, fmt.Errorf("failed to parse platforms of game %d: %w", gameWithStatus.Id, err)
+		}
+	}
 	return &game.BriefGame{
-		GameID:      int64(gameWithStatus.Id),
-		CpID:        int64(gameWithStatus.CpId),
-		GameName:    gameWithStatus.GameName,
-		GameIcon:    gameWithStatus.GameIcon,
-		HeaderImage: gameWithStatus.HeaderImage,
-		CreateTime:  gameWithStatus.CreateTs.Unix(),
-		UpdateTime:  gameWithStatus.ModifyTs.Unix(),
-		GameStatus:  game.GameStatus(gameWithStatus.Status),
+		GameID:           int64(gameWithStatus.Id),
+		CpID:             int64(gameWithStatus.CpId),
+		GameName:         gameWithStatus.GameName,
+		GameIcon:         gameWithStatus.GameIcon,
+		HeaderImage:      gameWithStatus.HeaderImage,
+		CreateTime:       gameWithStatus.CreateTs.Unix(),
+		UpdateTime:       gameWithStatus.ModifyTs.Unix(),
+		GameStatus:       game.GameStatus(gameWithStatus.Status),
+		GameIntroduction: gameWithStatus.GameIntroduction,
+		GamePlatforms:    platforms,
+		PackageName:      gameWithStatus.PackageName,
+		DownloadURL:      gameWithStatus.DownloadUrl,
 	}, nil
 }
 
@@ -75,6 +154,7 @@ func ConvertDdlToDetailGame(gameDdl *ddl.GpGame, newestVersionDdl *ddl.GpGameVer
 		OnlineGameVersion:  onlineVersion,
 		CreateTime:         gameDdl.CreateTs.Unix(),
 		ModifyTime:         gameDdl.ModifyTs.Unix(),
+		Rollout:            ConvertDdlToGameRollout(gameDdl),
 	}, nil
 }
 
@@ -98,6 +178,50 @@ func ConvertDdlToGameVersion(versionDdl *ddl.GpGameVersion) (*game.GameVersion,
 		}
 	}
 
+	media, err := unmarshalMedia(versionDdl, images)
+	if err != nil {
+		return nil, err
+	}
+
+	tagIDs, err := dao.ParseTagIDs(versionDdl)
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]int64, 0, len(tagIDs))
+	for _, tagID := range tagIDs {
+		tags = append(tags, int64(tagID))
+	}
+
+	listings, err := unmarshalListings(versionDdl)
+	if err != nil {
+		return nil, err
+	}
+	buildDdls, err := dao.ParseBuilds(versionDdl)
+	if err != nil {
+		return nil, err
+	}
+	apkManifest, err := unmarshalApkManifest(versionDdl)
+	if err != nil {
+		return nil, err
+	}
+	builds := make([]*game.PlatformBuild, 0, len(buildDdls))
+	for _, build := range buildDdls {
+		builds = append(builds, &game.PlatformBuild{
+			Platform:     game.GamePlatform(build.Platform),
+			Identifier:   build.Identifier,
+			VersionName:  build.VersionName,
+			VersionCode:  build.VersionCode,
+			DownloadURL:  build.DownloadURL,
+			MinOSVersion: build.MinOSVersion,
+			FileSize:     build.FileSize,
+			SHA256:       build.SHA256,
+		})
+	}
+	defaultLocale := versionDdl.DefaultLocale
+	if defaultLocale == "" {
+		defaultLocale = locale.Default
+	}
+
 	return &game.GameVersion{
 		GameID:                 int64(versionDdl.GameId),
 		GamVersionID:           int64(versionDdl.Id),
@@ -109,10 +233,377 @@ func ConvertDdlToGameVersion(versionDdl *ddl.GpGameVersion) (*game.GameVersion,
 		GamePlatforms:          platforms,
 		PackageName:            versionDdl.PackageName,
 		DownloadURL:            versionDdl.DownloadUrl,
+		Builds:                 builds,
+		ApkManifest:            apkManifest,
 		GameStatus:             game.GameStatus(versionDdl.Status),
 		ReviewComment:          versionDdl.ReviewComment,
 		ReviewTime:             versionDdl.ReviewTime,
 		CreateTime:             versionDdl.CreateTs.Unix(),
 		UpdateTime:             versionDdl.ModifyTs.Unix(),
+		PublishAt:              versionDdl.PublishAt,
+		Operator:               versionDdl.Operator,
+		Revision:               versionDdl.Revision,
+		CategoryID:             int64(versionDdl.CategoryId),
+		TagIDs:                 tags,
+		DefaultLocale:          defaultLocale,
+		Listings:               listings,
+		ReleaseNotes:           versionDdl.ReleaseNotes,
+		Media:                  media,
+	}, nil
+}
+
+// marshalListings returns the normalized default locale of version and its other listings as stored in
+// gp_game_version, keyed by normalized locale. An empty column means the version has no other listing.
+func marshalListings(version *game.GameVersion) (string, string, error) {
+	defaultLocale := locale.Default
+	if version.DefaultLocale != "" {
+		normalized, ok := locale.Normalize(version.DefaultLocale)
+		if !ok {
+			return "", "", fmt.Errorf("invalid default locale %q", version.DefaultLocale)
+		}
+		defaultLocale = normalized
+	}
+	if len(version.Listings) == 0 {
+		return defaultLocale, "", nil
+	}
+
+	listings := make(map[string]*localizedListingJSON, len(version.Listings))
+	for tag, listing := range version.Listings {
+		normalized, ok := locale.Normalize(tag)
+		if !ok {
+			return "", "", fmt.Errorf("invalid locale %q", tag)
+		}
+		if listing == nil {
+			listing = &game.LocalizedListing{}
+		}
+		listings[normalized] = &localizedListingJSON{
+			GameName:               listing.GameName,
+			GameIntroduction:       listing.GameIntroduction,
+			GameIntroductionImages: listing.GameIntroductionImages,
+			HeaderImage:            listing.HeaderImage,
+			ReleaseNotes:           listing.ReleaseNotes,
+		}
+	}
+	data, err := json.Marshal(listings)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal listings: %v", err)
+	}
+	return defaultLocale, string(data), nil
+}
+
+// marshalBuilds returns the builds column of a version, empty for a version without builds.
+func marshalBuilds(builds []*game.PlatformBuild) (string, error) {
+	if len(builds) == 0 {
+		return "", nil
+	}
+	buildDdls := make([]*dao.PlatformBuild, 0, len(builds))
+	for _, build := range builds {
+		if build == nil {
+			continue
+		}
+		buildDdls = append(buildDdls, &dao.PlatformBuild{
+			Platform:     int(build.Platform),
+			Identifier:   build.Identifier,
+			VersionName:  build.VersionName,
+			VersionCode:  build.VersionCode,
+			DownloadURL:  build.DownloadURL,
+			MinOSVersion: build.MinOSVersion,
+			FileSize:     build.FileSize,
+			SHA256:       strings.ToLower(build.SHA256),
+		})
+	}
+	data, err := json.Marshal(buildDdls)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal builds: %v", err)
+	}
+	return string(data), nil
+}
+
+// marshalMedia returns the gallery as stored in gp_game_version, ordered by position, and the URIs of its
+// screenshots in that order. An empty gallery is stored as an empty column.
+func marshalMedia(items []*game.MediaItem) (string, []string, error) {
+	itemDdls := make([]*mediaItemJSON, 0, len(items))
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		itemDdls = append(itemDdls, &mediaItemJSON{
+			Type:         int(item.Type),
+			URI:          item.URI,
+			ThumbnailURI: item.ThumbnailURI,
+			Orientation:  int(item.Orientation),
+			AspectRatio:  item.AspectRatio,
+			Caption:      item.Caption,
+			Position:     item.Position,
+		})
+	}
+	if len(itemDdls) == 0 {
+		return "", nil, nil
+	}
+	sort.SliceStable(itemDdls, func(i, j int) bool { return itemDdls[i].Position < itemDdls[j].Position })
+
+	screenshots := make([]string, 0, len(itemDdls))
+	for _, item := range itemDdls {
+		if item.Type == int(game.MediaType_Screenshot) {
+			screenshots = append(screenshots, item.URI)
+		}
+	}
+	data, err := json.Marshal(itemDdls)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to marshal media: %v", err)
+	}
+	return string(data), screenshots, nil
+}
+
+// unmarshalMedia parses the media column of a version, ordered by position. Versions written before the
+// gallery existed have an empty column; their introduction images, already parsed into images, become
+// screenshots in list order.
+func unmarshalMedia(versionDdl *ddl.GpGameVersion, images []string) ([]*game.MediaItem, error) {
+	if versionDdl.Media == "" {
+		media := make([]*game.MediaItem, 0, len(images))
+		for i, image := range images {
+			media = append(media, &game.MediaItem{Type: game.MediaType_Screenshot, URI: image, Position: int32(i)})
+		}
+		return media, nil
+	}
+
+	var stored []*mediaItemJSON
+	if err := json.Unmarshal([]byte(versionDdl.Media), &stored); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal media for version ID %d: %w", versionDdl.Id, err)
+	}
+	media := make([]*game.MediaItem, 0, len(stored))
+	for _, item := range stored {
+		if item == nil {
+			continue
+		}
+		media = append(media, &game.MediaItem{
+			Type:         game.MediaType(item.Type),
+			URI:          item.URI,
+			ThumbnailURI: item.ThumbnailURI,
+			Orientation:  game.MediaOrientation(item.Orientation),
+			AspectRatio:  item.AspectRatio,
+			Caption:      item.Caption,
+			Position:     item.Position,
+		})
+	}
+	sort.SliceStable(media, func(i, j int) bool { return media[i].Position < media[j].Position })
+	return media, nil
+}
+
+// ConvertApkManifestToDdl returns the apk_manifest column for an APK inspected at inspectTime.
+func ConvertApkManifestToDdl(manifest *apk.Manifest, inspectTime time.Time) (string, error) {
+	data, err := json.Marshal(&apkManifestJSON{
+		PackageName:       manifest.PackageName,
+		VersionCode:       manifest.VersionCode,
+		VersionName:       manifest.VersionName,
+		MinSdkVersion:     manifest.MinSdkVersion,
+		CertificateSHA256: manifest.CertificateSHA256,
+		InspectTime:       inspectTime.Unix(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal apk manifest: %v", err)
+	}
+	return string(data), nil
+}
+
+// unmarshalApkManifest parses the apk_manifest column of a version, which is empty when no APK was inspected.
+func unmarshalApkManifest(versionDdl *ddl.GpGameVersion) (*game.ApkManifest, error) {
+	if versionDdl.ApkManifest == "" {
+		return nil, nil
+	}
+	var stored apkManifestJSON
+	if err := json.Unmarshal([]byte(versionDdl.ApkManifest), &stored); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal apk manifest for version ID %d: %w", versionDdl.Id, err)
+	}
+	return &game.ApkManifest{
+		PackageName:       stored.PackageName,
+		VersionCode:       stored.VersionCode,
+		VersionName:       stored.VersionName,
+		MinSdkVersion:     stored.MinSdkVersion,
+		CertificateSHA256: stored.CertificateSHA256,
+		InspectTime:       stored.InspectTime,
 	}, nil
 }
+
+// unmarshalListings parses the listings column of a version.
+func unmarshalListings(versionDdl *ddl.GpGameVersion) (map[string]*game.LocalizedListing, error) {
+	listings := make(map[string]*game.LocalizedListing)
+	if versionDdl.Listings == "" {
+		return listings, nil
+	}
+	var stored map[string]*localizedListingJSON
+	if err := json.Unmarshal([]byte(versionDdl.Listings), &stored); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal listings for version ID %d: %w", versionDdl.Id, err)
+	}
+	for tag, listing := range stored {
+		if listing == nil {
+			continue
+		}
+		listings[tag] = &game.LocalizedListing{
+			GameName:               listing.GameName,
+			GameIntroduction:       listing.GameIntroduction,
+			GameIntroductionImages: listing.GameIntroductionImages,
+			HeaderImage:            listing.HeaderImage,
+			ReleaseNotes:           listing.ReleaseNotes,
+		}
+	}
+	return listings, nil
+}
+
+// ConvertDdlToGameVersionList converts a list of GORM models to GameVersion structures, keeping their order.
+func ConvertDdlToGameVersionList(versionDdls []*ddl.GpGameVersion) ([]*game.GameVersion, error) {
+	versions := make([]*game.GameVersion, 0, len(versionDdls))
+	for _, versionDdl := range versionDdls {
+		version, err := ConvertDdlToGameVersion(versionDdl)
+		if err != nil {
+			return nil, err
+		}
+		if version != nil {
+			versions = append(versions, version)
+		}
+	}
+	return versions, nil
+}
+
+// ConvertDdlToChangelogEntry converts a published or taken-down version to its changelog entry. Locales
+// whose listing has no release notes are left out, they fall back to the default locale.
+func ConvertDdlToChangelogEntry(versionDdl *ddl.GpGameVersion) (*game.ChangelogEntry, error) {
+	listings, err := unmarshalListings(versionDdl)
+	if err != nil {
+		return nil, err
+	}
+	localized := make(map[string]string, len(listings))
+	for tag, listing := range listings {
+		if listing.ReleaseNotes != "" {
+			localized[tag] = listing.ReleaseNotes
+		}
+	}
+	defaultLocale := versionDdl.DefaultLocale
+	if defaultLocale == "" {
+		defaultLocale = locale.Default
+	}
+	// a scheduled version went live at its publish time, any other one when it was approved
+	publishTime := versionDdl.ReviewTime
+	if versionDdl.PublishAt != 0 {
+		publishTime = versionDdl.PublishAt
+	}
+	return &game.ChangelogEntry{
+		GameVersionID:         int64(versionDdl.Id),
+		GameStatus:            game.GameStatus(versionDdl.Status),
+		PublishTime:           publishTime,
+		DefaultLocale:         defaultLocale,
+		ReleaseNotes:          versionDdl.ReleaseNotes,
+		LocalizedReleaseNotes: localized,
+	}, nil
+}
+
+// ConvertDdlToDeletedGameDraft converts a deleted draft to a trash entry; it expires retention after its deletion.
+func ConvertDdlToDeletedGameDraft(versionDdl *ddl.GpGameVersion, retention time.Duration) (*game.DeletedGameDraft, error) {
+	version, err := ConvertDdlToGameVersion(versionDdl)
+	if err != nil {
+		return nil, err
+	}
+	return &game.DeletedGameDraft{
+		GameVersion: version,
+		DeleteTime:  versionDdl.DeleteTime,
+		ExpireTime:  versionDdl.DeleteTime + int64(retention/time.Second),
+	}, nil
+}
+
+// ConvertDdlToGameReviewLog converts a GORM model to a GameReviewLog structure.
+func ConvertDdlToGameReviewLog(reviewLogDdl *ddl.GpGameReviewLog) *game.GameReviewLog {
+	if reviewLogDdl == nil {
+		return nil
+	}
+	return &game.GameReviewLog{
+		ReviewLogID:   int64(reviewLogDdl.Id),
+		GameID:        int64(reviewLogDdl.GameId),
+		GameVersionID: int64(reviewLogDdl.GameVersionId),
+		ReviewResult_: game.ReviewResult_(reviewLogDdl.ReviewResult),
+		ResultStatus:  game.GameStatus(reviewLogDdl.ResultStatus),
+		ReviewRemark: &game.ReviewRemark{
+			Remark:     reviewLogDdl.Remark,
+			Operator:   reviewLogDdl.Operator,
+			ReviewTime: reviewLogDdl.ReviewTime,
+			Meta:       reviewLogDdl.Meta,
+		},
+		PublishAt: reviewLogDdl.PublishAt,
+	}
+}
+
+// ConvertDdlToGameTakedown builds the takedown info of a game from the version that was taken down and the
+// operation log of the takedown, which may be missing for data written before the log existed.
+func ConvertDdlToGameTakedown(takedownVersionID uint64, takedownLogDdl *ddl.GpGameOperationLog) *game.GameTakedown {
+	takedown := &game.GameTakedown{
+		GameVersionID: int64(takedownVersionID),
+	}
+	if takedownLogDdl != nil {
+		takedown.Reason = takedownLogDdl.Reason
+		takedown.Operator = takedownLogDdl.Operator
+		takedown.TakedownTime = takedownLogDdl.CreateTs.Unix()
+	}
+	return takedown
+}
+
+// ConvertDdlToGameRollout returns the rollout in progress on a game, nil if there is none.
+func ConvertDdlToGameRollout(gameDdl *ddl.GpGame) *game.GameRollout {
+	if gameDdl.RolloutVersionId == 0 {
+		return nil
+	}
+	return &game.GameRollout{
+		GameVersionID:     int64(gameDdl.RolloutVersionId),
+		Percentage:        int32(gameDdl.RolloutPercentage),
+		State:             game.RolloutState(gameDdl.RolloutState),
+		BaseGameVersionID: int64(gameDdl.OnlineGameVersionId),
+	}
+}
+
+// ConvertDdlToRolloutLog converts a GORM model to a RolloutLog structure.
+func ConvertDdlToRolloutLog(rolloutLogDdl *ddl.GpGameRolloutLog) *game.RolloutLog {
+	return &game.RolloutLog{
+		RolloutLogID:   int64(rolloutLogDdl.Id),
+		GameID:         int64(rolloutLogDdl.GameId),
+		GameVersionID:  int64(rolloutLogDdl.GameVersionId),
+		Action:         game.RolloutAction(rolloutLogDdl.Action),
+		FromPercentage: int32(rolloutLogDdl.FromPercentage),
+		ToPercentage:   int32(rolloutLogDdl.ToPercentage),
+		Operator:       rolloutLogDdl.Operator,
+		Reason:         rolloutLogDdl.Reason,
+		CreateTime:     rolloutLogDdl.CreateTs.Unix(),
+	}
+}
+
+// ConvertDdlToCategory converts a GORM model to a Category structure.
+func ConvertDdlToCategory(categoryDdl *ddl.GpCategory) *game.Category {
+	return &game.Category{
+		CategoryID: int64(categoryDdl.Id),
+		ParentID:   int64(categoryDdl.ParentId),
+		Name:       categoryDdl.Name,
+		SortOrder:  int32(categoryDdl.SortOrder),
+		CreateTime: categoryDdl.CreateTs.Unix(),
+		UpdateTime: categoryDdl.ModifyTs.Unix(),
+	}
+}
+
+// ConvertDdlToTag converts a GORM model to a Tag structure.
+func ConvertDdlToTag(tagDdl *ddl.GpTag) *game.Tag {
+	return &game.Tag{
+		TagID:      int64(tagDdl.Id),
+		Name:       tagDdl.Name,
+		CreateTime: tagDdl.CreateTs.Unix(),
+		UpdateTime: tagDdl.ModifyTs.Unix(),
+	}
+}
+
+// ConvertFieldErrorsToGame converts the errors found by the validation package for a response.
+func ConvertFieldErrorsToGame(fieldErrors []*validation.FieldError) []*game.FieldError {
+	result := make([]*game.FieldError, 0, len(fieldErrors))
+	for _, fe := range fieldErrors {
+		result = append(result, &game.FieldError{
+			Field:   fe.Field,
+			Code:    fe.Code,
+			Message: fe.Message,
+		})
+	}
+	return result
+}
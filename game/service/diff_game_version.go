@@ -0,0 +1,237 @@
+package service
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/locale"
+)
+
+// DiffGameVersions compares the reviewable fields of two versions and returns the fields that changed,
+// in a fixed order. A nil from version is treated as an empty version, so every filled field shows up as new.
+// Both versions are expected to come from ConvertDdlToGameVersion.
+func DiffGameVersions(from, to *game.GameVersion) []*game.FieldDiff {
+	if from == nil {
+		from = &game.GameVersion{}
+	}
+	if to == nil {
+		to = &game.GameVersion{}
+	}
+
+	diffs := make([]*game.FieldDiff, 0)
+	appendStringDiff := func(field, fromValue, toValue string) {
+		if fromValue != toValue {
+			diffs = append(diffs, &game.FieldDiff{Field: field, FromValue: fromValue, ToValue: toValue})
+		}
+	}
+	appendListDiff := func(field string, fromItems, toItems []string) {
+		if equalStringLists(fromItems, toItems) {
+			return
+		}
+		added, removed := diffStringLists(fromItems, toItems)
+		diffs = append(diffs, &game.FieldDiff{
+			Field:        field,
+			FromValue:    marshalStringList(fromItems),
+			ToValue:      marshalStringList(toItems),
+			AddedItems:   added,
+			RemovedItems: removed,
+		})
+	}
+
+	appendStringDiff("game_name", from.GameName, to.GameName)
+	appendStringDiff("game_icon", from.GameIcon, to.GameIcon)
+	appendStringDiff("header_image", from.HeaderImage, to.HeaderImage)
+	appendStringDiff("game_introduction", from.GameIntroduction, to.GameIntroduction)
+	appendListDiff("game_introduction_images", from.GameIntroductionImages, to.GameIntroductionImages)
+	// a gallery of bare screenshots is what game_introduction_images already shows
+	if !isBareScreenshots(from.Media) || !isBareScreenshots(to.Media) {
+		appendListDiff("media", mediaNames(from.Media), mediaNames(to.Media))
+	}
+	appendListDiff("game_platforms", platformNames(from.GamePlatforms), platformNames(to.GamePlatforms))
+	appendStringDiff("package_name", from.PackageName, to.PackageName)
+	appendStringDiff("download_url", from.DownloadURL, to.DownloadURL)
+	for _, platform := range buildPlatforms(from, to) {
+		fromBuild, toBuild := buildOf(from, platform), buildOf(to, platform)
+		field := "builds[" + platform.String() + "]"
+		appendStringDiff(field+".identifier", fromBuild.Identifier, toBuild.Identifier)
+		appendStringDiff(field+".version_name", fromBuild.VersionName, toBuild.VersionName)
+		appendStringDiff(field+".version_code", numberString(fromBuild.VersionCode), numberString(toBuild.VersionCode))
+		appendStringDiff(field+".download_url", fromBuild.DownloadURL, toBuild.DownloadURL)
+		appendStringDiff(field+".min_os_version", fromBuild.MinOSVersion, toBuild.MinOSVersion)
+		appendStringDiff(field+".file_size", numberString(fromBuild.FileSize), numberString(toBuild.FileSize))
+		appendStringDiff(field+".sha256", fromBuild.SHA256, toBuild.SHA256)
+	}
+	appendStringDiff("category_id", numberString(from.CategoryID), numberString(to.CategoryID))
+	appendListDiff("tag_ids", idStrings(from.TagIDs), idStrings(to.TagIDs))
+	appendStringDiff("release_notes", from.ReleaseNotes, to.ReleaseNotes)
+	// an empty version is in the default locale too, so only a switch of locale shows up
+	appendStringDiff("default_locale", locale.DefaultLocaleOf(from), locale.DefaultLocaleOf(to))
+	for _, tag := range listingLocales(from, to) {
+		fromListing, toListing := listingOf(from, tag), listingOf(to, tag)
+		field := "listings[" + tag + "]"
+		appendStringDiff(field+".game_name", fromListing.GameName, toListing.GameName)
+		appendStringDiff(field+".header_image", fromListing.HeaderImage, toListing.HeaderImage)
+		appendStringDiff(field+".game_introduction", fromListing.GameIntroduction, toListing.GameIntroduction)
+		appendListDiff(field+".game_introduction_images", fromListing.GameIntroductionImages, toListing.GameIntroductionImages)
+		appendStringDiff(field+".release_notes", fromListing.ReleaseNotes, toListing.ReleaseNotes)
+	}
+
+	return diffs
+}
+
+// buildPlatforms returns the platforms having a build in either version, in platform order.
+func buildPlatforms(from, to *game.GameVersion) []game.GamePlatform {
+	seen := make(map[game.GamePlatform]bool, len(from.Builds)+len(to.Builds))
+	platforms := make([]game.GamePlatform, 0, len(from.Builds)+len(to.Builds))
+	for _, builds := range [][]*game.PlatformBuild{from.Builds, to.Builds} {
+		for _, build := range builds {
+			if build != nil && !seen[build.Platform] {
+				seen[build.Platform] = true
+				platforms = append(platforms, build.Platform)
+			}
+		}
+	}
+	sort.Slice(platforms, func(i, j int) bool { return platforms[i] < platforms[j] })
+	return platforms
+}
+
+// buildOf returns the build of version for platform, empty when it has none.
+func buildOf(version *game.GameVersion, platform game.GamePlatform) *game.PlatformBuild {
+	for _, build := range version.Builds {
+		if build != nil && build.Platform == platform {
+			return build
+		}
+	}
+	return &game.PlatformBuild{}
+}
+
+// listingLocales returns the locales having a listing in either version, sorted.
+func listingLocales(from, to *game.GameVersion) []string {
+	seen := make(map[string]bool, len(from.Listings)+len(to.Listings))
+	tags := make([]string, 0, len(from.Listings)+len(to.Listings))
+	for _, listings := range []map[string]*game.LocalizedListing{from.Listings, to.Listings} {
+		for tag := range listings {
+			if !seen[tag] {
+				seen[tag] = true
+				tags = append(tags, tag)
+			}
+		}
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// listingOf returns the listing of version in locale tag, empty when it has none.
+func listingOf(version *game.GameVersion, tag string) *game.LocalizedListing {
+	if listing := version.Listings[tag]; listing != nil {
+		return listing
+	}
+	return &game.LocalizedListing{}
+}
+
+// diffStringLists returns the items only present in toItems and the items only present in fromItems.
+func diffStringLists(fromItems, toItems []string) (added, removed []string) {
+	fromSet := make(map[string]struct{}, len(fromItems))
+	for _, item := range fromItems {
+		fromSet[item] = struct{}{}
+	}
+	toSet := make(map[string]struct{}, len(toItems))
+	for _, item := range toItems {
+		toSet[item] = struct{}{}
+	}
+
+	added = make([]string, 0)
+	for _, item := range toItems {
+		if _, ok := fromSet[item]; !ok {
+			added = append(added, item)
+		}
+	}
+	removed = make([]string, 0)
+	for _, item := range fromItems {
+		if _, ok := toSet[item]; !ok {
+			removed = append(removed, item)
+		}
+	}
+	return added, removed
+}
+
+// equalStringLists reports whether two lists hold the same items in the same order;
+// a pure reordering (e.g. of introduction images) is a change too.
+func equalStringLists(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func marshalStringList(items []string) string {
+	if items == nil {
+		items = []string{}
+	}
+	data, _ := json.Marshal(items)
+	return string(data)
+}
+
+func platformNames(platforms []game.GamePlatform) []string {
+	names := make([]string, 0, len(platforms))
+	for _, platform := range platforms {
+		names = append(names, platform.String())
+	}
+	return names
+}
+
+// mediaNames describes the items of a gallery in order, by type, URI and caption, so that a reordered,
+// replaced or recaptioned item shows up in the diff.
+func mediaNames(media []*game.MediaItem) []string {
+	names := make([]string, 0, len(media))
+	for _, item := range media {
+		if item == nil {
+			continue
+		}
+		name := item.Type.String() + " " + item.URI
+		if item.Caption != "" {
+			name += " " + strconv.Quote(item.Caption)
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// isBareScreenshots reports whether a gallery only holds screenshots without any detail, as versions written
+// before the gallery existed do.
+func isBareScreenshots(media []*game.MediaItem) bool {
+	for _, item := range media {
+		if item == nil {
+			continue
+		}
+		if item.Type != game.MediaType_Screenshot || item.ThumbnailURI != "" || item.Orientation != game.MediaOrientation_Unset ||
+			item.AspectRatio != "" || item.Caption != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// numberString formats an ID or a number for a diff, with 0 (not set) as an empty value.
+func numberString(n int64) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.FormatInt(n, 10)
+}
+
+// idStrings formats a list of IDs for a diff.
+func idStrings(ids []int64) []string {
+	items := make([]string, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, strconv.FormatInt(id, 10))
+	}
+	return items
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+)
+
+var ErrInvalidGameListCursor = errors.New("invalid game list cursor")
+
+// gameListCursorToken is the content of an opaque GetGameList cursor. It records the sort it was issued
+// for, so that a cursor is never applied to a listing sorted differently.
+type gameListCursorToken struct {
+	SortField game.GameListSortField `json:"f"`
+	SortOrder game.SortOrder         `json:"o"`
+	SortTime  int64                  `json:"t,omitempty"`
+	SortName  string                 `json:"n,omitempty"`
+	ID        uint64                 `json:"id"`
+}
+
+// EncodeGameListCursor returns the cursor pointing after the given game, the last one of a page.
+func EncodeGameListCursor(opts *dao.GameListOptions, last *dao.GameWithVersionStatus) string {
+	field, order := normalizeGameListSort(opts)
+	token := gameListCursorToken{
+		SortField: field,
+		SortOrder: order,
+		ID:        last.Id,
+	}
+	switch field {
+	case game.GameListSortField_CreateTime:
+		token.SortTime = last.CreateTs.UnixNano()
+	case game.GameListSortField_GameName:
+		token.SortName = last.GameName
+	default:
+		token.SortTime = last.ModifyTs.UnixNano()
+	}
+
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeGameListCursor parses a cursor issued by EncodeGameListCursor for the same sort.
+// The empty cursor denotes the first page and decodes to nil.
+func DecodeGameListCursor(cursor string, opts *dao.GameListOptions) (*dao.GameListCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidGameListCursor
+	}
+	var token gameListCursorToken
+	if err := json.Unmarshal(data, &token); err != nil || token.ID == 0 {
+		return nil, ErrInvalidGameListCursor
+	}
+
+	field, order := normalizeGameListSort(opts)
+	if token.SortField != field || token.SortOrder != order {
+		return nil, fmt.Errorf("%w: it was issued for a different sort", ErrInvalidGameListCursor)
+	}
+
+	return &dao.GameListCursor{
+		SortTime: time.Unix(0, token.SortTime),
+		SortName: token.SortName,
+		ID:       token.ID,
+	}, nil
+}
+
+// normalizeGameListSort resolves the defaults of a game list sort: update time, descending.
+func normalizeGameListSort(opts *dao.GameListOptions) (game.GameListSortField, game.SortOrder) {
+	field, order := opts.SortField, opts.SortOrder
+	if field == game.GameListSortField_Unset {
+		field = game.GameListSortField_UpdateTime
+	}
+	if order == game.SortOrder_Unset {
+		order = game.SortOrder_Desc
+	}
+	return field, order
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+
+	"github.com/GameLaunchPad/game_management_project/game/constdef"
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+)
+
+// ReconcileGameSummaries compares the summary columns of every game with the version they mirror and
+// calls report for each game that drifted, with the drifted columns. With repair set the drifted games are
+// rewritten, each in its own transaction that reports what it actually changed, so a game fixed by a
+// concurrent publish in the meantime is not reported twice. It returns the number of drifted games.
+func ReconcileGameSummaries(ctx context.Context, gameDao dao.IGameDAO, repair bool, report func(gameID uint64, columns []string)) (int, error) {
+	drifted := 0
+	var afterID uint64
+	for {
+		batch, err := gameDao.ScanGameSummaries(ctx, afterID, constdef.GameSummaryReconcileBatchSize)
+		if err != nil {
+			return drifted, err
+		}
+		for _, item := range batch {
+			if item.SummaryVersion == nil {
+				continue
+			}
+			columns, err := dao.GameSummaryDrift(item.Game, item.SummaryVersion)
+			if err != nil {
+				return drifted, err
+			}
+			if len(columns) == 0 {
+				continue
+			}
+			if repair {
+				if columns, err = gameDao.RepairGameSummary(ctx, item.Game.Id); err != nil {
+					return drifted, err
+				}
+				if len(columns) == 0 {
+					continue
+				}
+			}
+			drifted++
+			report(item.Game.Id, columns)
+		}
+		if len(batch) < constdef.GameSummaryReconcileBatchSize {
+			break
+		}
+		afterID = batch[len(batch)-1].Game.Id
+	}
+	return drifted, nil
+}
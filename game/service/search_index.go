@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+
+	"github.com/GameLaunchPad/game_management_project/game/constdef"
+	"github.com/GameLaunchPad/game_management_project/game/dao"
+	"github.com/GameLaunchPad/game_management_project/game/dao/ddl"
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/search"
+)
+
+// ConvertDdlToSearchDocument builds the search document of a game from its newest version. The status
+// follows GetGameList: a taken-down game is Offline and a game without versions is Unset.
+func ConvertDdlToSearchDocument(gameDdl *ddl.GpGame, newestVersionDdl *ddl.GpGameVersion) *search.Document {
+	doc := &search.Document{
+		GameID:           gameDdl.Id,
+		CpID:             gameDdl.CpId,
+		GameName:         gameDdl.GameName,
+		GameIcon:         gameDdl.GameIcon,
+		HeaderImage:      gameDdl.HeaderImage,
+		GameIntroduction: gameDdl.GameIntroduction,
+		PackageName:      gameDdl.PackageName,
+		Status:           int(game.GameStatus_Unset),
+		CreateTime:       gameDdl.CreateTs.Unix(),
+		UpdateTime:       gameDdl.ModifyTs.Unix(),
+	}
+	if newestVersionDdl != nil {
+		doc.GameName = newestVersionDdl.GameName
+		doc.GameIcon = newestVersionDdl.GameIcon
+		doc.HeaderImage = newestVersionDdl.HeaderImage
+		doc.GameIntroduction = newestVersionDdl.GameIntroduction
+		doc.PackageName = newestVersionDdl.PackageName
+		doc.Status = newestVersionDdl.Status
+	}
+	if gameDdl.TakedownVersionId != 0 {
+		doc.Status = int(game.GameStatus_Offline)
+	}
+	return doc
+}
+
+// ConvertSearchHitToGame converts a search hit to its RPC form.
+func ConvertSearchHitToGame(hit *search.Hit) *game.SearchGameHit {
+	highlights := make([]*game.SearchHighlight, 0, len(hit.Highlights))
+	for _, h := range hit.Highlights {
+		highlights = append(highlights, &game.SearchHighlight{Field: h.Field, Snippet: h.Snippet})
+	}
+	return &game.SearchGameHit{
+		Game: &game.BriefGame{
+			GameID:      int64(hit.Document.GameID),
+			CpID:        int64(hit.Document.CpID),
+			GameName:    hit.Document.GameName,
+			GameIcon:    hit.Document.GameIcon,
+			HeaderImage: hit.Document.HeaderImage,
+			CreateTime:  hit.Document.CreateTime,
+			UpdateTime:  hit.Document.UpdateTime,
+			GameStatus:  game.GameStatus(hit.Document.Status),
+		},
+		Score:      hit.Score,
+		Highlights: highlights,
+	}
+}
+
+// RebuildSearchIndex reads every game from the database and replaces the content of index with them.
+// It returns the number of indexed games.
+func RebuildSearchIndex(ctx context.Context, gameDao dao.IGameDAO, index *search.Index) (int, error) {
+	var docs []*search.Document
+	var afterID uint64
+	for {
+		batch, err := gameDao.ScanGamesWithNewestVersion(ctx, afterID, constdef.SearchIndexRebuildBatchSize)
+		if err != nil {
+			return 0, err
+		}
+		for _, item := range batch {
+			docs = append(docs, ConvertDdlToSearchDocument(item.Game, item.NewestVersion))
+		}
+		if len(batch) < constdef.SearchIndexRebuildBatchSize {
+			break
+		}
+		afterID = batch[len(batch)-1].Game.Id
+	}
+
+	if err := index.Reset(docs); err != nil {
+		return 0, err
+	}
+	return len(docs), nil
+}
@@ -0,0 +1,484 @@
+package validation
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/locale"
+)
+
+// 字段长度上限，与 gp_game_version 的列定义保持一致
+const (
+	maxGameNameLength  = 1024  // game_name varchar(1024)
+	maxURILength       = 512   // game_icon / header_image varchar(512)，介绍图沿用同一上限
+	maxPackageLength   = 256   // package_name varchar(256)
+	maxTextColumnBytes = 65535 // game_introduction / download_url / game_introduction_images / release_notes text
+	maxTagsPerVersion  = 10    // tag_ids varchar(1024)
+	maxListingLocales  = 20    // listings mediumtext，除默认语言外最多的语言数
+	maxVersionName     = 64    // builds 中的 version_name
+	maxMinOSVersion    = 32    // builds 中的 min_os_version
+	maxBuildURLLength  = 2048  // builds 中的 download_url，builds text 整体不超过 maxTextColumnBytes
+	maxMediaCaption    = 256   // media 中的 caption
+	maxAspectRatio     = 16    // media 中的 aspect_ratio
+)
+
+// 媒体库中每种媒体的数量上限
+var maxMediaPerType = map[game.MediaType]int{
+	game.MediaType_Screenshot:   10,
+	game.MediaType_TrailerVideo: 3,
+	game.MediaType_PromoArt:     5,
+}
+
+var (
+	// androidPackagePattern Android applicationId：至少两段，每段以字母开头，只含字母、数字和下划线
+	androidPackagePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*(\.[A-Za-z][A-Za-z0-9_]*)+$`)
+	// bundleIDPattern iOS Bundle ID：至少两段，每段只含字母、数字和连字符
+	bundleIDPattern = regexp.MustCompile(`^[A-Za-z0-9-]+(\.[A-Za-z0-9-]+)+$`)
+	// osVersionPattern 系统版本号：一到三段数字，如 8、8.0、13.4.1
+	osVersionPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+){0,2}$`)
+	// sha256Pattern 十六进制的 SHA-256 摘要
+	sha256Pattern = regexp.MustCompile(`^[0-9A-Fa-f]{64}$`)
+	// aspectRatioPattern 宽高比：两个正整数，如 16:9
+	aspectRatioPattern = regexp.MustCompile(`^([1-9][0-9]*):([1-9][0-9]*)$`)
+)
+
+// StorageRule rejects content that cannot be stored, in every mode: values longer than their column and
+// platforms that are unset, unknown or repeated.
+var StorageRule = RuleFunc(func(version *game.GameVersion, mode Mode) []*FieldError {
+	var fieldErrors []*FieldError
+	fieldErrors = appendIfTooLong(fieldErrors, "game_name", utf8.RuneCountInString(version.GameName), maxGameNameLength)
+	fieldErrors = appendIfTooLong(fieldErrors, "game_icon", utf8.RuneCountInString(version.GameIcon), maxURILength)
+	fieldErrors = appendIfTooLong(fieldErrors, "header_image", utf8.RuneCountInString(version.HeaderImage), maxURILength)
+	fieldErrors = appendIfTooLong(fieldErrors, "package_name", utf8.RuneCountInString(version.PackageName), maxPackageLength)
+	fieldErrors = appendIfTooLong(fieldErrors, "game_introduction", len(version.GameIntroduction), maxTextColumnBytes)
+	fieldErrors = appendIfTooLong(fieldErrors, "download_url", len(version.DownloadURL), maxTextColumnBytes)
+	fieldErrors = appendIfTooLong(fieldErrors, "release_notes", len(version.ReleaseNotes), maxTextColumnBytes)
+
+	imagesBytes := 0
+	for i, image := range version.GameIntroductionImages {
+		fieldErrors = appendIfTooLong(fieldErrors, indexedField("game_introduction_images", i), utf8.RuneCountInString(image), maxURILength)
+		imagesBytes += len(image)
+	}
+	fieldErrors = appendIfTooLong(fieldErrors, "game_introduction_images", imagesBytes, maxTextColumnBytes)
+
+	seen := make(map[game.GamePlatform]bool, len(version.GamePlatforms))
+	for i, platform := range version.GamePlatforms {
+		field := indexedField("game_platforms", i)
+		switch {
+		case !isKnownPlatform(platform):
+			fieldErrors = append(fieldErrors, &FieldError{Field: field, Code: CodeInvalidValue, Message: fmt.Sprintf("platform %d is not a valid platform", platform)})
+		case seen[platform]:
+			fieldErrors = append(fieldErrors, &FieldError{Field: field, Code: CodeDuplicate, Message: fmt.Sprintf("platform %s is listed more than once", platform)})
+		}
+		seen[platform] = true
+	}
+	return fieldErrors
+})
+
+// TaxonomyRule checks the shape of the category and tags of a version in every mode: IDs must be positive,
+// and a version carries at most maxTagsPerVersion distinct tags. Whether they exist is checked on write.
+var TaxonomyRule = RuleFunc(func(version *game.GameVersion, mode Mode) []*FieldError {
+	var fieldErrors []*FieldError
+	if version.CategoryID < 0 {
+		fieldErrors = append(fieldErrors, &FieldError{Field: "category_id", Code: CodeInvalidValue, Message: fmt.Sprintf("category %d is not a valid category", version.CategoryID)})
+	}
+	if len(version.TagIDs) > maxTagsPerVersion {
+		fieldErrors = append(fieldErrors, &FieldError{Field: "tag_ids", Code: CodeTooLong, Message: fmt.Sprintf("tag_ids must be at most %d long", maxTagsPerVersion)})
+	}
+	seen := make(map[int64]bool, len(version.TagIDs))
+	for i, tagID := range version.TagIDs {
+		field := indexedField("tag_ids", i)
+		switch {
+		case tagID <= 0:
+			fieldErrors = append(fieldErrors, &FieldError{Field: field, Code: CodeInvalidValue, Message: fmt.Sprintf("tag %d is not a valid tag", tagID)})
+		case seen[tagID]:
+			fieldErrors = append(fieldErrors, &FieldError{Field: field, Code: CodeDuplicate, Message: fmt.Sprintf("tag %d is listed more than once", tagID)})
+		}
+		seen[tagID] = true
+	}
+	return fieldErrors
+})
+
+// LocaleRule checks the default locale and the listings of other locales in every mode: tags must be
+// valid and distinct from each other and from the default locale, and listing fields have the limits of
+// the default ones. On submit every listing needs a name and well-formed images; its other fields may be
+// left empty to fall back to the default locale.
+var LocaleRule = RuleFunc(func(version *game.GameVersion, mode Mode) []*FieldError {
+	var fieldErrors []*FieldError
+	defaultLocale := locale.Default
+	if version.DefaultLocale != "" {
+		normalized, ok := locale.Normalize(version.DefaultLocale)
+		if !ok {
+			fieldErrors = append(fieldErrors, &FieldError{Field: "default_locale", Code: CodeInvalidFormat, Message: fmt.Sprintf("%q is not a valid locale", version.DefaultLocale)})
+		}
+		defaultLocale = normalized
+	}
+	if len(version.Listings) > maxListingLocales {
+		fieldErrors = append(fieldErrors, &FieldError{Field: "listings", Code: CodeTooLong, Message: fmt.Sprintf("listings must be at most %d long", maxListingLocales)})
+	}
+
+	tags := make([]string, 0, len(version.Listings))
+	for tag := range version.Listings {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		field := fmt.Sprintf("listings[%s]", tag)
+		normalized, ok := locale.Normalize(tag)
+		switch {
+		case !ok:
+			fieldErrors = append(fieldErrors, &FieldError{Field: field, Code: CodeInvalidFormat, Message: fmt.Sprintf("%q is not a valid locale", tag)})
+			continue
+		case normalized == defaultLocale:
+			fieldErrors = append(fieldErrors, &FieldError{Field: field, Code: CodeDuplicate, Message: fmt.Sprintf("%s is the default locale, its listing is the version itself", tag)})
+			continue
+		case seen[normalized]:
+			fieldErrors = append(fieldErrors, &FieldError{Field: field, Code: CodeDuplicate, Message: fmt.Sprintf("locale %s is listed more than once", normalized)})
+			continue
+		}
+		seen[normalized] = true
+
+		listing := version.Listings[tag]
+		if listing == nil {
+			listing = &game.LocalizedListing{}
+		}
+		fieldErrors = append(fieldErrors, validateListing(field, listing, mode)...)
+	}
+	return fieldErrors
+})
+
+// validateListing checks the listing of one locale; field is its prefix, e.g. "listings[en-US]".
+func validateListing(field string, listing *game.LocalizedListing, mode Mode) []*FieldError {
+	var fieldErrors []*FieldError
+	fieldErrors = appendIfTooLong(fieldErrors, field+".game_name", utf8.RuneCountInString(listing.GameName), maxGameNameLength)
+	fieldErrors = appendIfTooLong(fieldErrors, field+".header_image", utf8.RuneCountInString(listing.HeaderImage), maxURILength)
+	fieldErrors = appendIfTooLong(fieldErrors, field+".game_introduction", len(listing.GameIntroduction), maxTextColumnBytes)
+	fieldErrors = appendIfTooLong(fieldErrors, field+".release_notes", len(listing.ReleaseNotes), maxTextColumnBytes)
+	imagesBytes := 0
+	for i, image := range listing.GameIntroductionImages {
+		fieldErrors = appendIfTooLong(fieldErrors, indexedField(field+".game_introduction_images", i), utf8.RuneCountInString(image), maxURILength)
+		imagesBytes += len(image)
+	}
+	fieldErrors = appendIfTooLong(fieldErrors, field+".game_introduction_images", imagesBytes, maxTextColumnBytes)
+	if mode != ModeSubmit {
+		return fieldErrors
+	}
+
+	if strings.TrimSpace(listing.GameName) == "" {
+		fieldErrors = append(fieldErrors, requiredError(field+".game_name"))
+	}
+	fieldErrors = appendIfInvalidURI(fieldErrors, field+".header_image", listing.HeaderImage)
+	for i, image := range listing.GameIntroductionImages {
+		fieldErrors = appendIfInvalidURI(fieldErrors, indexedField(field+".game_introduction_images", i), image)
+	}
+	return fieldErrors
+}
+
+// BuildRule checks the per-platform builds of a version. In every mode each build must be for a valid
+// platform, at most one per platform, and fit the storage. On submit the builds replace package_name and
+// download_url: every platform of the version needs a build, and each build what its platform needs to be
+// distributed. Android and iOS builds need their package name or bundle ID, a version name and a download
+// url; Android also a version code. A web build is an entry url and has no identifier.
+var BuildRule = RuleFunc(func(version *game.GameVersion, mode Mode) []*FieldError {
+	var fieldErrors []*FieldError
+	seen := make(map[game.GamePlatform]bool, len(version.Builds))
+	buildsBytes := 0
+	for i, build := range version.Builds {
+		field := indexedField("builds", i)
+		if build == nil {
+			fieldErrors = append(fieldErrors, &FieldError{Field: field, Code: CodeRequired, Message: field + " is empty"})
+			continue
+		}
+		switch {
+		case !isKnownPlatform(build.Platform):
+			fieldErrors = append(fieldErrors, &FieldError{Field: field + ".platform", Code: CodeInvalidValue, Message: fmt.Sprintf("platform %d is not a valid platform", build.Platform)})
+		case seen[build.Platform]:
+			fieldErrors = append(fieldErrors, &FieldError{Field: field + ".platform", Code: CodeDuplicate, Message: fmt.Sprintf("platform %s has more than one build", build.Platform)})
+		}
+		seen[build.Platform] = true
+
+		fieldErrors = appendIfTooLong(fieldErrors, field+".identifier", utf8.RuneCountInString(build.Identifier), maxPackageLength)
+		fieldErrors = appendIfTooLong(fieldErrors, field+".version_name", utf8.RuneCountInString(build.VersionName), maxVersionName)
+		fieldErrors = appendIfTooLong(fieldErrors, field+".download_url", len(build.DownloadURL), maxBuildURLLength)
+		fieldErrors = appendIfTooLong(fieldErrors, field+".min_os_version", utf8.RuneCountInString(build.MinOSVersion), maxMinOSVersion)
+		if build.VersionCode < 0 {
+			fieldErrors = append(fieldErrors, &FieldError{Field: field + ".version_code", Code: CodeInvalidValue, Message: "version code must not be negative"})
+		}
+		if build.FileSize < 0 {
+			fieldErrors = append(fieldErrors, &FieldError{Field: field + ".file_size", Code: CodeInvalidValue, Message: "file size must not be negative"})
+		}
+		buildsBytes += len(build.Identifier) + len(build.VersionName) + len(build.DownloadURL) + len(build.MinOSVersion) + len(build.SHA256)
+		if mode == ModeSubmit {
+			fieldErrors = append(fieldErrors, validateBuild(field, build)...)
+		}
+	}
+	fieldErrors = appendIfTooLong(fieldErrors, "builds", buildsBytes, maxTextColumnBytes)
+	if mode != ModeSubmit || len(version.Builds) == 0 {
+		return fieldErrors
+	}
+
+	listed := make(map[game.GamePlatform]bool, len(version.GamePlatforms))
+	for _, platform := range version.GamePlatforms {
+		listed[platform] = true
+		if !seen[platform] {
+			fieldErrors = append(fieldErrors, &FieldError{Field: "builds", Code: CodeRequired, Message: fmt.Sprintf("platform %s has no build", platform)})
+		}
+	}
+	for i, build := range version.Builds {
+		if build != nil && isKnownPlatform(build.Platform) && !listed[build.Platform] {
+			listed[build.Platform] = true // report each platform once
+			fieldErrors = append(fieldErrors, &FieldError{Field: indexedField("builds", i) + ".platform", Code: CodeInvalidValue, Message: fmt.Sprintf("platform %s is not one of the game platforms", build.Platform)})
+		}
+	}
+	return fieldErrors
+})
+
+// validateBuild checks, on submit, a build against its platform; field is its prefix, e.g. "builds[0]".
+func validateBuild(field string, build *game.PlatformBuild) []*FieldError {
+	var fieldErrors []*FieldError
+	switch build.Platform {
+	case game.GamePlatform_Android, game.GamePlatform_IOS:
+		pattern, name := androidPackagePattern, "Android package name"
+		if build.Platform == game.GamePlatform_IOS {
+			pattern, name = bundleIDPattern, "iOS bundle ID"
+		}
+		switch {
+		case build.Identifier == "":
+			fieldErrors = append(fieldErrors, requiredError(field+".identifier"))
+		case !pattern.MatchString(build.Identifier):
+			fieldErrors = append(fieldErrors, &FieldError{Field: field + ".identifier", Code: CodeInvalidFormat, Message: name + " must be in reverse-DNS form, such as com.example.game"})
+		}
+		if strings.TrimSpace(build.VersionName) == "" {
+			fieldErrors = append(fieldErrors, requiredError(field+".version_name"))
+		}
+		if build.Platform == game.GamePlatform_Android && build.VersionCode == 0 {
+			fieldErrors = append(fieldErrors, requiredError(field+".version_code"))
+		}
+	case game.GamePlatform_Web:
+		if build.Identifier != "" {
+			fieldErrors = append(fieldErrors, &FieldError{Field: field + ".identifier", Code: CodeInvalidValue, Message: "a web build has no identifier"})
+		}
+	default:
+		return nil
+	}
+
+	switch {
+	case build.DownloadURL == "":
+		fieldErrors = append(fieldErrors, requiredError(field+".download_url"))
+	case !isHTTPURL(build.DownloadURL):
+		fieldErrors = append(fieldErrors, &FieldError{Field: field + ".download_url", Code: CodeInvalidFormat, Message: "download url must be an absolute http or https url"})
+	}
+	if build.MinOSVersion != "" && !osVersionPattern.MatchString(build.MinOSVersion) {
+		fieldErrors = append(fieldErrors, &FieldError{Field: field + ".min_os_version", Code: CodeInvalidFormat, Message: "min os version must be a version number, such as 8.0"})
+	}
+	if build.SHA256 != "" && !sha256Pattern.MatchString(build.SHA256) {
+		fieldErrors = append(fieldErrors, &FieldError{Field: field + ".sha256", Code: CodeInvalidFormat, Message: "sha256 must be 64 hexadecimal digits"})
+	}
+	return fieldErrors
+}
+
+// MediaRule checks the media gallery of a version. In every mode each item must have a known type, fit the
+// storage and take a position of its own, and the gallery holds at most maxMediaPerType items of each type.
+// On submit every item needs a well-formed URI, a trailer also a thumbnail to show before it plays, and an
+// aspect ratio must be in the form 16:9 and agree with the orientation.
+var MediaRule = RuleFunc(func(version *game.GameVersion, mode Mode) []*FieldError {
+	var fieldErrors []*FieldError
+	counts := make(map[game.MediaType]int, len(maxMediaPerType))
+	positions := make(map[int32]bool, len(version.Media))
+	for i, item := range version.Media {
+		field := indexedField("media", i)
+		if item == nil {
+			fieldErrors = append(fieldErrors, &FieldError{Field: field, Code: CodeRequired, Message: field + " is empty"})
+			continue
+		}
+		if _, ok := maxMediaPerType[item.Type]; ok {
+			counts[item.Type]++
+		} else {
+			fieldErrors = append(fieldErrors, &FieldError{Field: field + ".type", Code: CodeInvalidValue, Message: fmt.Sprintf("media type %d is not a valid media type", item.Type)})
+		}
+		if item.Orientation != game.MediaOrientation_Unset && item.Orientation.String() == "<UNSET>" {
+			fieldErrors = append(fieldErrors, &FieldError{Field: field + ".orientation", Code: CodeInvalidValue, Message: fmt.Sprintf("orientation %d is not a valid orientation", item.Orientation)})
+		}
+		switch {
+		case item.Position < 0:
+			fieldErrors = append(fieldErrors, &FieldError{Field: field + ".position", Code: CodeInvalidValue, Message: "position must not be negative"})
+		case positions[item.Position]:
+			fieldErrors = append(fieldErrors, &FieldError{Field: field + ".position", Code: CodeDuplicate, Message: fmt.Sprintf("position %d is taken by another item", item.Position)})
+		}
+		positions[item.Position] = true
+
+		fieldErrors = appendIfTooLong(fieldErrors, field+".uri", utf8.RuneCountInString(item.URI), maxURILength)
+		fieldErrors = appendIfTooLong(fieldErrors, field+".thumbnail_uri", utf8.RuneCountInString(item.ThumbnailURI), maxURILength)
+		fieldErrors = appendIfTooLong(fieldErrors, field+".aspect_ratio", len(item.AspectRatio), maxAspectRatio)
+		fieldErrors = appendIfTooLong(fieldErrors, field+".caption", utf8.RuneCountInString(item.Caption), maxMediaCaption)
+		if mode == ModeSubmit {
+			fieldErrors = append(fieldErrors, validateMediaItem(field, item)...)
+		}
+	}
+
+	for _, mediaType := range []game.MediaType{game.MediaType_Screenshot, game.MediaType_TrailerVideo, game.MediaType_PromoArt} {
+		if counts[mediaType] > maxMediaPerType[mediaType] {
+			fieldErrors = append(fieldErrors, &FieldError{Field: "media", Code: CodeTooLong, Message: fmt.Sprintf("media may hold at most %d items of type %s", maxMediaPerType[mediaType], mediaType)})
+		}
+	}
+	return fieldErrors
+})
+
+// validateMediaItem checks what one gallery item needs to be shown; field is its prefix, e.g. "media[2]".
+func validateMediaItem(field string, item *game.MediaItem) []*FieldError {
+	var fieldErrors []*FieldError
+	if strings.TrimSpace(item.URI) == "" {
+		fieldErrors = append(fieldErrors, requiredError(field+".uri"))
+	}
+	fieldErrors = appendIfInvalidURI(fieldErrors, field+".uri", item.URI)
+	if item.Type == game.MediaType_TrailerVideo && strings.TrimSpace(item.ThumbnailURI) == "" {
+		fieldErrors = append(fieldErrors, requiredError(field+".thumbnail_uri"))
+	}
+	fieldErrors = appendIfInvalidURI(fieldErrors, field+".thumbnail_uri", item.ThumbnailURI)
+
+	if item.AspectRatio == "" {
+		return fieldErrors
+	}
+	match := aspectRatioPattern.FindStringSubmatch(item.AspectRatio)
+	if match == nil {
+		return append(fieldErrors, &FieldError{Field: field + ".aspect_ratio", Code: CodeInvalidFormat, Message: "aspect ratio must be two positive integers, such as 16:9"})
+	}
+	width, _ := strconv.Atoi(match[1])
+	height, _ := strconv.Atoi(match[2])
+	var orientation game.MediaOrientation
+	switch {
+	case width > height:
+		orientation = game.MediaOrientation_Landscape
+	case width < height:
+		orientation = game.MediaOrientation_Portrait
+	default:
+		orientation = game.MediaOrientation_Square
+	}
+	if item.Orientation != game.MediaOrientation_Unset && item.Orientation != orientation {
+		fieldErrors = append(fieldErrors, &FieldError{Field: field + ".orientation", Code: CodeInvalidValue, Message: fmt.Sprintf("aspect ratio %s is %s, not %s", item.AspectRatio, orientation, item.Orientation)})
+	}
+	return fieldErrors
+}
+
+// RequiredRule requires, on submit, the content every listing shows.
+var RequiredRule = RuleFunc(func(version *game.GameVersion, mode Mode) []*FieldError {
+	if mode != ModeSubmit {
+		return nil
+	}
+	var fieldErrors []*FieldError
+	for _, f := range []struct {
+		field string
+		value string
+	}{
+		{"game_name", version.GameName},
+		{"game_icon", version.GameIcon},
+		{"header_image", version.HeaderImage},
+		{"game_introduction", version.GameIntroduction},
+	} {
+		if strings.TrimSpace(f.value) == "" {
+			fieldErrors = append(fieldErrors, requiredError(f.field))
+		}
+	}
+	if len(version.GamePlatforms) == 0 {
+		fieldErrors = append(fieldErrors, &FieldError{Field: "game_platforms", Code: CodeRequired, Message: "at least one platform is required"})
+	}
+	return fieldErrors
+})
+
+// FormatRule checks, on submit, that links are well formed. The download URL must be an absolute http(s)
+// URL; image URIs may be storage keys, so they only have to parse as a URI without blanks.
+var FormatRule = RuleFunc(func(version *game.GameVersion, mode Mode) []*FieldError {
+	if mode != ModeSubmit {
+		return nil
+	}
+	var fieldErrors []*FieldError
+	if version.DownloadURL != "" && !isHTTPURL(version.DownloadURL) {
+		fieldErrors = append(fieldErrors, &FieldError{Field: "download_url", Code: CodeInvalidFormat, Message: "download url must be an absolute http or https url"})
+	}
+	fieldErrors = appendIfInvalidURI(fieldErrors, "game_icon", version.GameIcon)
+	fieldErrors = appendIfInvalidURI(fieldErrors, "header_image", version.HeaderImage)
+	for i, image := range version.GameIntroductionImages {
+		fieldErrors = appendIfInvalidURI(fieldErrors, indexedField("game_introduction_images", i), image)
+	}
+	return fieldErrors
+})
+
+// PlatformRule checks, on submit, what each platform needs to be distributed: every platform needs a
+// download url, Android a reverse-DNS package name and iOS a bundle ID, both kept in package_name. It only
+// applies to versions without builds, see BuildRule.
+var PlatformRule = RuleFunc(func(version *game.GameVersion, mode Mode) []*FieldError {
+	if mode != ModeSubmit || len(version.GamePlatforms) == 0 || len(version.Builds) > 0 {
+		return nil
+	}
+	var fieldErrors []*FieldError
+	if version.DownloadURL == "" {
+		fieldErrors = append(fieldErrors, requiredError("download_url"))
+	}
+
+	var needsAndroidPackage, needsBundleID bool
+	for _, platform := range version.GamePlatforms {
+		switch platform {
+		case game.GamePlatform_Android:
+			needsAndroidPackage = true
+		case game.GamePlatform_IOS:
+			needsBundleID = true
+		}
+	}
+	if !needsAndroidPackage && !needsBundleID {
+		return fieldErrors
+	}
+	switch {
+	case version.PackageName == "":
+		fieldErrors = append(fieldErrors, &FieldError{Field: "package_name", Code: CodeRequired, Message: "package name is required for Android and iOS"})
+	case needsAndroidPackage && !androidPackagePattern.MatchString(version.PackageName):
+		fieldErrors = append(fieldErrors, &FieldError{Field: "package_name", Code: CodeInvalidFormat, Message: "Android package name must be in reverse-DNS form, such as com.example.game"})
+	case needsBundleID && !bundleIDPattern.MatchString(version.PackageName):
+		fieldErrors = append(fieldErrors, &FieldError{Field: "package_name", Code: CodeInvalidFormat, Message: "iOS bundle ID must be in reverse-DNS form, such as com.example.game"})
+	}
+	return fieldErrors
+})
+
+func isKnownPlatform(platform game.GamePlatform) bool {
+	return platform != game.GamePlatform_Unset && platform.String() != "<UNSET>"
+}
+
+func requiredError(field string) *FieldError {
+	return &FieldError{Field: field, Code: CodeRequired, Message: field + " is required"}
+}
+
+func appendIfTooLong(fieldErrors []*FieldError, field string, length, limit int) []*FieldError {
+	if length <= limit {
+		return fieldErrors
+	}
+	return append(fieldErrors, &FieldError{Field: field, Code: CodeTooLong, Message: fmt.Sprintf("%s must be at most %d long", field, limit)})
+}
+
+func appendIfInvalidURI(fieldErrors []*FieldError, field, value string) []*FieldError {
+	if value == "" {
+		return fieldErrors
+	}
+	if _, err := url.Parse(value); err != nil || strings.IndexFunc(value, unicode.IsSpace) >= 0 {
+		return append(fieldErrors, &FieldError{Field: field, Code: CodeInvalidFormat, Message: field + " is not a valid uri"})
+	}
+	return fieldErrors
+}
+
+func isHTTPURL(value string) bool {
+	u, err := url.Parse(value)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func indexedField(field string, i int) string {
+	return fmt.Sprintf("%s[%d]", field, i)
+}
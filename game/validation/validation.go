@@ -0,0 +1,83 @@
+// Package validation checks the content of a game version before it is written.
+//
+// Drafts are validated leniently: anything may be missing, but what is there has to fit the storage.
+// A version submitted for review is validated strictly: it must be complete, well formed and carry what
+// each of its platforms needs. Rules are pluggable, see Validator.Register.
+package validation
+
+import (
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+)
+
+// Mode 校验模式
+type Mode int
+
+const (
+	// ModeDraft 保存草稿，只校验内容能否落库
+	ModeDraft Mode = iota
+	// ModeSubmit 提交审核，额外校验内容完整性与各平台的要求
+	ModeSubmit
+)
+
+// 字段错误码
+const (
+	CodeRequired      = "required"
+	CodeTooLong       = "too_long"
+	CodeInvalidFormat = "invalid_format"
+	CodeInvalidValue  = "invalid_value"
+	CodeDuplicate     = "duplicate"
+)
+
+// FieldError is a problem with one field of a version. Field is the form field name used by the gateway,
+// with an index for list items, e.g. "game_introduction_images[2]".
+type FieldError struct {
+	Field   string
+	Code    string
+	Message string
+}
+
+// Rule checks one aspect of a version and returns the errors it found, or nil.
+type Rule interface {
+	Validate(version *game.GameVersion, mode Mode) []*FieldError
+}
+
+// RuleFunc adapts a function to Rule.
+type RuleFunc func(version *game.GameVersion, mode Mode) []*FieldError
+
+// Validate calls f.
+func (f RuleFunc) Validate(version *game.GameVersion, mode Mode) []*FieldError {
+	return f(version, mode)
+}
+
+// Validator runs a list of rules and collects all their errors.
+type Validator struct {
+	rules []Rule
+}
+
+// NewValidator returns a validator running rules in order.
+func NewValidator(rules ...Rule) *Validator {
+	return &Validator{rules: rules}
+}
+
+// Default returns a validator running the built-in rules.
+func Default() *Validator {
+	return NewValidator(StorageRule, TaxonomyRule, LocaleRule, RequiredRule, FormatRule, PlatformRule, BuildRule, MediaRule)
+}
+
+// Register appends rules to the validator.
+func (v *Validator) Register(rules ...Rule) *Validator {
+	v.rules = append(v.rules, rules...)
+	return v
+}
+
+// Validate runs every rule against version. It returns nil when the version is valid for mode.
+func (v *Validator) Validate(version *game.GameVersion, mode Mode) []*FieldError {
+	if version == nil {
+		return []*FieldError{{Field: "game_version", Code: CodeRequired, Message: "game version is required"}}
+	}
+	var fieldErrors []*FieldError
+	for _, rule := range v.rules {
+		fieldErrors = append(fieldErrors, rule.Validate(version, mode)...)
+	}
+	return fieldErrors
+}
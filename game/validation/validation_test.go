@@ -0,0 +1,275 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/stretchr/testify/assert"
+)
+
+// completeVersion returns a version that passes strict validation.
+func completeVersion() *game.GameVersion {
+	return &game.GameVersion{
+		GameName:               "Happy Elimination",
+		GameIcon:               "https://cdn.example.com/icon.png",
+		HeaderImage:            "https://cdn.example.com/header.png",
+		GameIntroduction:       "Match three.",
+		GameIntroductionImages: []string{"https://cdn.example.com/1.png"},
+		GamePlatforms:          []game.GamePlatform{game.GamePlatform_Android, game.GamePlatform_IOS},
+		PackageName:            "com.happy.elimination",
+		DownloadURL:            "https://download.example.com/happy.apk",
+	}
+}
+
+// fields returns the field of each error, in order.
+func fields(fieldErrors []*FieldError) []string {
+	var names []string
+	for _, fe := range fieldErrors {
+		names = append(names, fe.Field)
+	}
+	return names
+}
+
+// TestValidate_Complete tests that a complete version passes in both modes
+func TestValidate_Complete(t *testing.T) {
+	assert.Empty(t, Default().Validate(completeVersion(), ModeDraft))
+	assert.Empty(t, Default().Validate(completeVersion(), ModeSubmit))
+}
+
+// TestValidate_EmptyDraft tests that a draft may be saved with nothing filled in, but not submitted
+func TestValidate_EmptyDraft(t *testing.T) {
+	version := &game.GameVersion{}
+
+	assert.Empty(t, Default().Validate(version, ModeDraft))
+	assert.Equal(t,
+		[]string{"game_name", "game_icon", "header_image", "game_introduction", "game_platforms"},
+		fields(Default().Validate(version, ModeSubmit)))
+}
+
+// TestValidate_Storage tests the column limits and platform values enforced even on drafts
+func TestValidate_Storage(t *testing.T) {
+	version := &game.GameVersion{
+		GameName:      strings.Repeat("名", maxGameNameLength+1),
+		GameIcon:      "https://cdn.example.com/" + strings.Repeat("a", maxURILength),
+		GamePlatforms: []game.GamePlatform{game.GamePlatform_Web, game.GamePlatform_Unset, game.GamePlatform_Web, 9},
+		ReleaseNotes:  strings.Repeat("a", maxTextColumnBytes+1),
+	}
+
+	fieldErrors := Default().Validate(version, ModeDraft)
+
+	assert.Equal(t, []string{"game_name", "game_icon", "release_notes", "game_platforms[1]", "game_platforms[2]", "game_platforms[3]"}, fields(fieldErrors))
+	assert.Equal(t, CodeTooLong, fieldErrors[0].Code)
+	assert.Equal(t, CodeTooLong, fieldErrors[2].Code)
+	assert.Equal(t, CodeInvalidValue, fieldErrors[3].Code)
+	assert.Equal(t, CodeDuplicate, fieldErrors[4].Code)
+	assert.Equal(t, CodeInvalidValue, fieldErrors[5].Code)
+}
+
+// TestValidate_Taxonomy tests the category and tag IDs checked even on drafts
+func TestValidate_Taxonomy(t *testing.T) {
+	version := &game.GameVersion{CategoryID: -1, TagIDs: []int64{3, 0, 3}}
+
+	fieldErrors := Default().Validate(version, ModeDraft)
+
+	assert.Equal(t, []string{"category_id", "tag_ids[1]", "tag_ids[2]"}, fields(fieldErrors))
+	assert.Equal(t, CodeInvalidValue, fieldErrors[1].Code)
+	assert.Equal(t, CodeDuplicate, fieldErrors[2].Code)
+
+	version = &game.GameVersion{TagIDs: []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}}
+	assert.Equal(t, []string{"tag_ids"}, fields(Default().Validate(version, ModeDraft)))
+}
+
+// TestValidate_Locale tests the locale tags and listings checked even on drafts
+func TestValidate_Locale(t *testing.T) {
+	version := &game.GameVersion{
+		DefaultLocale: "en-US",
+		Listings: map[string]*game.LocalizedListing{
+			"en_us":   {GameName: "Happy Elimination"},
+			"english": {GameName: "Happy Elimination"},
+			"ja":      {GameName: strings.Repeat("名", maxGameNameLength+1)},
+			"ja-jp":   {GameName: "ハッピー"},
+			"JA-JP":   {GameName: "ハッピー"},
+		},
+	}
+
+	fieldErrors := Default().Validate(version, ModeDraft)
+
+	// en_us is the default locale; JA-JP comes first, so ja-jp is the repeated one
+	assert.Equal(t, []string{"listings[en_us]", "listings[english]", "listings[ja].game_name", "listings[ja-jp]"}, fields(fieldErrors))
+	assert.Equal(t, CodeDuplicate, fieldErrors[0].Code)
+	assert.Equal(t, CodeInvalidFormat, fieldErrors[1].Code)
+	assert.Equal(t, CodeTooLong, fieldErrors[2].Code)
+	assert.Equal(t, CodeDuplicate, fieldErrors[3].Code)
+
+	version = &game.GameVersion{DefaultLocale: "zh_CN_x"}
+	assert.Equal(t, []string{"default_locale"}, fields(Default().Validate(version, ModeDraft)))
+}
+
+// TestValidate_LocaleSubmit tests that each listing needs its own name on submit, while other fields fall back
+func TestValidate_LocaleSubmit(t *testing.T) {
+	version := completeVersion()
+	version.Listings = map[string]*game.LocalizedListing{
+		"en-US": {GameName: "Happy Elimination"},
+		"ja-JP": {GameIntroductionImages: []string{"not a uri"}},
+	}
+
+	assert.Empty(t, Default().Validate(version, ModeDraft))
+	assert.Equal(t,
+		[]string{"listings[ja-JP].game_name", "listings[ja-JP].game_introduction_images[0]"},
+		fields(Default().Validate(version, ModeSubmit)))
+}
+
+// TestValidate_NameLengthCountsCharacters tests that a name of exactly the column width in multi-byte characters fits
+func TestValidate_NameLengthCountsCharacters(t *testing.T) {
+	version := &game.GameVersion{GameName: strings.Repeat("名", maxGameNameLength)}
+
+	assert.Empty(t, Default().Validate(version, ModeDraft))
+}
+
+// TestValidate_Format tests that malformed links are only rejected on submit
+func TestValidate_Format(t *testing.T) {
+	version := completeVersion()
+	version.DownloadURL = "download.example.com/happy.apk"
+	version.GameIntroductionImages = []string{"https://cdn.example.com/1.png", "not a uri"}
+
+	assert.Empty(t, Default().Validate(version, ModeDraft))
+	fieldErrors := Default().Validate(version, ModeSubmit)
+	assert.Equal(t, []string{"download_url", "game_introduction_images[1]"}, fields(fieldErrors))
+	assert.Equal(t, CodeInvalidFormat, fieldErrors[0].Code)
+}
+
+// TestValidate_Platform tests the per-platform requirements on submit
+func TestValidate_Platform(t *testing.T) {
+	tests := []struct {
+		name        string
+		platforms   []game.GamePlatform
+		packageName string
+		downloadURL string
+		wantFields  []string
+	}{
+		{"web needs no package name", []game.GamePlatform{game.GamePlatform_Web}, "", "https://play.example.com", nil},
+		{"web needs a download url", []game.GamePlatform{game.GamePlatform_Web}, "", "", []string{"download_url"}},
+		{"android needs a package name", []game.GamePlatform{game.GamePlatform_Android}, "", "https://d.example.com", []string{"package_name"}},
+		{"android package must be reverse-DNS", []game.GamePlatform{game.GamePlatform_Android}, "happy", "https://d.example.com", []string{"package_name"}},
+		{"android package segments start with a letter", []game.GamePlatform{game.GamePlatform_Android}, "com.1happy", "https://d.example.com", []string{"package_name"}},
+		{"ios bundle ID may hold hyphens", []game.GamePlatform{game.GamePlatform_IOS}, "com.happy-games.elimination", "https://d.example.com", nil},
+		{"android rejects hyphens", []game.GamePlatform{game.GamePlatform_Android, game.GamePlatform_IOS}, "com.happy-games.elimination", "https://d.example.com", []string{"package_name"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version := completeVersion()
+			version.GamePlatforms = tt.platforms
+			version.PackageName = tt.packageName
+			version.DownloadURL = tt.downloadURL
+
+			assert.Equal(t, tt.wantFields, fields(Default().Validate(version, ModeSubmit)))
+		})
+	}
+}
+
+// completeBuilds returns builds for every platform that pass strict validation.
+func completeBuilds() []*game.PlatformBuild {
+	return []*game.PlatformBuild{
+		{Platform: game.GamePlatform_Android, Identifier: "com.happy.elimination", VersionName: "1.2.0", VersionCode: 120,
+			DownloadURL: "https://download.example.com/happy.apk", MinOSVersion: "8.0", FileSize: 104857600,
+			SHA256: strings.Repeat("ab", 32)},
+		{Platform: game.GamePlatform_IOS, Identifier: "com.happy-games.elimination", VersionName: "1.2.0",
+			DownloadURL: "https://apps.example.com/happy", MinOSVersion: "13.0"},
+		{Platform: game.GamePlatform_Web, DownloadURL: "https://play.example.com/happy"},
+	}
+}
+
+// TestValidate_Builds tests that builds replace package_name and download_url, each checked against its platform
+func TestValidate_Builds(t *testing.T) {
+	version := completeVersion()
+	version.GamePlatforms = []game.GamePlatform{game.GamePlatform_Android, game.GamePlatform_IOS, game.GamePlatform_Web}
+	version.PackageName = ""
+	version.DownloadURL = ""
+	version.Builds = completeBuilds()
+
+	assert.Empty(t, Default().Validate(version, ModeSubmit))
+
+	version.Builds[0].Identifier = "com.happy-games.elimination"
+	version.Builds[0].VersionCode = 0
+	version.Builds[0].SHA256 = "abc"
+	version.Builds[1].DownloadURL = "apps.example.com/happy"
+	version.Builds[2].Identifier = "com.happy.web"
+	version.Builds[2].MinOSVersion = "latest"
+
+	assert.Empty(t, Default().Validate(version, ModeDraft))
+	assert.Equal(t,
+		[]string{"builds[0].identifier", "builds[0].version_code", "builds[0].sha256", "builds[1].download_url", "builds[2].identifier", "builds[2].min_os_version"},
+		fields(Default().Validate(version, ModeSubmit)))
+}
+
+// TestValidate_BuildPlatforms tests that builds must match the platforms of the version
+func TestValidate_BuildPlatforms(t *testing.T) {
+	version := completeVersion()
+	version.GamePlatforms = []game.GamePlatform{game.GamePlatform_Android, game.GamePlatform_IOS}
+	version.Builds = completeBuilds()[:1]
+	version.Builds = append(version.Builds, completeBuilds()[0], completeBuilds()[2], &game.PlatformBuild{Platform: 9, VersionCode: -1})
+
+	// a repeated or unknown platform and a negative version code cannot even be stored
+	assert.Equal(t,
+		[]string{"builds[1].platform", "builds[3].platform", "builds[3].version_code"},
+		fields(Default().Validate(version, ModeDraft)))
+	// iOS has no build, and the web build is not for one of the game platforms
+	fieldErrors := Default().Validate(version, ModeSubmit)
+	assert.Equal(t,
+		[]string{"builds[1].platform", "builds[3].platform", "builds[3].version_code", "builds", "builds[2].platform"},
+		fields(fieldErrors))
+	assert.Equal(t, CodeRequired, fieldErrors[3].Code)
+}
+
+// TestValidate_Media tests the gallery checks that apply even to drafts: known types, distinct positions and the count per type
+func TestValidate_Media(t *testing.T) {
+	version := completeVersion()
+	for i := 0; i < 4; i++ {
+		version.Media = append(version.Media, &game.MediaItem{Type: game.MediaType_TrailerVideo, URI: fmt.Sprintf("trailer%d.mp4", i), ThumbnailURI: "poster.png", Position: int32(i)})
+	}
+	version.Media = append(version.Media,
+		&game.MediaItem{Type: game.MediaType_Unset, URI: "unknown.png", Position: 3},
+		&game.MediaItem{Type: game.MediaType_PromoArt, URI: "art.png", Orientation: 7, Caption: strings.Repeat("字", maxMediaCaption+1), Position: -1},
+	)
+
+	fieldErrors := Default().Validate(version, ModeDraft)
+
+	assert.Equal(t, []string{"media[4].type", "media[4].position", "media[5].orientation", "media[5].position", "media[5].caption", "media"}, fields(fieldErrors))
+	assert.Equal(t, CodeDuplicate, fieldErrors[1].Code)
+	assert.Equal(t, CodeTooLong, fieldErrors[5].Code)
+}
+
+// TestValidate_MediaSubmit tests that on submit each item needs a valid URI, a trailer its thumbnail, and the aspect ratio its orientation
+func TestValidate_MediaSubmit(t *testing.T) {
+	version := completeVersion()
+	version.Media = []*game.MediaItem{
+		{Type: game.MediaType_Screenshot, URI: "https://cdn.example.com/1.png", Orientation: game.MediaOrientation_Landscape, AspectRatio: "16:9", Position: 0},
+		{Type: game.MediaType_Screenshot, URI: "https://cdn.example.com/2.png", Orientation: game.MediaOrientation_Portrait, AspectRatio: "16:9", Position: 1},
+		{Type: game.MediaType_TrailerVideo, URI: "https://cdn.example.com/trailer.mp4", Position: 2},
+		{Type: game.MediaType_PromoArt, URI: "not a uri", AspectRatio: "wide", Position: 3},
+		{Type: game.MediaType_PromoArt, AspectRatio: "1:1", Orientation: game.MediaOrientation_Square, Position: 4},
+	}
+
+	assert.Empty(t, Default().Validate(version, ModeDraft))
+	assert.Equal(t,
+		[]string{"media[1].orientation", "media[2].thumbnail_uri", "media[3].uri", "media[3].aspect_ratio", "media[4].uri"},
+		fields(Default().Validate(version, ModeSubmit)))
+}
+
+// TestValidator_Register tests that extra rules run after the built-in ones
+func TestValidator_Register(t *testing.T) {
+	validator := Default().Register(RuleFunc(func(version *game.GameVersion, mode Mode) []*FieldError {
+		if strings.Contains(version.GameName, "forbidden") {
+			return []*FieldError{{Field: "game_name", Code: CodeInvalidValue, Message: "game name is not allowed"}}
+		}
+		return nil
+	}))
+
+	version := completeVersion()
+	version.GameName = "forbidden game"
+
+	assert.Equal(t, []string{"game_name"}, fields(validator.Validate(version, ModeDraft)))
+	assert.Empty(t, Default().Validate(version, ModeDraft))
+}
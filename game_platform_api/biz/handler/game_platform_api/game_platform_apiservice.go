@@ -4,14 +4,22 @@ package game_platform_api
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"mime/multipart"
+	"os"
+	"path/filepath"
 	"strconv"
 
+	"github.com/GameLaunchPad/game_management_project/game/constdef"
 	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	"github.com/GameLaunchPad/game_management_project/game/locale"
 	"github.com/GameLaunchPad/game_management_project/game_platform_api/biz/model/common"
 	game_platform_api "github.com/GameLaunchPad/game_management_project/game_platform_api/biz/model/game_platform_api"
 	"github.com/GameLaunchPad/game_management_project/game_platform_api/biz/service"
+	"github.com/GameLaunchPad/game_management_project/game_platform_api/config"
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 )
@@ -175,6 +183,8 @@ func GetGameList(ctx context.Context, c *app.RequestContext) {
 		Data: &game_platform_api.GetGameListData{
 			GameList:   convertBriefGameListToAPI(rpcResp.GameList),
 			TotalCount: rpcResp.TotalCount,
+			NextCursor: rpcResp.NextCursor,
+			HasMore:    rpcResp.HasMore,
 		},
 		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
 	}
@@ -204,7 +214,7 @@ func GetGameDetail(ctx context.Context, c *app.RequestContext) {
 
 	resp = &game_platform_api.GetGameDetailResponse{
 		Data: &game_platform_api.GetGameDetailData{
-			GameDetail: convertGameDetailToAPI(rpcResp.GameDetail),
+			GameDetail: convertGameDetailToAPI(rpcResp.GameDetail, requestLocales(c)),
 		},
 		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
 	}
@@ -236,17 +246,522 @@ func CreateGameDetail(ctx context.Context, c *app.RequestContext) {
 		Data: &game_platform_api.CreateGameDetailData{
 			GameID: fmt.Sprint(rpcResp.GameID),
 		},
+		FieldErrors: convertFieldErrorListToAPI(rpcResp.FieldErrors),
+		BaseResp:    (*common.BaseResp)(rpcResp.BaseResp),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UpdateGameDetail .
+// @router /api/v1/games/:id [PUT]
+func UpdateGameDetail(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req game_platform_api.UpdateGameDetailRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	gameSvc := service.NewGameService()
+	rpcResp, err := gameSvc.UpdateGameDetail(ctx, &req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(game_platform_api.UpdateGameDetailResponse)
+
+	resp = &game_platform_api.UpdateGameDetailResponse{
+		Data: &game_platform_api.UpdateGameDetailData{
+			GameVersionID: fmt.Sprint(rpcResp.GameVersionID),
+			Revision:      rpcResp.Revision,
+		},
+		FieldErrors: convertFieldErrorListToAPI(rpcResp.FieldErrors),
+		BaseResp:    (*common.BaseResp)(rpcResp.BaseResp),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ReviewGameVersion .
+// @router /api/v1/games/review [POST]
+func ReviewGameVersion(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req game_platform_api.ReviewGameVersionRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	gameSvc := service.NewGameService()
+	rpcResp, err := gameSvc.ReviewGameVersion(ctx, &req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(game_platform_api.ReviewGameVersionResponse)
+
+	resp = &game_platform_api.ReviewGameVersionResponse{
+		Data:     &game_platform_api.ReviewGameVersionData{},
+		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// DeleteGameDraft .
+// @router /api/v1/games/:id/draft [DELETE]
+func DeleteGameDraft(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req game_platform_api.DeleteGameDraftRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	gameSvc := service.NewGameService()
+	rpcResp, err := gameSvc.DeleteGameDraft(ctx, &req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(game_platform_api.DeleteGameDraftResponse)
+
+	resp = &game_platform_api.DeleteGameDraftResponse{
+		Data: &game_platform_api.DeleteGameDraftData{
+			NewestGameVersionID: fmt.Sprint(rpcResp.NewestGameVersionID_),
+		},
+		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListGameVersions .
+// @router /api/v1/games/:id/versions [GET]
+func ListGameVersions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req game_platform_api.ListGameVersionsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	gameSvc := service.NewGameService()
+	rpcResp, err := gameSvc.ListGameVersions(ctx, &req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(game_platform_api.ListGameVersionsResponse)
+
+	resp = &game_platform_api.ListGameVersionsResponse{
+		Data: &game_platform_api.ListGameVersionsData{
+			GameVersions: convertGameVersionListToAPI(rpcResp.GameVersions, requestLocales(c)),
+			TotalCount:   rpcResp.TotalCount,
+		},
+		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RollbackGameVersion .
+// @router /api/v1/games/:id/rollback [POST]
+func RollbackGameVersion(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req game_platform_api.RollbackGameVersionRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	gameSvc := service.NewGameService()
+	rpcResp, err := gameSvc.RollbackGameVersion(ctx, &req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(game_platform_api.RollbackGameVersionResponse)
+
+	resp = &game_platform_api.RollbackGameVersionResponse{
+		Data:     &game_platform_api.RollbackGameVersionData{},
+		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// CancelScheduledPublish .
+// @router /api/v1/games/:id/schedule/cancel [POST]
+func CancelScheduledPublish(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req game_platform_api.CancelScheduledPublishRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	gameSvc := service.NewGameService()
+	rpcResp, err := gameSvc.CancelScheduledPublish(ctx, &req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(game_platform_api.CancelScheduledPublishResponse)
+
+	resp = &game_platform_api.CancelScheduledPublishResponse{
+		Data:     &game_platform_api.CancelScheduledPublishData{},
+		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetGameReviewLogs .
+// @router /api/v1/games/:id/versions/:version_id/reviews [GET]
+func GetGameReviewLogs(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req game_platform_api.GetGameReviewLogsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	gameSvc := service.NewGameService()
+	rpcResp, err := gameSvc.GetGameReviewLogs(ctx, &req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(game_platform_api.GetGameReviewLogsResponse)
+
+	resp = &game_platform_api.GetGameReviewLogsResponse{
+		Data: &game_platform_api.GetGameReviewLogsData{
+			ReviewLogs: convertGameReviewLogListToAPI(rpcResp.ReviewLogs),
+		},
+		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SubmitGameVersion .
+// @router /api/v1/games/:id/submit [POST]
+func SubmitGameVersion(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req game_platform_api.SubmitGameVersionRequest
+
+	// this route is exempt from the server's body limit (see main.go), check the length before binding reads the body
+	contentLength := c.Request.Header.ContentLength()
+	if contentLength > maxSubmitBodySize {
+		c.String(consts.StatusRequestEntityTooLarge, fmt.Sprintf("request body is larger than %d bytes", maxSubmitBodySize))
+		return
+	}
+	if contentLength < 0 && c.Request.IsBodyStream() {
+		c.String(consts.StatusLengthRequired, "an APK upload must have a Content-Length")
+		return
+	}
+
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// an Android build may be submitted with its APK, as the multipart file "apk". It is copied to the
+	// upload directory shared with the game service, which reads it from there by path
+	var apkName string
+	if fileHeader, formErr := c.FormFile("apk"); formErr == nil {
+		if fileHeader.Size > constdef.MaxApkFileSize {
+			c.String(consts.StatusRequestEntityTooLarge, fmt.Sprintf("apk is larger than %d bytes", constdef.MaxApkFileSize))
+			return
+		}
+		apkName, err = saveApkUpload(fileHeader)
+		if err != nil {
+			c.String(consts.StatusInternalServerError, err.Error())
+			return
+		}
+		// the game service is done with the file once it has answered
+		defer os.Remove(filepath.Join(config.ApkUploadDir(), apkName))
+	}
+
+	gameSvc := service.NewGameService()
+	rpcResp, err := gameSvc.SubmitGameVersion(ctx, &req, apkName)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(game_platform_api.SubmitGameVersionResponse)
+
+	resp = &game_platform_api.SubmitGameVersionResponse{
+		Data:        &game_platform_api.SubmitGameVersionData{},
+		FieldErrors: convertFieldErrorListToAPI(rpcResp.FieldErrors),
+		BaseResp:    (*common.BaseResp)(rpcResp.BaseResp),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// WithdrawGameVersion .
+// @router /api/v1/games/:id/withdraw [POST]
+func WithdrawGameVersion(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req game_platform_api.WithdrawGameVersionRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	gameSvc := service.NewGameService()
+	rpcResp, err := gameSvc.WithdrawGameVersion(ctx, &req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(game_platform_api.WithdrawGameVersionResponse)
+
+	resp = &game_platform_api.WithdrawGameVersionResponse{
+		Data:     &game_platform_api.WithdrawGameVersionData{},
+		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// DiffGameVersions .
+// @router /api/v1/games/:id/diff [GET]
+func DiffGameVersions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req game_platform_api.DiffGameVersionsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	gameSvc := service.NewGameService()
+	rpcResp, err := gameSvc.DiffGameVersions(ctx, &req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(game_platform_api.DiffGameVersionsResponse)
+
+	resp = &game_platform_api.DiffGameVersionsResponse{
+		Data: &game_platform_api.DiffGameVersionsData{
+			FromVersionID: fmt.Sprint(rpcResp.FromVersionID),
+			ToVersionID:   fmt.Sprint(rpcResp.ToVersionID),
+			Diffs:         convertFieldDiffListToAPI(rpcResp.Diffs),
+		},
+		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// TakedownGame .
+// @router /api/v1/games/:id/takedown [POST]
+func TakedownGame(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req game_platform_api.TakedownGameRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	gameSvc := service.NewGameService()
+	rpcResp, err := gameSvc.TakedownGame(ctx, &req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(game_platform_api.TakedownGameResponse)
+
+	resp = &game_platform_api.TakedownGameResponse{
+		Data:     &game_platform_api.TakedownGameData{},
+		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RestoreGame .
+// @router /api/v1/games/:id/restore [POST]
+func RestoreGame(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req game_platform_api.RestoreGameRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	gameSvc := service.NewGameService()
+	rpcResp, err := gameSvc.RestoreGame(ctx, &req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(game_platform_api.RestoreGameResponse)
+
+	resp = &game_platform_api.RestoreGameResponse{
+		Data:     &game_platform_api.RestoreGameData{},
+		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UpdateGameRollout .
+// @router /api/v1/games/:id/rollout [POST]
+func UpdateGameRollout(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req game_platform_api.UpdateGameRolloutRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	gameSvc := service.NewGameService()
+	rpcResp, err := gameSvc.UpdateGameRollout(ctx, &req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(game_platform_api.UpdateGameRolloutResponse)
+
+	resp = &game_platform_api.UpdateGameRolloutResponse{
+		Data: &game_platform_api.UpdateGameRolloutData{
+			Rollout: convertGameRolloutToAPI(rpcResp.Rollout),
+		},
+		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetGameRollout .
+// @router /api/v1/games/:id/rollout [GET]
+func GetGameRollout(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req game_platform_api.GetGameRolloutRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	gameSvc := service.NewGameService()
+	rpcResp, err := gameSvc.GetGameRollout(ctx, &req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(game_platform_api.GetGameRolloutResponse)
+
+	resp = &game_platform_api.GetGameRolloutResponse{
+		Data: &game_platform_api.GetGameRolloutData{
+			Rollout:     convertGameRolloutToAPI(rpcResp.Rollout),
+			RolloutLogs: convertRolloutLogListToAPI(rpcResp.RolloutLogs),
+		},
+		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ResolveServedVersion .
+// @router /api/v1/games/:id/served-version [GET]
+func ResolveServedVersion(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req game_platform_api.ResolveServedVersionRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	gameSvc := service.NewGameService()
+	rpcResp, err := gameSvc.ResolveServedVersion(ctx, &req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(game_platform_api.ResolveServedVersionResponse)
+
+	resp = &game_platform_api.ResolveServedVersionResponse{
+		Data: &game_platform_api.ResolveServedVersionData{
+			GameVersionID: convertOptionalIDToAPI(rpcResp.GameVersionID),
+			InRollout:     rpcResp.InRollout,
+		},
+		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetGameChangelog .
+// @router /api/v1/games/:id/changelog [GET]
+func GetGameChangelog(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req game_platform_api.GetGameChangelogRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	gameSvc := service.NewGameService()
+	rpcResp, err := gameSvc.GetGameChangelog(ctx, &req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(game_platform_api.GetGameChangelogResponse)
+
+	resp = &game_platform_api.GetGameChangelogResponse{
+		Data: &game_platform_api.GetGameChangelogData{
+			Entries:    convertChangelogToAPI(rpcResp.Entries, requestLocales(c)),
+			TotalCount: rpcResp.TotalCount,
+		},
 		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
 	}
 
 	c.JSON(consts.StatusOK, resp)
 }
 
-// UpdateGameDetail .
-// @router /api/v1/games/:id [PUT]
-func UpdateGameDetail(ctx context.Context, c *app.RequestContext) {
+// ListDeletedGameDrafts .
+// @router /api/v1/games/:id/trash [GET]
+func ListDeletedGameDrafts(ctx context.Context, c *app.RequestContext) {
 	var err error
-	var req game_platform_api.UpdateGameDetailRequest
+	var req game_platform_api.ListDeletedGameDraftsRequest
 	err = c.BindAndValidate(&req)
 	if err != nil {
 		c.String(consts.StatusBadRequest, err.Error())
@@ -254,27 +769,29 @@ func UpdateGameDetail(ctx context.Context, c *app.RequestContext) {
 	}
 
 	gameSvc := service.NewGameService()
-	rpcResp, err := gameSvc.UpdateGameDetail(ctx, &req)
+	rpcResp, err := gameSvc.ListDeletedGameDrafts(ctx, &req)
 	if err != nil {
 		c.String(consts.StatusInternalServerError, err.Error())
 		return
 	}
 
-	resp := new(game_platform_api.UpdateGameDetailResponse)
+	resp := new(game_platform_api.ListDeletedGameDraftsResponse)
 
-	resp = &game_platform_api.UpdateGameDetailResponse{
-		Data:     &game_platform_api.UpdateGameDetailData{},
+	resp = &game_platform_api.ListDeletedGameDraftsResponse{
+		Data: &game_platform_api.ListDeletedGameDraftsData{
+			DeletedDrafts: convertDeletedGameDraftListToAPI(rpcResp.DeletedDrafts, requestLocales(c)),
+		},
 		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
 	}
 
 	c.JSON(consts.StatusOK, resp)
 }
 
-// ReviewGameVersion .
-// @router /api/v1/games/review [POST]
-func ReviewGameVersion(ctx context.Context, c *app.RequestContext) {
+// RestoreGameDraft .
+// @router /api/v1/games/:id/trash/:version_id/restore [POST]
+func RestoreGameDraft(ctx context.Context, c *app.RequestContext) {
 	var err error
-	var req game_platform_api.ReviewGameVersionRequest
+	var req game_platform_api.RestoreGameDraftRequest
 	err = c.BindAndValidate(&req)
 	if err != nil {
 		c.String(consts.StatusBadRequest, err.Error())
@@ -282,27 +799,29 @@ func ReviewGameVersion(ctx context.Context, c *app.RequestContext) {
 	}
 
 	gameSvc := service.NewGameService()
-	rpcResp, err := gameSvc.ReviewGameVersion(ctx, &req)
+	rpcResp, err := gameSvc.RestoreGameDraft(ctx, &req)
 	if err != nil {
 		c.String(consts.StatusInternalServerError, err.Error())
 		return
 	}
 
-	resp := new(game_platform_api.ReviewGameVersionResponse)
+	resp := new(game_platform_api.RestoreGameDraftResponse)
 
-	resp = &game_platform_api.ReviewGameVersionResponse{
-		Data:     &game_platform_api.ReviewGameVersionData{},
+	resp = &game_platform_api.RestoreGameDraftResponse{
+		Data: &game_platform_api.RestoreGameDraftData{
+			Revision: rpcResp.Revision,
+		},
 		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
 	}
 
 	c.JSON(consts.StatusOK, resp)
 }
 
-// DeleteGameDraft .
-// @router /api/v1/games/:id/draft [DELETE]
-func DeleteGameDraft(ctx context.Context, c *app.RequestContext) {
+// SearchGames .
+// @router /api/v1/games/search [GET]
+func SearchGames(ctx context.Context, c *app.RequestContext) {
 	var err error
-	var req game_platform_api.DeleteGameDraftRequest
+	var req game_platform_api.SearchGamesRequest
 	err = c.BindAndValidate(&req)
 	if err != nil {
 		c.String(consts.StatusBadRequest, err.Error())
@@ -310,34 +829,207 @@ func DeleteGameDraft(ctx context.Context, c *app.RequestContext) {
 	}
 
 	gameSvc := service.NewGameService()
-	rpcResp, err := gameSvc.DeleteGameDraft(ctx, &req)
+	rpcResp, err := gameSvc.SearchGames(ctx, &req)
 	if err != nil {
 		c.String(consts.StatusInternalServerError, err.Error())
 		return
 	}
 
-	resp := new(game_platform_api.DeleteGameDraftResponse)
+	resp := new(game_platform_api.SearchGamesResponse)
 
-	resp = &game_platform_api.DeleteGameDraftResponse{
-		Data:     &game_platform_api.DeleteGameDraftData{},
+	resp = &game_platform_api.SearchGamesResponse{
+		Data: &game_platform_api.SearchGamesData{
+			Hits:       convertSearchGameHitListToAPI(rpcResp.Hits),
+			TotalCount: rpcResp.TotalCount,
+		},
+		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// BatchGetGameDetails .
+// @router /api/v1/games/batch [GET]
+func BatchGetGameDetails(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req game_platform_api.BatchGetGameDetailsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	gameSvc := service.NewGameService()
+	rpcResp, err := gameSvc.BatchGetGameDetails(ctx, &req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(game_platform_api.BatchGetGameDetailsResponse)
+
+	resp = &game_platform_api.BatchGetGameDetailsResponse{
+		Data: &game_platform_api.BatchGetGameDetailsData{
+			GameDetails:    convertGameDetailListToAPI(rpcResp.GameDetails, requestLocales(c)),
+			MissingGameIds: convertIDListToAPI(rpcResp.MissingGameIDs),
+		},
 		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
 	}
 
 	c.JSON(consts.StatusOK, resp)
 }
 
+// ReleasePackageName .
+// @router /api/v1/admin/package-names/release [POST]
+func ReleasePackageName(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req game_platform_api.ReleasePackageNameRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	gameSvc := service.NewGameService()
+	rpcResp, err := gameSvc.ReleasePackageName(ctx, &req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(game_platform_api.ReleasePackageNameResponse)
+
+	resp = &game_platform_api.ReleasePackageNameResponse{
+		Data: &game_platform_api.ReleasePackageNameData{
+			PreviousGameID: fmt.Sprint(rpcResp.PreviousGameID),
+		},
+		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListCategories .
+// @router /api/v1/categories [GET]
+func ListCategories(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req game_platform_api.ListCategoriesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	gameSvc := service.NewGameService()
+	rpcResp, err := gameSvc.ListCategories(ctx, &req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(game_platform_api.ListCategoriesResponse)
+
+	resp = &game_platform_api.ListCategoriesResponse{
+		Data: &game_platform_api.ListCategoriesData{
+			Categories: convertCategoryTreeToAPI(rpcResp.Categories),
+		},
+		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListTags .
+// @router /api/v1/tags [GET]
+func ListTags(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req game_platform_api.ListTagsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	gameSvc := service.NewGameService()
+	rpcResp, err := gameSvc.ListTags(ctx, &req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := new(game_platform_api.ListTagsResponse)
+
+	resp = &game_platform_api.ListTagsResponse{
+		Data: &game_platform_api.ListTagsData{
+			Tags: convertTagListToAPI(rpcResp.Tags),
+		},
+		BaseResp: (*common.BaseResp)(rpcResp.BaseResp),
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// maxSubmitBodySize is the largest SubmitGameVersion body: an APK of the largest size the game service
+// accepts, plus room for the rest of the multipart form.
+const maxSubmitBodySize = constdef.MaxApkFileSize + 1<<20
+
+// saveApkUpload copies an uploaded APK into apk.upload_dir and returns its file name there.
+func saveApkUpload(fileHeader *multipart.FileHeader) (string, error) {
+	uploadDir := config.ApkUploadDir()
+	if uploadDir == "" {
+		return "", errors.New("apk.upload_dir is not configured, APK upload is disabled")
+	}
+	src, err := fileHeader.Open()
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	if err := os.MkdirAll(uploadDir, 0o755); err != nil {
+		return "", err
+	}
+	dst, err := os.CreateTemp(uploadDir, "submit-*.apk")
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		os.Remove(dst.Name())
+		return "", err
+	}
+	if err := dst.Close(); err != nil {
+		os.Remove(dst.Name())
+		return "", err
+	}
+	return filepath.Base(dst.Name()), nil
+}
+
+// requestLocales returns the locales the caller prefers: the lang query parameter if set, otherwise the
+// Accept-Language header.
+func requestLocales(c *app.RequestContext) []string {
+	if lang := c.Query("lang"); lang != "" {
+		return []string{lang}
+	}
+	return locale.ParseAcceptLanguage(string(c.GetHeader("Accept-Language")))
+}
+
 func convertBriefGameToAPI(rpcGame *game.BriefGame) *game_platform_api.BriefGame {
 	if rpcGame == nil {
 		return nil
 	}
 	return &game_platform_api.BriefGame{
-		GameID:     fmt.Sprint(rpcGame.GameID),
-		CpID:       fmt.Sprint(rpcGame.CpID),
-		GameName:   rpcGame.GameName,
-		GameIcon:   rpcGame.GameIcon,
-		CreateTime: rpcGame.CreateTime,
-		UpdateTime: rpcGame.UpdateTime,
-		GameStatus: convertGameStatusToAPI(rpcGame.GameStatus),
+		GameID:           fmt.Sprint(rpcGame.GameID),
+		CpID:             fmt.Sprint(rpcGame.CpID),
+		GameName:         rpcGame.GameName,
+		GameIcon:         rpcGame.GameIcon,
+		CreateTime:       rpcGame.CreateTime,
+		UpdateTime:       rpcGame.UpdateTime,
+		GameStatus:       convertGameStatusToAPI(rpcGame.GameStatus),
+		HeaderImage:      rpcGame.HeaderImage,
+		GameIntroduction: rpcGame.GameIntroduction,
+		GamePlatforms:    convertPlatformToAPI(rpcGame.GamePlatforms),
+		PackageName:      rpcGame.PackageName,
+		DownloadURL:      rpcGame.DownloadURL,
 	}
 }
 
@@ -349,24 +1041,189 @@ func convertBriefGameListToAPI(rpcList []*game.BriefGame) []*game_platform_api.B
 	return apiList
 }
 
-func convertGameDetailToAPI(rpcDetail *game.GameDetail) *game_platform_api.GameDetail {
+func convertSearchGameHitListToAPI(rpcList []*game.SearchGameHit) []*game_platform_api.SearchGameHit {
+	apiList := make([]*game_platform_api.SearchGameHit, 0, len(rpcList))
+	for _, hit := range rpcList {
+		highlights := make([]*game_platform_api.SearchHighlight, 0, len(hit.Highlights))
+		for _, h := range hit.Highlights {
+			highlights = append(highlights, &game_platform_api.SearchHighlight{
+				Field:   h.Field,
+				Snippet: h.Snippet,
+			})
+		}
+		apiList = append(apiList, &game_platform_api.SearchGameHit{
+			Game:       convertBriefGameToAPI(hit.Game),
+			Score:      hit.Score,
+			Highlights: highlights,
+		})
+	}
+	return apiList
+}
+
+func convertGameDetailToAPI(rpcDetail *game.GameDetail, preferred []string) *game_platform_api.GameDetail {
 	if rpcDetail == nil {
 		return nil
 	}
 	return &game_platform_api.GameDetail{
 		GameID:            fmt.Sprint(rpcDetail.GameID),
 		CpID:              fmt.Sprint(rpcDetail.CpID),
-		OnlineGameVersion: convertGameVersionToAPI(rpcDetail.OnlineGameVersion),
-		NewestGameVersion: convertGameVersionToAPI(rpcDetail.NewestGameVersion_),
+		OnlineGameVersion: convertGameVersionToAPI(rpcDetail.OnlineGameVersion, preferred),
+		NewestGameVersion: convertGameVersionToAPI(rpcDetail.NewestGameVersion_, preferred),
 		CreateTime:        rpcDetail.CreateTime,
 		ModifyTime:        rpcDetail.ModifyTime,
+		Takedown:          convertGameTakedownToAPI(rpcDetail.Takedown),
+		Rollout:           convertGameRolloutToAPI(rpcDetail.Rollout),
+	}
+}
+
+func convertGameDetailListToAPI(rpcList []*game.GameDetail, preferred []string) []*game_platform_api.GameDetail {
+	apiList := make([]*game_platform_api.GameDetail, 0, len(rpcList))
+	for _, detail := range rpcList {
+		apiList = append(apiList, convertGameDetailToAPI(detail, preferred))
+	}
+	return apiList
+}
+
+func convertIDListToAPI(rpcList []int64) []string {
+	apiList := make([]string, 0, len(rpcList))
+	for _, id := range rpcList {
+		apiList = append(apiList, fmt.Sprint(id))
+	}
+	return apiList
+}
+
+func convertFieldErrorListToAPI(rpcList []*game.FieldError) []*game_platform_api.FieldError {
+	apiList := make([]*game_platform_api.FieldError, 0, len(rpcList))
+	for _, fe := range rpcList {
+		apiList = append(apiList, &game_platform_api.FieldError{
+			Field:   fe.Field,
+			Code:    fe.Code,
+			Message: fe.Message,
+		})
+	}
+	return apiList
+}
+
+// convertCategoryTreeToAPI builds the category tree from the flat list returned by the game service, which
+// is ordered by parent and sort order; categories whose parent is missing are dropped.
+func convertCategoryTreeToAPI(rpcList []*game.Category) []*game_platform_api.CategoryNode {
+	children := make(map[int64][]*game.Category, len(rpcList))
+	for _, category := range rpcList {
+		children[category.ParentID] = append(children[category.ParentID], category)
+	}
+	var build func(parentID int64) []*game_platform_api.CategoryNode
+	build = func(parentID int64) []*game_platform_api.CategoryNode {
+		nodes := make([]*game_platform_api.CategoryNode, 0, len(children[parentID]))
+		for _, category := range children[parentID] {
+			nodes = append(nodes, &game_platform_api.CategoryNode{
+				CategoryID: strconv.FormatInt(category.CategoryID, 10),
+				Name:       category.Name,
+				SortOrder:  category.SortOrder,
+				Children:   build(category.CategoryID),
+			})
+		}
+		return nodes
+	}
+	return build(0)
+}
+
+func convertTagListToAPI(rpcList []*game.Tag) []*game_platform_api.Tag {
+	apiList := make([]*game_platform_api.Tag, 0, len(rpcList))
+	for _, tag := range rpcList {
+		apiList = append(apiList, &game_platform_api.Tag{
+			TagID: strconv.FormatInt(tag.TagID, 10),
+			Name:  tag.Name,
+		})
+	}
+	return apiList
+}
+
+// convertOptionalIDToAPI formats an ID, with 0 (not set) as an empty string.
+func convertOptionalIDToAPI(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatInt(id, 10)
+}
+
+func convertGameTakedownToAPI(rpcTakedown *game.GameTakedown) *game_platform_api.GameTakedown {
+	if rpcTakedown == nil {
+		return nil
+	}
+	return &game_platform_api.GameTakedown{
+		GameVersionID: fmt.Sprint(rpcTakedown.GameVersionID),
+		Reason:        rpcTakedown.Reason,
+		Operator:      rpcTakedown.Operator,
+		TakedownTime:  rpcTakedown.TakedownTime,
+	}
+}
+
+func convertGameRolloutToAPI(rpcRollout *game.GameRollout) *game_platform_api.GameRollout {
+	if rpcRollout == nil {
+		return nil
+	}
+	return &game_platform_api.GameRollout{
+		GameVersionID:     fmt.Sprint(rpcRollout.GameVersionID),
+		Percentage:        rpcRollout.Percentage,
+		State:             convertRolloutStateToAPI(rpcRollout.State),
+		BaseGameVersionID: fmt.Sprint(rpcRollout.BaseGameVersionID),
+	}
+}
+
+func convertRolloutLogListToAPI(rpcList []*game.RolloutLog) []*game_platform_api.RolloutLog {
+	apiList := make([]*game_platform_api.RolloutLog, 0, len(rpcList))
+	for _, l := range rpcList {
+		apiList = append(apiList, &game_platform_api.RolloutLog{
+			RolloutLogID:   fmt.Sprint(l.RolloutLogID),
+			GameVersionID:  fmt.Sprint(l.GameVersionID),
+			Action:         convertRolloutActionToAPI(l.Action),
+			FromPercentage: l.FromPercentage,
+			ToPercentage:   l.ToPercentage,
+			Operator:       l.Operator,
+			Reason:         l.Reason,
+			CreateTime:     l.CreateTime,
+		})
+	}
+	return apiList
+}
+
+func convertRolloutStateToAPI(state game.RolloutState) game_platform_api.RolloutState {
+	switch state {
+	case game.RolloutState_Active:
+		return game_platform_api.RolloutState_Active
+	case game.RolloutState_Paused:
+		return game_platform_api.RolloutState_Paused
+	default:
+		return game_platform_api.RolloutState_Unset
+	}
+}
+
+func convertRolloutActionToAPI(action game.RolloutAction) game_platform_api.RolloutAction {
+	switch action {
+	case game.RolloutAction_Start:
+		return game_platform_api.RolloutAction_Start
+	case game.RolloutAction_Raise:
+		return game_platform_api.RolloutAction_Raise
+	case game.RolloutAction_Pause:
+		return game_platform_api.RolloutAction_Pause
+	case game.RolloutAction_Resume:
+		return game_platform_api.RolloutAction_Resume
+	case game.RolloutAction_Rollback:
+		return game_platform_api.RolloutAction_Rollback
+	case game.RolloutAction_Complete:
+		return game_platform_api.RolloutAction_Complete
+	default:
+		return game_platform_api.RolloutAction_Unset
 	}
 }
 
-func convertGameVersionToAPI(rpcVersion *game.GameVersion) *game_platform_api.GameVersion {
+// convertGameVersionToAPI converts a version, showing it in the first locale of preferred it has. The
+// editable fields keep the content of the default locale; the localized content goes to display.
+func convertGameVersionToAPI(rpcVersion *game.GameVersion, preferred []string) *game_platform_api.GameVersion {
 	if rpcVersion == nil {
 		return nil
 	}
+	displayLocale, display := locale.Resolve(rpcVersion, preferred)
 	return &game_platform_api.GameVersion{
 		GameID:                 fmt.Sprint(rpcVersion.GameID),
 		GameVersionID:          fmt.Sprint(rpcVersion.GamVersionID),
@@ -378,13 +1235,163 @@ func convertGameVersionToAPI(rpcVersion *game.GameVersion) *game_platform_api.Ga
 		GamePlatforms:          convertPlatformToAPI(rpcVersion.GamePlatforms),
 		PackageName:            rpcVersion.PackageName,
 		DownloadURL:            rpcVersion.DownloadURL,
+		Builds:                 convertBuildsToAPI(rpcVersion.Builds),
 		GameStatus:             convertGameStatusToAPI(rpcVersion.GameStatus),
-		ReviewRemark: &game_platform_api.ReviewRemark{ // 注意: rpc层目前只有comment和time
+		ReviewRemark: &game_platform_api.ReviewRemark{
 			Remark:     rpcVersion.ReviewComment,
+			Operator:   rpcVersion.Operator,
 			ReviewTime: rpcVersion.ReviewTime,
 		},
-		CreateTime: rpcVersion.CreateTime,
-		UpdateTime: rpcVersion.UpdateTime,
+		CreateTime:    rpcVersion.CreateTime,
+		UpdateTime:    rpcVersion.UpdateTime,
+		PublishAt:     rpcVersion.PublishAt,
+		Revision:      rpcVersion.Revision,
+		CategoryID:    convertOptionalIDToAPI(rpcVersion.CategoryID),
+		TagIds:        convertIDListToAPI(rpcVersion.TagIDs),
+		DefaultLocale: locale.DefaultLocaleOf(rpcVersion),
+		Listings:      convertListingsToAPI(rpcVersion.Listings),
+		DisplayLocale: displayLocale,
+		Display:       convertListingToAPI(display),
+		ApkManifest:   convertApkManifestToAPI(rpcVersion.ApkManifest),
+		ReleaseNotes:  rpcVersion.ReleaseNotes,
+		Media:         convertMediaToAPI(rpcVersion.Media),
+	}
+}
+
+func convertApkManifestToAPI(rpcManifest *game.ApkManifest) *game_platform_api.ApkManifest {
+	if rpcManifest == nil {
+		return nil
+	}
+	return &game_platform_api.ApkManifest{
+		PackageName:       rpcManifest.PackageName,
+		VersionCode:       rpcManifest.VersionCode,
+		VersionName:       rpcManifest.VersionName,
+		MinSdkVersion:     rpcManifest.MinSdkVersion,
+		CertificateSha256: rpcManifest.CertificateSHA256,
+		InspectTime:       rpcManifest.InspectTime,
+	}
+}
+
+func convertListingsToAPI(rpcListings map[string]*game.LocalizedListing) map[string]*game_platform_api.LocalizedListing {
+	apiListings := make(map[string]*game_platform_api.LocalizedListing, len(rpcListings))
+	for tag, listing := range rpcListings {
+		apiListings[tag] = convertListingToAPI(listing)
+	}
+	return apiListings
+}
+
+func convertListingToAPI(rpcListing *game.LocalizedListing) *game_platform_api.LocalizedListing {
+	if rpcListing == nil {
+		return nil
+	}
+	return &game_platform_api.LocalizedListing{
+		GameName:               rpcListing.GameName,
+		GameIntroduction:       rpcListing.GameIntroduction,
+		GameIntroductionImages: rpcListing.GameIntroductionImages,
+		HeaderImage:            rpcListing.HeaderImage,
+		ReleaseNotes:           rpcListing.ReleaseNotes,
+	}
+}
+
+// convertChangelogToAPI converts changelog entries, showing the release notes of each in the first locale
+// of preferred they were written in.
+func convertChangelogToAPI(rpcEntries []*game.ChangelogEntry, preferred []string) []*game_platform_api.ChangelogEntry {
+	apiEntries := make([]*game_platform_api.ChangelogEntry, 0, len(rpcEntries))
+	for _, entry := range rpcEntries {
+		available := make([]string, 0, len(entry.LocalizedReleaseNotes)+1)
+		available = append(available, entry.DefaultLocale)
+		for tag := range entry.LocalizedReleaseNotes {
+			available = append(available, tag)
+		}
+		displayLocale := locale.Match(available, preferred, entry.DefaultLocale)
+		releaseNotes := entry.ReleaseNotes
+		if notes, ok := entry.LocalizedReleaseNotes[displayLocale]; ok {
+			releaseNotes = notes
+		}
+		apiEntries = append(apiEntries, &game_platform_api.ChangelogEntry{
+			GameVersionID: fmt.Sprint(entry.GameVersionID),
+			GameStatus:    convertGameStatusToAPI(entry.GameStatus),
+			PublishTime:   entry.PublishTime,
+			DisplayLocale: displayLocale,
+			ReleaseNotes:  releaseNotes,
+		})
+	}
+	return apiEntries
+}
+
+func convertGameVersionListToAPI(rpcList []*game.GameVersion, preferred []string) []*game_platform_api.GameVersion {
+	apiList := make([]*game_platform_api.GameVersion, 0, len(rpcList))
+	for _, v := range rpcList {
+		apiList = append(apiList, convertGameVersionToAPI(v, preferred))
+	}
+	return apiList
+}
+
+func convertDeletedGameDraftListToAPI(rpcList []*game.DeletedGameDraft, preferred []string) []*game_platform_api.DeletedGameDraft {
+	apiList := make([]*game_platform_api.DeletedGameDraft, 0, len(rpcList))
+	for _, d := range rpcList {
+		apiList = append(apiList, &game_platform_api.DeletedGameDraft{
+			GameVersion: convertGameVersionToAPI(d.GameVersion, preferred),
+			DeleteTime:  d.DeleteTime,
+			ExpireTime:  d.ExpireTime,
+		})
+	}
+	return apiList
+}
+
+func convertGameReviewLogToAPI(rpcLog *game.GameReviewLog) *game_platform_api.GameReviewLog {
+	if rpcLog == nil {
+		return nil
+	}
+	apiLog := &game_platform_api.GameReviewLog{
+		ReviewLogID:   fmt.Sprint(rpcLog.ReviewLogID),
+		GameID:        fmt.Sprint(rpcLog.GameID),
+		GameVersionID: fmt.Sprint(rpcLog.GameVersionID),
+		ReviewResult:  convertReviewResultToAPI(rpcLog.ReviewResult_),
+		ResultStatus:  convertGameStatusToAPI(rpcLog.ResultStatus),
+		PublishAt:     rpcLog.PublishAt,
+	}
+	if rpcLog.ReviewRemark != nil {
+		apiLog.ReviewRemark = &game_platform_api.ReviewRemark{
+			Remark:     rpcLog.ReviewRemark.Remark,
+			Operator:   rpcLog.ReviewRemark.Operator,
+			ReviewTime: rpcLog.ReviewRemark.ReviewTime,
+			Meta:       rpcLog.ReviewRemark.Meta,
+		}
+	}
+	return apiLog
+}
+
+func convertGameReviewLogListToAPI(rpcList []*game.GameReviewLog) []*game_platform_api.GameReviewLog {
+	apiList := make([]*game_platform_api.GameReviewLog, 0, len(rpcList))
+	for _, l := range rpcList {
+		apiList = append(apiList, convertGameReviewLogToAPI(l))
+	}
+	return apiList
+}
+
+func convertFieldDiffListToAPI(rpcList []*game.FieldDiff) []*game_platform_api.FieldDiff {
+	apiList := make([]*game_platform_api.FieldDiff, 0, len(rpcList))
+	for _, d := range rpcList {
+		apiList = append(apiList, &game_platform_api.FieldDiff{
+			Field:        d.Field,
+			FromValue:    d.FromValue,
+			ToValue:      d.ToValue,
+			AddedItems:   d.AddedItems,
+			RemovedItems: d.RemovedItems,
+		})
+	}
+	return apiList
+}
+
+func convertReviewResultToAPI(result game.ReviewResult_) game_platform_api.ReviewResult {
+	switch result {
+	case game.ReviewResult__Pass:
+		return game_platform_api.ReviewResult_Pass
+	case game.ReviewResult__Reject:
+		return game_platform_api.ReviewResult_Reject
+	default:
+		return game_platform_api.ReviewResult_Unset
 	}
 }
 
@@ -398,6 +1405,12 @@ func convertGameStatusToAPI(status game.GameStatus) game_platform_api.GameStatus
 		return game_platform_api.GameStatus_Published
 	case game.GameStatus_Rejected:
 		return game_platform_api.GameStatus_Rejected
+	case game.GameStatus_Scheduled:
+		return game_platform_api.GameStatus_Scheduled
+	case game.GameStatus_Offline:
+		return game_platform_api.GameStatus_Offline
+	case game.GameStatus_Deleted:
+		return game_platform_api.GameStatus_Deleted
 	default:
 		return game_platform_api.GameStatus_Unset
 	}
@@ -406,14 +1419,81 @@ func convertGameStatusToAPI(status game.GameStatus) game_platform_api.GameStatus
 func convertPlatformToAPI(platforms []game.GamePlatform) []game_platform_api.GamePlatform {
 	apiPlatforms := make([]game_platform_api.GamePlatform, 0, len(platforms))
 	for _, p := range platforms {
-		switch p {
-		case game.GamePlatform_Android:
-			apiPlatforms = append(apiPlatforms, game_platform_api.GamePlatform_Android)
-		case game.GamePlatform_IOS:
-			apiPlatforms = append(apiPlatforms, game_platform_api.GamePlatform_IOS)
-		case game.GamePlatform_Web:
-			apiPlatforms = append(apiPlatforms, game_platform_api.GamePlatform_Web)
+		if apiPlatform := convertGamePlatformToAPI(p); apiPlatform != game_platform_api.GamePlatform_Unset {
+			apiPlatforms = append(apiPlatforms, apiPlatform)
 		}
 	}
 	return apiPlatforms
 }
+
+func convertGamePlatformToAPI(platform game.GamePlatform) game_platform_api.GamePlatform {
+	switch platform {
+	case game.GamePlatform_Android:
+		return game_platform_api.GamePlatform_Android
+	case game.GamePlatform_IOS:
+		return game_platform_api.GamePlatform_IOS
+	case game.GamePlatform_Web:
+		return game_platform_api.GamePlatform_Web
+	default:
+		return game_platform_api.GamePlatform_Unset
+	}
+}
+
+func convertMediaToAPI(media []*game.MediaItem) []*game_platform_api.MediaItem {
+	apiMedia := make([]*game_platform_api.MediaItem, 0, len(media))
+	for _, item := range media {
+		apiMedia = append(apiMedia, &game_platform_api.MediaItem{
+			Type:         convertMediaTypeToAPI(item.Type),
+			URI:          item.URI,
+			ThumbnailURI: item.ThumbnailURI,
+			Orientation:  convertMediaOrientationToAPI(item.Orientation),
+			AspectRatio:  item.AspectRatio,
+			Caption:      item.Caption,
+			Position:     item.Position,
+		})
+	}
+	return apiMedia
+}
+
+func convertMediaTypeToAPI(mediaType game.MediaType) game_platform_api.MediaType {
+	switch mediaType {
+	case game.MediaType_Screenshot:
+		return game_platform_api.MediaType_Screenshot
+	case game.MediaType_TrailerVideo:
+		return game_platform_api.MediaType_TrailerVideo
+	case game.MediaType_PromoArt:
+		return game_platform_api.MediaType_PromoArt
+	default:
+		return game_platform_api.MediaType_Unset
+	}
+}
+
+func convertMediaOrientationToAPI(orientation game.MediaOrientation) game_platform_api.MediaOrientation {
+	switch orientation {
+	case game.MediaOrientation_Landscape:
+		return game_platform_api.MediaOrientation_Landscape
+	case game.MediaOrientation_Portrait:
+		return game_platform_api.MediaOrientation_Portrait
+	case game.MediaOrientation_Square:
+		return game_platform_api.MediaOrientation_Square
+	default:
+		return game_platform_api.MediaOrientation_Unset
+	}
+}
+
+func convertBuildsToAPI(builds []*game.PlatformBuild) []*game_platform_api.PlatformBuild {
+	apiBuilds := make([]*game_platform_api.PlatformBuild, 0, len(builds))
+	for _, build := range builds {
+		apiBuilds = append(apiBuilds, &game_platform_api.PlatformBuild{
+			Platform:     convertGamePlatformToAPI(build.Platform),
+			Identifier:   build.Identifier,
+			VersionName:  build.VersionName,
+			VersionCode:  build.VersionCode,
+			DownloadURL:  build.DownloadURL,
+			MinOsVersion: build.MinOSVersion,
+			FileSize:     build.FileSize,
+			Sha256:       build.SHA256,
+		})
+	}
+	return apiBuilds
+}
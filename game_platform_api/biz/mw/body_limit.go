@@ -0,0 +1,45 @@
+package mw
+
+import (
+	"context"
+	"io"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// MaxBodySize rejects request bodies larger than limit bytes on every route except the exempt ones,
+// which are full route paths such as "/api/v1/games/:id/submit".
+//
+// The server streams bodies over its max request body size instead of refusing them, so that an
+// upload route can write a large file to disk without holding it in memory. This middleware restores
+// the limit everywhere else; exempt routes must check the size of what they read themselves.
+func MaxBodySize(limit int, exempt ...string) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		for _, path := range exempt {
+			if c.FullPath() == path {
+				c.Next(ctx)
+				return
+			}
+		}
+
+		if c.Request.Header.ContentLength() > limit {
+			c.AbortWithMsg("request body too large", consts.StatusRequestEntityTooLarge)
+			return
+		}
+		if c.Request.IsBodyStream() {
+			// a chunked body has no length to check up front, read it up to the limit
+			body, err := io.ReadAll(io.LimitReader(c.Request.BodyStream(), int64(limit)+1))
+			if err != nil {
+				c.AbortWithMsg(err.Error(), consts.StatusBadRequest)
+				return
+			}
+			if len(body) > limit {
+				c.AbortWithMsg("request body too large", consts.StatusRequestEntityTooLarge)
+				return
+			}
+			c.Request.SetBody(body)
+		}
+		c.Next(ctx)
+	}
+}
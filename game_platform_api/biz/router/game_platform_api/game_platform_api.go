@@ -21,14 +21,49 @@ func Register(r *server.Hertz) {
 		_api := root.Group("/api", _apiMw()...)
 		{
 			_v1 := _api.Group("/v1", _v1Mw()...)
+			{
+				_admin := _v1.Group("/admin", _adminMw()...)
+				{
+					_package_names := _admin.Group("/package-names", _package_namesMw()...)
+					_package_names.POST("/release", append(_releasepackagenameMw(), game_platform_api.ReleasePackageName)...)
+				}
+			}
 			_v1.GET("/games", append(_getgamelistMw(), game_platform_api.GetGameList)...)
 			_games := _v1.Group("/games", _gamesMw()...)
 			_games.GET("/:id", append(_getgamedetailMw(), game_platform_api.GetGameDetail)...)
 			_id := _games.Group("/:id", _idMw()...)
 			_id.DELETE("/draft", append(_deletegamedraftMw(), game_platform_api.DeleteGameDraft)...)
+			_id.GET("/versions", append(_listgameversionsMw(), game_platform_api.ListGameVersions)...)
+			_versions := _id.Group("/versions", _versionsMw()...)
+			{
+				_version_id := _versions.Group("/:version_id", _version_idMw()...)
+				_version_id.GET("/reviews", append(_getgamereviewlogsMw(), game_platform_api.GetGameReviewLogs)...)
+			}
+			_id.POST("/rollback", append(_rollbackgameversionMw(), game_platform_api.RollbackGameVersion)...)
+			_schedule := _id.Group("/schedule", _scheduleMw()...)
+			_schedule.POST("/cancel", append(_cancelscheduledpublishMw(), game_platform_api.CancelScheduledPublish)...)
+			_id.POST("/submit", append(_submitgameversionMw(), game_platform_api.SubmitGameVersion)...)
+			_id.POST("/withdraw", append(_withdrawgameversionMw(), game_platform_api.WithdrawGameVersion)...)
+			_id.GET("/diff", append(_diffgameversionsMw(), game_platform_api.DiffGameVersions)...)
+			_id.POST("/takedown", append(_takedowngameMw(), game_platform_api.TakedownGame)...)
+			_id.POST("/restore", append(_restoregameMw(), game_platform_api.RestoreGame)...)
+			_id.POST("/rollout", append(_updategamerolloutMw(), game_platform_api.UpdateGameRollout)...)
+			_id.GET("/rollout", append(_getgamerolloutMw(), game_platform_api.GetGameRollout)...)
+			_id.GET("/served-version", append(_resolveservedversionMw(), game_platform_api.ResolveServedVersion)...)
+			_id.GET("/changelog", append(_getgamechangelogMw(), game_platform_api.GetGameChangelog)...)
+			_id.GET("/trash", append(_listdeletedgamedraftsMw(), game_platform_api.ListDeletedGameDrafts)...)
+			_trash := _id.Group("/trash", _trashMw()...)
+			{
+				_version_id0 := _trash.Group("/:version_id", _version_id0Mw()...)
+				_version_id0.POST("/restore", append(_restoregamedraftMw(), game_platform_api.RestoreGameDraft)...)
+			}
 			_games.PUT("/:id", append(_updategamedetailMw(), game_platform_api.UpdateGameDetail)...)
 			_games.POST("/review", append(_reviewgameversionMw(), game_platform_api.ReviewGameVersion)...)
+			_games.GET("/search", append(_searchgamesMw(), game_platform_api.SearchGames)...)
+			_games.GET("/batch", append(_batchgetgamedetailsMw(), game_platform_api.BatchGetGameDetails)...)
 			_v1.POST("/games", append(_creategamedetailMw(), game_platform_api.CreateGameDetail)...)
+			_v1.GET("/categories", append(_listcategoriesMw(), game_platform_api.ListCategories)...)
+			_v1.GET("/tags", append(_listtagsMw(), game_platform_api.ListTags)...)
 			{
 				_cp := _v1.Group("/cp", _cpMw()...)
 				_cp.POST("/materials", append(_createcpmaterialMw(), game_platform_api.CreateCPMaterial)...)
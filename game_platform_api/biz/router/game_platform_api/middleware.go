@@ -3,6 +3,7 @@
 package game_platform_api
 
 import (
+	"github.com/GameLaunchPad/game_management_project/game_platform_api/biz/mw"
 	"github.com/cloudwego/hertz/pkg/app"
 )
 
@@ -17,8 +18,7 @@ func _apiMw() []app.HandlerFunc {
 }
 
 func _v1Mw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{mw.Identity()}
 }
 
 func _gamesMw() []app.HandlerFunc {
@@ -90,3 +90,137 @@ func _deletegamedraftMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _listgameversionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _rollbackgameversionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _scheduleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _cancelscheduledpublishMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _versionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _version_idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getgamereviewlogsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _submitgameversionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _withdrawgameversionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _diffgameversionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _takedowngameMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _restoregameMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updategamerolloutMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getgamerolloutMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _resolveservedversionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getgamechangelogMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listdeletedgamedraftsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _trashMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _version_id0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _restoregamedraftMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _searchgamesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _batchgetgamedetailsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adminMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.RequireAdmin()}
+}
+
+func _package_namesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _releasepackagenameMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listcategoriesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listtagsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
 	"github.com/GameLaunchPad/game_management_project/game_platform_api/biz/model/game_platform_api"
@@ -21,18 +22,63 @@ func NewGameService() *GameService {
 // GetGameList 调用 game 服务获取游戏列表
 func (s *GameService) GetGameList(ctx context.Context, req *game_platform_api.GetGameListRequest) (*game.GetGameListResponse, error) {
 	rpcReq := &game.GetGameListRequest{
-		PageNum:  req.PageNum,
-		PageSize: req.PageSize,
+		PageNum:   req.PageNum,
+		PageSize:  req.PageSize,
+		Cursor:    req.Cursor,
+		NeedTotal: req.NeedTotal,
 	}
 	if req.Filter != nil {
 		rpcReq.Filter = &game.GameListFilter{
-			FilterText: req.Filter.FilterText,
+			FilterText:      req.Filter.FilterText,
+			CreateTimeStart: req.Filter.CreateTimeStart,
+			CreateTimeEnd:   req.Filter.CreateTimeEnd,
+			UpdateTimeStart: req.Filter.UpdateTimeStart,
+			UpdateTimeEnd:   req.Filter.UpdateTimeEnd,
+		}
+		if req.Filter.IsSetCpID() {
+			cpID, err := strconv.ParseInt(req.Filter.GetCpID(), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cp_id format: %w", err)
+			}
+			rpcReq.Filter.CpID = &cpID
+		}
+		if req.Filter.IsSetNewestStatus() {
+			rpcReq.Filter.NewestStatus_ = convertGameStatusListToRPC(req.Filter.NewestStatus)
+		}
+		if req.Filter.IsSetOnlineStatus() {
+			onlineStatus := convertOnlineStatusToRPC(req.Filter.GetOnlineStatus())
+			rpcReq.Filter.OnlineStatus = &onlineStatus
+		}
+		if req.Filter.IsSetPlatforms() {
+			rpcReq.Filter.Platforms = convertPlatformToRPC(req.Filter.Platforms)
+		}
+		if req.Filter.IsSetCategoryID() {
+			categoryID, err := strconv.ParseInt(req.Filter.GetCategoryID(), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid category_id format: %w", err)
+			}
+			rpcReq.Filter.CategoryID = &categoryID
+		}
+		if req.Filter.IsSetTagIds() {
+			tagIDs, err := parseTagIDs(req.Filter.TagIds)
+			if err != nil {
+				return nil, err
+			}
+			rpcReq.Filter.TagIDs = tagIDs
 		}
 	}
 	if req.Sorter != nil {
 		rpcReq.Sorter = &game.GameListSorter{
 			UpdateTime: req.Sorter.UpdateTime,
 		}
+		if req.Sorter.IsSetSortField() {
+			sortField := convertGameListSortFieldToRPC(req.Sorter.GetSortField())
+			rpcReq.Sorter.SortField = &sortField
+		}
+		if req.Sorter.IsSetSortOrder() {
+			sortOrder := convertSortOrderToRPC(req.Sorter.GetSortOrder())
+			rpcReq.Sorter.SortOrder = &sortOrder
+		}
 	}
 
 	resp, err := rpc.GameClient.GetGameList(ctx, rpcReq)
@@ -56,6 +102,11 @@ func (s *GameService) GetGameDetail(ctx context.Context, req *game_platform_api.
 
 // CreateGameDetail 调用 game 服务创建游戏详情
 func (s *GameService) CreateGameDetail(ctx context.Context, req *game_platform_api.CreateGameDetailRequest) (*game.CreateGameDetailResponse, error) {
+	categoryID, tagIDs, err := parseVersionTaxonomy(req.GameDetail.GameVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	rpcReq := &game.CreateGameDetailRequest{
 		GameDetail: &game.GameDetailWrite{
 			CpID: req.GameDetail.CpID,
@@ -68,6 +119,13 @@ func (s *GameService) CreateGameDetail(ctx context.Context, req *game_platform_a
 				GamePlatforms:          convertPlatformToRPC(req.GameDetail.GameVersion.GamePlatforms),
 				PackageName:            req.GameDetail.GameVersion.PackageName,
 				DownloadURL:            req.GameDetail.GameVersion.DownloadURL,
+				Builds:                 convertBuildsToRPC(req.GameDetail.GameVersion.Builds),
+				CategoryID:             categoryID,
+				TagIDs:                 tagIDs,
+				DefaultLocale:          req.GameDetail.GameVersion.DefaultLocale,
+				Listings:               convertListingsToRPC(req.GameDetail.GameVersion.Listings),
+				ReleaseNotes:           req.GameDetail.GameVersion.ReleaseNotes,
+				Media:                  convertMediaToRPC(req.GameDetail.GameVersion.Media),
 			},
 		},
 		SubmitMode: convertSubmitModeToRPC(req.SubmitMode),
@@ -92,6 +150,11 @@ func (s *GameService) UpdateGameDetail(ctx context.Context, req *game_platform_a
 		return nil, fmt.Errorf("invalid game_detail.game_id format: %w", err)
 	}
 
+	categoryID, tagIDs, err := parseVersionTaxonomy(req.GameDetail.GameVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	rpcReq := &game.UpdateGameDraftRequest{
 		GameDetail: &game.GameDetailWrite{
 			GameID: gameIDInDetail,
@@ -106,9 +169,17 @@ func (s *GameService) UpdateGameDetail(ctx context.Context, req *game_platform_a
 				GamePlatforms:          convertPlatformToRPC(req.GameDetail.GameVersion.GamePlatforms),
 				PackageName:            req.GameDetail.GameVersion.PackageName,
 				DownloadURL:            req.GameDetail.GameVersion.DownloadURL,
+				Builds:                 convertBuildsToRPC(req.GameDetail.GameVersion.Builds),
+				CategoryID:             categoryID,
+				TagIDs:                 tagIDs,
+				DefaultLocale:          req.GameDetail.GameVersion.DefaultLocale,
+				Listings:               convertListingsToRPC(req.GameDetail.GameVersion.Listings),
+				ReleaseNotes:           req.GameDetail.GameVersion.ReleaseNotes,
+				Media:                  convertMediaToRPC(req.GameDetail.GameVersion.Media),
 			},
 		},
-		SubmitMode: convertSubmitModeToRPC(req.SubmitMode),
+		SubmitMode:       convertSubmitModeToRPC(req.SubmitMode),
+		ExpectedRevision: req.ExpectedRevision,
 	}
 
 	resp, err := rpc.GameClient.UpdateGameDraft(ctx, rpcReq)
@@ -131,9 +202,19 @@ func (s *GameService) ReviewGameVersion(ctx context.Context, req *game_platform_
 	}
 
 	rpcReq := &game.ReviewGameVersionRequest{
-		GameID:        gameID,
-		GameVersionID: gameVersionID,
-		ReviewResult_: convertReviewResultToRPC(req.ReviewResult),
+		GameID:            gameID,
+		GameVersionID:     gameVersionID,
+		ReviewResult_:     convertReviewResultToRPC(req.ReviewResult),
+		PublishAt:         req.PublishAt,
+		RolloutPercentage: req.RolloutPercentage,
+	}
+	if req.ReviewRemark != nil {
+		// 审核时间以服务端落库时间为准，这里只透传审核意见、审核人和附加信息
+		rpcReq.ReviewRemark = &game.ReviewRemark{
+			Remark:   req.ReviewRemark.Remark,
+			Operator: req.ReviewRemark.Operator,
+			Meta:     req.ReviewRemark.Meta,
+		}
 	}
 
 	resp, err := rpc.GameClient.ReviewGameVersion(ctx, rpcReq)
@@ -155,8 +236,400 @@ func (s *GameService) DeleteGameDraft(ctx context.Context, req *game_platform_ap
 	return resp, nil
 }
 
+// ListGameVersions 调用 game 服务获取游戏版本历史
+func (s *GameService) ListGameVersions(ctx context.Context, req *game_platform_api.ListGameVersionsRequest) (*game.ListGameVersionsResponse, error) {
+	rpcReq := &game.ListGameVersionsRequest{
+		GameID:   req.GameID,
+		PageNum:  req.PageNum,
+		PageSize: req.PageSize,
+	}
+	if req.IsSetStatusFilter() {
+		rpcReq.StatusFilter = convertGameStatusListToRPC(req.StatusFilter)
+	}
+
+	resp, err := rpc.GameClient.ListGameVersions(ctx, rpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RollbackGameVersion 调用 game 服务回滚上线版本
+func (s *GameService) RollbackGameVersion(ctx context.Context, req *game_platform_api.RollbackGameVersionRequest) (*game.RollbackGameVersionResponse, error) {
+	gameVersionID, err := strconv.ParseInt(req.GameVersionID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid game_version_id format: %w", err)
+	}
+
+	rpcReq := &game.RollbackGameVersionRequest{
+		GameID:        req.GameID,
+		GameVersionID: gameVersionID,
+		Reason:        req.Reason,
+		Operator:      req.Operator,
+	}
+
+	resp, err := rpc.GameClient.RollbackGameVersion(ctx, rpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CancelScheduledPublish 调用 game 服务取消定时发布
+func (s *GameService) CancelScheduledPublish(ctx context.Context, req *game_platform_api.CancelScheduledPublishRequest) (*game.CancelScheduledPublishResponse, error) {
+	gameVersionID, err := strconv.ParseInt(req.GameVersionID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid game_version_id format: %w", err)
+	}
+
+	rpcReq := &game.CancelScheduledPublishRequest{
+		GameID:        req.GameID,
+		GameVersionID: gameVersionID,
+	}
+
+	resp, err := rpc.GameClient.CancelScheduledPublish(ctx, rpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetGameReviewLogs 调用 game 服务获取版本的审核记录
+func (s *GameService) GetGameReviewLogs(ctx context.Context, req *game_platform_api.GetGameReviewLogsRequest) (*game.GetGameReviewLogsResponse, error) {
+	rpcReq := &game.GetGameReviewLogsRequest{
+		GameID:        req.GameID,
+		GameVersionID: req.GameVersionID,
+	}
+
+	resp, err := rpc.GameClient.GetGameReviewLogs(ctx, rpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SubmitGameVersion 调用 game 服务将草稿提交审核，apkName 为随提交上传、保存在 apk.upload_dir 下的 Android 安装包文件名，可为空
+func (s *GameService) SubmitGameVersion(ctx context.Context, req *game_platform_api.SubmitGameVersionRequest, apkName string) (*game.SubmitGameVersionResponse, error) {
+	gameVersionID, err := strconv.ParseInt(req.GameVersionID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid game_version_id format: %w", err)
+	}
+
+	rpcReq := &game.SubmitGameVersionRequest{
+		GameID:        req.GameID,
+		GameVersionID: gameVersionID,
+	}
+	if apkName != "" {
+		// a relative path, resolved by the game service inside its own apk.upload_dir
+		rpcReq.ApkPath = &apkName
+	}
+
+	resp, err := rpc.GameClient.SubmitGameVersion(ctx, rpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WithdrawGameVersion 调用 game 服务撤回审核
+func (s *GameService) WithdrawGameVersion(ctx context.Context, req *game_platform_api.WithdrawGameVersionRequest) (*game.WithdrawGameVersionResponse, error) {
+	gameVersionID, err := strconv.ParseInt(req.GameVersionID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid game_version_id format: %w", err)
+	}
+
+	rpcReq := &game.WithdrawGameVersionRequest{
+		GameID:        req.GameID,
+		GameVersionID: gameVersionID,
+	}
+
+	resp, err := rpc.GameClient.WithdrawGameVersion(ctx, rpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DiffGameVersions 调用 game 服务对比两个版本的字段差异
+func (s *GameService) DiffGameVersions(ctx context.Context, req *game_platform_api.DiffGameVersionsRequest) (*game.DiffGameVersionsResponse, error) {
+	rpcReq := &game.DiffGameVersionsRequest{
+		GameID: req.GameID,
+	}
+	if req.IsSetFromVersionID() {
+		fromVersionID, err := strconv.ParseInt(req.GetFromVersionID(), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from_version_id format: %w", err)
+		}
+		rpcReq.FromVersionID = &fromVersionID
+	}
+	if req.IsSetToVersionID() {
+		toVersionID, err := strconv.ParseInt(req.GetToVersionID(), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to_version_id format: %w", err)
+		}
+		rpcReq.ToVersionID = &toVersionID
+	}
+
+	resp, err := rpc.GameClient.DiffGameVersions(ctx, rpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// TakedownGame 调用 game 服务下架游戏
+func (s *GameService) TakedownGame(ctx context.Context, req *game_platform_api.TakedownGameRequest) (*game.TakedownGameResponse, error) {
+	rpcReq := &game.TakedownGameRequest{
+		GameID:   req.GameID,
+		Reason:   req.Reason,
+		Operator: req.Operator,
+	}
+
+	resp, err := rpc.GameClient.TakedownGame(ctx, rpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RestoreGame 调用 game 服务恢复已下架的游戏
+func (s *GameService) RestoreGame(ctx context.Context, req *game_platform_api.RestoreGameRequest) (*game.RestoreGameResponse, error) {
+	rpcReq := &game.RestoreGameRequest{
+		GameID:   req.GameID,
+		Reason:   req.Reason,
+		Operator: req.Operator,
+	}
+
+	resp, err := rpc.GameClient.RestoreGame(ctx, rpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ListDeletedGameDrafts 调用 game 服务获取草稿回收站
+func (s *GameService) ListDeletedGameDrafts(ctx context.Context, req *game_platform_api.ListDeletedGameDraftsRequest) (*game.ListDeletedGameDraftsResponse, error) {
+	rpcReq := &game.ListDeletedGameDraftsRequest{
+		GameID: req.GameID,
+	}
+
+	resp, err := rpc.GameClient.ListDeletedGameDrafts(ctx, rpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RestoreGameDraft 调用 game 服务从回收站恢复草稿
+func (s *GameService) RestoreGameDraft(ctx context.Context, req *game_platform_api.RestoreGameDraftRequest) (*game.RestoreGameDraftResponse, error) {
+	rpcReq := &game.RestoreGameDraftRequest{
+		GameID:        req.GameID,
+		GameVersionID: req.GameVersionID,
+	}
+
+	resp, err := rpc.GameClient.RestoreGameDraft(ctx, rpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SearchGames 调用 game 服务全文搜索游戏
+func (s *GameService) SearchGames(ctx context.Context, req *game_platform_api.SearchGamesRequest) (*game.SearchGamesResponse, error) {
+	rpcReq := &game.SearchGamesRequest{
+		Query:    req.Query,
+		PageNum:  req.PageNum,
+		PageSize: req.PageSize,
+	}
+	if req.IsSetCpID() {
+		cpID, err := strconv.ParseInt(req.GetCpID(), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cp_id format: %w", err)
+		}
+		rpcReq.CpID = &cpID
+	}
+	if req.IsSetStatus() {
+		rpcReq.Status = convertGameStatusListToRPC(req.Status)
+	}
+
+	resp, err := rpc.GameClient.SearchGames(ctx, rpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// BatchGetGameDetails 调用 game 服务批量获取游戏详情，game_ids 可重复传参，也可用逗号分隔
+func (s *GameService) BatchGetGameDetails(ctx context.Context, req *game_platform_api.BatchGetGameDetailsRequest) (*game.BatchGetGameDetailsResponse, error) {
+	rpcReq := &game.BatchGetGameDetailsRequest{}
+	for _, ids := range req.GameIds {
+		for _, id := range strings.Split(ids, ",") {
+			gameID, err := strconv.ParseInt(strings.TrimSpace(id), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid game_id format: %w", err)
+			}
+			rpcReq.GameIDs = append(rpcReq.GameIDs, gameID)
+		}
+	}
+
+	resp, err := rpc.GameClient.BatchGetGameDetails(ctx, rpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ReleasePackageName 调用 game 服务释放包名占用，设置 transfer_to_game_id 时转移给该游戏
+func (s *GameService) ReleasePackageName(ctx context.Context, req *game_platform_api.ReleasePackageNameRequest) (*game.ReleasePackageNameResponse, error) {
+	rpcReq := &game.ReleasePackageNameRequest{
+		Platform:    convertGamePlatformToRPC(req.Platform),
+		PackageName: req.PackageName,
+		Reason:      req.Reason,
+		Operator:    req.Operator,
+	}
+	if req.IsSetTransferToGameID() {
+		transferToGameID, err := strconv.ParseInt(req.GetTransferToGameID(), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transfer_to_game_id format: %w", err)
+		}
+		rpcReq.TransferToGameID = &transferToGameID
+	}
+
+	resp, err := rpc.GameClient.ReleasePackageName(ctx, rpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ListCategories 调用 game 服务获取全部分类
+func (s *GameService) ListCategories(ctx context.Context, req *game_platform_api.ListCategoriesRequest) (*game.ListCategoriesResponse, error) {
+	resp, err := rpc.GameClient.ListCategories(ctx, &game.ListCategoriesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ListTags 调用 game 服务获取全部标签
+func (s *GameService) ListTags(ctx context.Context, req *game_platform_api.ListTagsRequest) (*game.ListTagsResponse, error) {
+	resp, err := rpc.GameClient.ListTags(ctx, &game.ListTagsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// UpdateGameRollout 调用 game 服务调整灰度发布
+func (s *GameService) UpdateGameRollout(ctx context.Context, req *game_platform_api.UpdateGameRolloutRequest) (*game.UpdateGameRolloutResponse, error) {
+	gameVersionID, err := strconv.ParseInt(req.GameVersionID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid game_version_id format: %w", err)
+	}
+
+	rpcReq := &game.UpdateGameRolloutRequest{
+		GameID:        req.GameID,
+		GameVersionID: gameVersionID,
+		Action:        convertRolloutActionToRPC(req.Action),
+		Percentage:    req.Percentage,
+		Reason:        req.Reason,
+		Operator:      req.Operator,
+	}
+
+	resp, err := rpc.GameClient.UpdateGameRollout(ctx, rpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetGameRollout 调用 game 服务获取灰度状态与操作记录
+func (s *GameService) GetGameRollout(ctx context.Context, req *game_platform_api.GetGameRolloutRequest) (*game.GetGameRolloutResponse, error) {
+	resp, err := rpc.GameClient.GetGameRollout(ctx, &game.GetGameRolloutRequest{GameID: req.GameID})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ResolveServedVersion 调用 game 服务获取玩家应获取的版本
+func (s *GameService) ResolveServedVersion(ctx context.Context, req *game_platform_api.ResolveServedVersionRequest) (*game.ResolveServedVersionResponse, error) {
+	rpcReq := &game.ResolveServedVersionRequest{
+		GameID:   req.GameID,
+		PlayerID: req.PlayerID,
+	}
+
+	resp, err := rpc.GameClient.ResolveServedVersion(ctx, rpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetGameChangelog 调用 game 服务获取已发布版本的更新说明
+func (s *GameService) GetGameChangelog(ctx context.Context, req *game_platform_api.GetGameChangelogRequest) (*game.GetGameChangelogResponse, error) {
+	rpcReq := &game.GetGameChangelogRequest{
+		GameID:   req.GameID,
+		PageNum:  req.PageNum,
+		PageSize: req.PageSize,
+	}
+
+	resp, err := rpc.GameClient.GetGameChangelog(ctx, rpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // --- 类型转换辅助函数 ---
 
+// parseVersionTaxonomy 解析版本的分类和标签 ID，分类为空表示未分类
+func parseVersionTaxonomy(version *game_platform_api.GameVersion) (int64, []int64, error) {
+	var categoryID int64
+	if version.CategoryID != "" {
+		var err error
+		categoryID, err = strconv.ParseInt(version.CategoryID, 10, 64)
+		if err != nil {
+			return 0, nil, fmt.Errorf("invalid category_id format: %w", err)
+		}
+	}
+	tagIDs, err := parseTagIDs(version.TagIds)
+	if err != nil {
+		return 0, nil, err
+	}
+	return categoryID, tagIDs, nil
+}
+
+func parseTagIDs(ids []string) ([]int64, error) {
+	tagIDs := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		tagID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag_id format: %w", err)
+		}
+		tagIDs = append(tagIDs, tagID)
+	}
+	return tagIDs, nil
+}
+
+// convertListingsToRPC 转换其他语言的商店信息；display 等只读字段不回传
+func convertListingsToRPC(listings map[string]*game_platform_api.LocalizedListing) map[string]*game.LocalizedListing {
+	rpcListings := make(map[string]*game.LocalizedListing, len(listings))
+	for tag, listing := range listings {
+		if listing == nil {
+			continue
+		}
+		rpcListings[tag] = &game.LocalizedListing{
+			GameName:               listing.GameName,
+			GameIntroduction:       listing.GameIntroduction,
+			GameIntroductionImages: listing.GameIntroductionImages,
+			HeaderImage:            listing.HeaderImage,
+			ReleaseNotes:           listing.ReleaseNotes,
+		}
+	}
+	return rpcListings
+}
+
 func convertSubmitModeToRPC(mode game_platform_api.SubmitMode) game.SubmitMode {
 	switch mode {
 	case game_platform_api.SubmitMode_SubmitDraft:
@@ -180,17 +653,173 @@ func convertReviewResultToRPC(result game_platform_api.ReviewResult) game.Review
 	}
 }
 
+func convertRolloutActionToRPC(action game_platform_api.RolloutAction) game.RolloutAction {
+	switch action {
+	case game_platform_api.RolloutAction_Raise:
+		return game.RolloutAction_Raise
+	case game_platform_api.RolloutAction_Pause:
+		return game.RolloutAction_Pause
+	case game_platform_api.RolloutAction_Resume:
+		return game.RolloutAction_Resume
+	case game_platform_api.RolloutAction_Rollback:
+		return game.RolloutAction_Rollback
+	default:
+		// Start 和 Complete 只出现在操作记录中，不能直接请求
+		return game.RolloutAction_Unset
+	}
+}
+
+func convertGameStatusToRPC(status game_platform_api.GameStatus) game.GameStatus {
+	switch status {
+	case game_platform_api.GameStatus_Draft:
+		return game.GameStatus_Draft
+	case game_platform_api.GameStatus_Reviewing:
+		return game.GameStatus_Reviewing
+	case game_platform_api.GameStatus_Published:
+		return game.GameStatus_Published
+	case game_platform_api.GameStatus_Rejected:
+		return game.GameStatus_Rejected
+	case game_platform_api.GameStatus_Scheduled:
+		return game.GameStatus_Scheduled
+	case game_platform_api.GameStatus_Offline:
+		return game.GameStatus_Offline
+	case game_platform_api.GameStatus_Deleted:
+		return game.GameStatus_Deleted
+	default:
+		return game.GameStatus_Unset
+	}
+}
+
+func convertGameStatusListToRPC(statuses []game_platform_api.GameStatus) []game.GameStatus {
+	rpcStatuses := make([]game.GameStatus, 0, len(statuses))
+	for _, status := range statuses {
+		rpcStatuses = append(rpcStatuses, convertGameStatusToRPC(status))
+	}
+	return rpcStatuses
+}
+
+// convertPlatformToRPC 转换平台列表；无法识别的平台保留为 Unset，由 game 服务校验拒绝
 func convertPlatformToRPC(platforms []game_platform_api.GamePlatform) []game.GamePlatform {
 	rpcPlatforms := make([]game.GamePlatform, 0, len(platforms))
 	for _, p := range platforms {
-		switch p {
-		case game_platform_api.GamePlatform_Android:
-			rpcPlatforms = append(rpcPlatforms, game.GamePlatform_Android)
-		case game_platform_api.GamePlatform_IOS:
-			rpcPlatforms = append(rpcPlatforms, game.GamePlatform_IOS)
-		case game_platform_api.GamePlatform_Web:
-			rpcPlatforms = append(rpcPlatforms, game.GamePlatform_Web)
-		}
+		rpcPlatforms = append(rpcPlatforms, convertGamePlatformToRPC(p))
 	}
 	return rpcPlatforms
 }
+
+func convertGamePlatformToRPC(platform game_platform_api.GamePlatform) game.GamePlatform {
+	switch platform {
+	case game_platform_api.GamePlatform_Android:
+		return game.GamePlatform_Android
+	case game_platform_api.GamePlatform_IOS:
+		return game.GamePlatform_IOS
+	case game_platform_api.GamePlatform_Web:
+		return game.GamePlatform_Web
+	default:
+		return game.GamePlatform_Unset
+	}
+}
+
+// convertBuildsToRPC 转换各平台的构建信息；无法识别的平台保留为 Unset，由 game 服务校验拒绝
+func convertBuildsToRPC(builds []*game_platform_api.PlatformBuild) []*game.PlatformBuild {
+	rpcBuilds := make([]*game.PlatformBuild, 0, len(builds))
+	for _, build := range builds {
+		if build == nil {
+			continue
+		}
+		rpcBuilds = append(rpcBuilds, &game.PlatformBuild{
+			Platform:     convertGamePlatformToRPC(build.Platform),
+			Identifier:   build.Identifier,
+			VersionName:  build.VersionName,
+			VersionCode:  build.VersionCode,
+			DownloadURL:  build.DownloadURL,
+			MinOSVersion: build.MinOsVersion,
+			FileSize:     build.FileSize,
+			SHA256:       build.Sha256,
+		})
+	}
+	return rpcBuilds
+}
+
+// convertMediaToRPC 转换媒体库；无法识别的类型保留为 Unset，由 game 服务校验拒绝
+func convertMediaToRPC(media []*game_platform_api.MediaItem) []*game.MediaItem {
+	rpcMedia := make([]*game.MediaItem, 0, len(media))
+	for _, item := range media {
+		if item == nil {
+			continue
+		}
+		rpcMedia = append(rpcMedia, &game.MediaItem{
+			Type:         convertMediaTypeToRPC(item.Type),
+			URI:          item.URI,
+			ThumbnailURI: item.ThumbnailURI,
+			Orientation:  convertMediaOrientationToRPC(item.Orientation),
+			AspectRatio:  item.AspectRatio,
+			Caption:      item.Caption,
+			Position:     item.Position,
+		})
+	}
+	return rpcMedia
+}
+
+func convertMediaTypeToRPC(mediaType game_platform_api.MediaType) game.MediaType {
+	switch mediaType {
+	case game_platform_api.MediaType_Screenshot:
+		return game.MediaType_Screenshot
+	case game_platform_api.MediaType_TrailerVideo:
+		return game.MediaType_TrailerVideo
+	case game_platform_api.MediaType_PromoArt:
+		return game.MediaType_PromoArt
+	default:
+		return game.MediaType_Unset
+	}
+}
+
+func convertMediaOrientationToRPC(orientation game_platform_api.MediaOrientation) game.MediaOrientation {
+	switch orientation {
+	case game_platform_api.MediaOrientation_Landscape:
+		return game.MediaOrientation_Landscape
+	case game_platform_api.MediaOrientation_Portrait:
+		return game.MediaOrientation_Portrait
+	case game_platform_api.MediaOrientation_Square:
+		return game.MediaOrientation_Square
+	default:
+		return game.MediaOrientation_Unset
+	}
+}
+
+func convertOnlineStatusToRPC(status game_platform_api.OnlineStatus) game.OnlineStatus {
+	switch status {
+	case game_platform_api.OnlineStatus_Online:
+		return game.OnlineStatus_Online
+	case game_platform_api.OnlineStatus_NeverOnline:
+		return game.OnlineStatus_NeverOnline
+	case game_platform_api.OnlineStatus_TakenDown:
+		return game.OnlineStatus_TakenDown
+	default:
+		return game.OnlineStatus_Unset
+	}
+}
+
+func convertGameListSortFieldToRPC(field game_platform_api.GameListSortField) game.GameListSortField {
+	switch field {
+	case game_platform_api.GameListSortField_CreateTime:
+		return game.GameListSortField_CreateTime
+	case game_platform_api.GameListSortField_UpdateTime:
+		return game.GameListSortField_UpdateTime
+	case game_platform_api.GameListSortField_GameName:
+		return game.GameListSortField_GameName
+	default:
+		return game.GameListSortField_Unset
+	}
+}
+
+func convertSortOrderToRPC(order game_platform_api.SortOrder) game.SortOrder {
+	switch order {
+	case game_platform_api.SortOrder_Desc:
+		return game.SortOrder_Desc
+	case game_platform_api.SortOrder_Asc:
+		return game.SortOrder_Asc
+	default:
+		return game.SortOrder_Unset
+	}
+}
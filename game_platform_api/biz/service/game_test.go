@@ -0,0 +1,41 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game"
+	game_platform_api "github.com/GameLaunchPad/game_management_project/game_platform_api/biz/model/game_platform_api"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestConvertPlatformToRPC tests that unknown platforms are passed on as Unset for the game service to reject
+func TestConvertPlatformToRPC(t *testing.T) {
+	platforms := []game_platform_api.GamePlatform{
+		game_platform_api.GamePlatform_Android,
+		game_platform_api.GamePlatform_Unset,
+		game_platform_api.GamePlatform_Web,
+		9,
+	}
+
+	assert.Equal(t, []game.GamePlatform{
+		game.GamePlatform_Android,
+		game.GamePlatform_Unset,
+		game.GamePlatform_Web,
+		game.GamePlatform_Unset,
+	}, convertPlatformToRPC(platforms))
+	assert.Empty(t, convertPlatformToRPC(nil))
+}
+
+// TestConvertBuildsToRPC tests that builds keep an unknown platform as Unset, like platform lists
+func TestConvertBuildsToRPC(t *testing.T) {
+	builds := []*game_platform_api.PlatformBuild{
+		{Platform: game_platform_api.GamePlatform_IOS, Identifier: "com.example.glory"},
+		nil,
+		{Platform: game_platform_api.GamePlatform_Unset, Identifier: "com.example.glory"},
+	}
+
+	rpcBuilds := convertBuildsToRPC(builds)
+	assert.Len(t, rpcBuilds, 2)
+	assert.Equal(t, game.GamePlatform_IOS, rpcBuilds[0].Platform)
+	assert.Equal(t, game.GamePlatform_Unset, rpcBuilds[1].Platform)
+}
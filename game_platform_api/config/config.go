@@ -13,6 +13,27 @@ var Config struct {
 		GameServiceAddr     string `yaml:"game_service_addr" json:"game_service_addr"`
 		CpCenterServiceAddr string `yaml:"cp_center_service_addr" json:"cp_center_service_addr"`
 	} `yaml:"rpc" json:"rpc"`
+	Apk struct {
+		UploadDir string `yaml:"upload_dir" json:"upload_dir"`
+	} `yaml:"apk" json:"apk"`
+	Auth struct {
+		TokenSecret string `yaml:"token_secret" json:"token_secret"`
+	} `yaml:"auth" json:"auth"`
+}
+
+// TokenSecret returns the secret bearer tokens are signed with, or nil when auth.token_secret is not
+// configured, in which case no caller can be authenticated.
+func TokenSecret() []byte {
+	if Config.Auth.TokenSecret == "" {
+		return nil
+	}
+	return []byte(Config.Auth.TokenSecret)
+}
+
+// ApkUploadDir returns the directory uploaded APKs are written to, shared with the game service as its
+// apk.upload_dir, or "" when APK uploads are not configured.
+func ApkUploadDir() string {
+	return Config.Apk.UploadDir
 }
 
 func Init(path string) error {
@@ -68,6 +89,12 @@ func parseSimpleYAML(data []byte) error {
 						Config.Rpc.CpCenterServiceAddr = value
 					}
 				}
+				if currentSection == "apk" && key == "upload_dir" {
+					Config.Apk.UploadDir = value
+				}
+				if currentSection == "auth" && key == "token_secret" {
+					Config.Auth.TokenSecret = value
+				}
 			}
 		}
 	}
@@ -5,11 +5,15 @@ package main
 import (
 	"log"
 
+	"github.com/GameLaunchPad/game_management_project/game_platform_api/biz/mw"
 	"github.com/GameLaunchPad/game_management_project/game_platform_api/config"
 	"github.com/GameLaunchPad/game_management_project/game_platform_api/rpc"
 	"github.com/cloudwego/hertz/pkg/app/server"
 )
 
+// defaultMaxRequestBodySize is hertz's default max request body size.
+const defaultMaxRequestBodySize = 4 << 20
+
 func main() {
 	if err := config.Init("./script/config.yaml"); err != nil {
 		log.Fatalf("Failed to init config: %v", err)
@@ -17,7 +21,15 @@ func main() {
 
 	rpc.Init()
 
-	h := server.Default(server.WithHostPorts(":8881"))
+	// bodies over the default max request body size are streamed rather than refused or read into memory,
+	// so that submitting a game version can upload its APK straight to disk. Every other route keeps
+	// the default limit.
+	h := server.Default(
+		server.WithHostPorts(":8881"),
+		server.WithStreamBody(true),
+		server.WithDisablePreParseMultipartForm(true),
+	)
+	h.Use(mw.MaxBodySize(defaultMaxRequestBodySize, "/api/v1/games/:id/submit"))
 
 	register(h)
 	h.Spin()
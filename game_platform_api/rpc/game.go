@@ -6,12 +6,19 @@ import (
 	"github.com/GameLaunchPad/game_management_project/game/kitex_gen/game/gameservice"
 	"github.com/GameLaunchPad/game_management_project/game_platform_api/config"
 	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/transmeta"
+	"github.com/cloudwego/kitex/transport"
 )
 
 var GameClient gameservice.Client
 
 func initGameClient() {
-	c, err := gameservice.NewClient("game", client.WithHostPorts(config.Config.Rpc.GameServiceAddr))
+	// TTHeader carries the metadata set by middlewares, such as the calling CP, to the game service
+	c, err := gameservice.NewClient("game",
+		client.WithHostPorts(config.Config.Rpc.GameServiceAddr),
+		client.WithTransportProtocol(transport.TTHeader),
+		client.WithMetaHandler(transmeta.ClientTTHeaderHandler),
+	)
 	if err != nil {
 		log.Fatal(err)
 	}